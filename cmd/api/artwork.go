@@ -0,0 +1,201 @@
+package main
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/video"
+	video_usecase "github.com.br/gibranct/admin_do_catalogo/internal/usecases/video"
+)
+
+func (app *application) uploadArtworkHandler(w http.ResponseWriter, r *http.Request) {
+	videoId, ok := app.readVideoId(w, r)
+	if !ok {
+		return
+	}
+
+	rc := http.NewResponseController(w)
+	rc.SetReadDeadline(time.Time{})
+	rc.SetWriteDeadline(time.Time{})
+
+	reader, err := r.MultipartReader()
+	if err != nil {
+		app.badRequestResponse(w, err)
+		return
+	}
+
+	fields := map[string]string{}
+
+	for {
+		part, err := reader.NextPart()
+		if errors.Is(err, io.EOF) {
+			app.badRequestResponse(w, errors.New("multipart body must contain a 'file' part"))
+			return
+		}
+
+		if err != nil {
+			app.badRequestResponse(w, err)
+			return
+		}
+
+		switch part.FormName() {
+		case "role", "language", "checksum":
+			value, err := io.ReadAll(io.LimitReader(part, 256))
+			if err != nil {
+				app.badRequestResponse(w, err)
+				return
+			}
+			fields[part.FormName()] = string(value)
+			continue
+		case "file":
+		default:
+			continue
+		}
+
+		output, err := app.useCases.Video.UploadArtwork.Execute(video_usecase.UploadArtworkCommand{
+			VideoId:  videoId,
+			Role:     fields["role"],
+			Language: fields["language"],
+			Resource: video.Resource{
+				Stream:      part,
+				Checksum:    fields["checksum"],
+				ContentType: part.Header.Get("Content-Type"),
+				Name:        part.FileName(),
+			},
+		})
+
+		var invalidErr video_usecase.InvalidMediaError
+
+		switch {
+		case errors.Is(err, video.ErrVideoNotFound):
+			app.notFoundResponse(w)
+		case errors.As(err, &invalidErr):
+			app.writeError(w, http.StatusBadRequest, "Could not upload artwork", invalidErr.Notification)
+		case errors.Is(err, video.ErrChecksumMismatch):
+			app.badRequestResponse(w, err)
+		case err != nil:
+			app.serverErrorResponse(w, err)
+		default:
+			app.writeJson(w, http.StatusCreated, output, nil)
+		}
+		return
+	}
+}
+
+func (app *application) listArtworkHandler(w http.ResponseWriter, r *http.Request) {
+	videoId, ok := app.readVideoId(w, r)
+	if !ok {
+		return
+	}
+
+	outputs, err := app.useCases.Video.ListArtwork.Execute(videoId)
+
+	switch {
+	case errors.Is(err, video.ErrVideoNotFound):
+		app.notFoundResponse(w)
+	case err != nil:
+		app.serverErrorResponse(w, err)
+	default:
+		app.writeJson(w, http.StatusOK, envelope{"artwork": outputs}, nil)
+	}
+}
+
+func (app *application) bestArtworkHandler(w http.ResponseWriter, r *http.Request) {
+	videoId, ok := app.readVideoId(w, r)
+	if !ok {
+		return
+	}
+
+	query := r.URL.Query()
+
+	// without a width the largest artwork is picked
+	width := 0
+	if value := query.Get("width"); value != "" {
+		var err error
+		if width, err = strconv.Atoi(value); err != nil {
+			app.badRequestResponse(w, err)
+			return
+		}
+	}
+
+	noti, output := app.useCases.Video.GetBestArtwork.Execute(video_usecase.BestArtworkCommand{
+		VideoId:  videoId,
+		Role:     query.Get("role"),
+		Language: query.Get("language"),
+		Width:    width,
+	})
+
+	if output != nil {
+		app.writeJson(w, http.StatusOK, output, nil)
+		return
+	}
+
+	if slices.ContainsFunc(noti.GetErrors(), isArtworkNotFound) {
+		app.notFoundResponse(w)
+		return
+	}
+
+	if err := app.writeError(w, http.StatusBadRequest, "Could not select artwork", noti); err != nil {
+		app.serverErrorResponse(w, err)
+	}
+}
+
+func (app *application) getArtworkImageHandler(w http.ResponseWriter, r *http.Request) {
+	command, ok := app.readArtworkCommand(w, r)
+	if !ok {
+		return
+	}
+
+	resource, err := app.useCases.Video.GetArtworkImage.Execute(command)
+
+	switch {
+	case isArtworkNotFound(err), errors.Is(err, video.ErrResourceNotFound):
+		app.notFoundResponse(w)
+		return
+	case err != nil:
+		app.serverErrorResponse(w, err)
+		return
+	}
+
+	app.serveResource(w, r, resource)
+}
+
+func (app *application) deleteArtworkHandler(w http.ResponseWriter, r *http.Request) {
+	command, ok := app.readArtworkCommand(w, r)
+	if !ok {
+		return
+	}
+
+	err := app.useCases.Video.DeleteArtwork.Execute(command)
+
+	switch {
+	case isArtworkNotFound(err):
+		app.notFoundResponse(w)
+	case err != nil:
+		app.serverErrorResponse(w, err)
+	default:
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func (app *application) readArtworkCommand(w http.ResponseWriter, r *http.Request) (video_usecase.ArtworkCommand, bool) {
+	videoId, ok := app.readVideoId(w, r)
+	if !ok {
+		return video_usecase.ArtworkCommand{}, false
+	}
+
+	artworkId, ok := app.readIdParam(w, r, "artworkId")
+	if !ok {
+		return video_usecase.ArtworkCommand{}, false
+	}
+
+	return video_usecase.ArtworkCommand{VideoId: videoId, ArtworkId: artworkId}, true
+}
+
+func isArtworkNotFound(err error) bool {
+	return errors.Is(err, video.ErrVideoNotFound) || errors.Is(err, video.ErrArtworkNotFound)
+}
@@ -0,0 +1,126 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	video_usecase "github.com.br/gibranct/admin_do_catalogo/internal/usecases/video"
+	"github.com.br/gibranct/admin_do_catalogo/pkg/test"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestArtwork(t *testing.T) {
+	t.Cleanup(cleanUp)
+	ts, app := runTestServer()
+	defer ts.Close()
+
+	_, output := app.useCases.Video.Create.Execute(video_usecase.CreateVideoCommand{
+		Title:       "dummy title",
+		Description: "dummy desc",
+		LaunchedAt:  2025,
+		Duration:    120.0,
+		Rating:      "Livre",
+	})
+	artworkUrl := fmt.Sprintf("%s/v1/videos/%d/artwork", ts.URL, output.ID)
+	poster := test.DummyPNG(600, 900)
+	var neutral, localized video_usecase.ArtworkOutput
+
+	t.Run("should return 201 when the artwork is uploaded", func(t *testing.T) {
+		body, contentType := multipartBody(map[string]string{"role": "POSTER"}, "poster.png", poster)
+		resp, err := http.Post(artworkUrl, contentType, body)
+		json.NewDecoder(resp.Body).Decode(&neutral)
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusCreated, resp.StatusCode)
+		assert.Equal(t, "POSTER", neutral.Role)
+		assert.Equal(t, "2:3", neutral.AspectRatio)
+		assert.Equal(t, 600, neutral.Width)
+
+		body, contentType = multipartBody(map[string]string{"role": "POSTER", "language": "pt-BR"}, "poster.pt.png", test.DummyPNG(1200, 1800))
+		resp, err = http.Post(artworkUrl, contentType, body)
+		json.NewDecoder(resp.Body).Decode(&localized)
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusCreated, resp.StatusCode)
+	})
+
+	t.Run("should return 400 when the artwork does not fit its role", func(t *testing.T) {
+		body, contentType := multipartBody(map[string]string{"role": "BACKDROP"}, "backdrop.png", poster)
+		resp, err := http.Post(artworkUrl, contentType, body)
+		var payload struct {
+			Errors []string `json:"errors"`
+		}
+		json.NewDecoder(resp.Body).Decode(&payload)
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		assert.Len(t, payload.Errors, 2)
+	})
+
+	t.Run("should select the best artwork", func(t *testing.T) {
+		resp, err := http.Get(artworkUrl + "/best?role=POSTER&language=pt&width=500")
+		var best video_usecase.ArtworkOutput
+		json.NewDecoder(resp.Body).Decode(&best)
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, localized.ID, best.ID)
+
+		resp, err = http.Get(artworkUrl + "/best?role=POSTER&language=en&width=500")
+		json.NewDecoder(resp.Body).Decode(&best)
+
+		assert.Nil(t, err)
+		assert.Equal(t, neutral.ID, best.ID)
+	})
+
+	t.Run("should return 404 when no artwork matches", func(t *testing.T) {
+		resp, err := http.Get(artworkUrl + "/best?role=LOGO")
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+
+	t.Run("should return 400 when the selector is invalid", func(t *testing.T) {
+		resp, err := http.Get(artworkUrl + "/best?role=BANNER")
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("should serve the artwork image", func(t *testing.T) {
+		resp, err := http.Get(fmt.Sprintf("%s/%d", artworkUrl, neutral.ID))
+		content, _ := io.ReadAll(resp.Body)
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, poster, content)
+	})
+
+	t.Run("should return 204 when the artwork is deleted", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodDelete, fmt.Sprintf("%s/%d", artworkUrl, neutral.ID), nil)
+		resp, err := http.DefaultClient.Do(req)
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+		resp, err = http.Get(artworkUrl)
+		var body struct {
+			Artwork []video_usecase.ArtworkOutput `json:"artwork"`
+		}
+		json.NewDecoder(resp.Body).Decode(&body)
+
+		assert.Nil(t, err)
+		assert.Len(t, body.Artwork, 1)
+	})
+
+	t.Run("should return 404 when the artwork does not exist", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodDelete, fmt.Sprintf("%s/%d", artworkUrl, neutral.ID), nil)
+		resp, err := http.DefaultClient.Do(req)
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+}
@@ -0,0 +1,125 @@
+package main
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/video"
+	video_usecase "github.com.br/gibranct/admin_do_catalogo/internal/usecases/video"
+	"github.com/go-chi/chi/v5"
+)
+
+func (app *application) uploadAudioTrackHandler(w http.ResponseWriter, r *http.Request) {
+	videoId, ok := app.readVideoId(w, r)
+	if !ok {
+		return
+	}
+
+	rc := http.NewResponseController(w)
+	rc.SetReadDeadline(time.Time{})
+	rc.SetWriteDeadline(time.Time{})
+
+	reader, err := r.MultipartReader()
+	if err != nil {
+		app.badRequestResponse(w, err)
+		return
+	}
+
+	fields := map[string]string{}
+
+	for {
+		part, err := reader.NextPart()
+		if errors.Is(err, io.EOF) {
+			app.badRequestResponse(w, errors.New("multipart body must contain a 'file' part"))
+			return
+		}
+
+		if err != nil {
+			app.badRequestResponse(w, err)
+			return
+		}
+
+		switch part.FormName() {
+		case "language", "role", "checksum":
+			value, err := io.ReadAll(io.LimitReader(part, 256))
+			if err != nil {
+				app.badRequestResponse(w, err)
+				return
+			}
+			fields[part.FormName()] = string(value)
+			continue
+		case "file":
+		default:
+			continue
+		}
+
+		output, err := app.useCases.Video.UploadAudioTrack.Execute(video_usecase.UploadAudioTrackCommand{
+			VideoId:  videoId,
+			Language: fields["language"],
+			Role:     fields["role"],
+			Resource: video.Resource{
+				Stream:      part,
+				Checksum:    fields["checksum"],
+				ContentType: part.Header.Get("Content-Type"),
+				Name:        part.FileName(),
+			},
+		})
+
+		var invalidErr video_usecase.InvalidMediaError
+
+		switch {
+		case errors.Is(err, video.ErrVideoNotFound):
+			app.notFoundResponse(w)
+		case errors.As(err, &invalidErr):
+			app.writeError(w, http.StatusBadRequest, "Could not upload audio track", invalidErr.Notification)
+		case errors.Is(err, video.ErrChecksumMismatch):
+			app.badRequestResponse(w, err)
+		case err != nil:
+			app.serverErrorResponse(w, err)
+		default:
+			app.writeJson(w, http.StatusCreated, output, nil)
+		}
+		return
+	}
+}
+
+func (app *application) listAudioTracksHandler(w http.ResponseWriter, r *http.Request) {
+	videoId, ok := app.readVideoId(w, r)
+	if !ok {
+		return
+	}
+
+	outputs, err := app.useCases.Video.ListAudioTracks.Execute(videoId)
+
+	switch {
+	case errors.Is(err, video.ErrVideoNotFound):
+		app.notFoundResponse(w)
+	case err != nil:
+		app.serverErrorResponse(w, err)
+	default:
+		app.writeJson(w, http.StatusOK, envelope{"audioTracks": outputs}, nil)
+	}
+}
+
+func (app *application) deleteAudioTrackHandler(w http.ResponseWriter, r *http.Request) {
+	videoId, ok := app.readVideoId(w, r)
+	if !ok {
+		return
+	}
+
+	err := app.useCases.Video.DeleteAudioTrack.Execute(video_usecase.DeleteAudioTrackCommand{
+		VideoId:  videoId,
+		Language: chi.URLParam(r, "language"),
+	})
+
+	switch {
+	case errors.Is(err, video.ErrVideoNotFound), errors.Is(err, video.ErrResourceNotFound):
+		app.notFoundResponse(w)
+	case err != nil:
+		app.serverErrorResponse(w, err)
+	default:
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
@@ -0,0 +1,90 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	video_usecase "github.com.br/gibranct/admin_do_catalogo/internal/usecases/video"
+	"github.com.br/gibranct/admin_do_catalogo/pkg/test"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAudioTracks(t *testing.T) {
+	t.Cleanup(cleanUp)
+	ts, app := runTestServer()
+	defer ts.Close()
+
+	_, output := app.useCases.Video.Create.Execute(video_usecase.CreateVideoCommand{
+		Title:       "dummy title",
+		Description: "dummy desc",
+		LaunchedAt:  2025,
+		Duration:    120.0,
+		Rating:      "Livre",
+	})
+	tracksUrl := fmt.Sprintf("%s/v1/videos/%d/audio-tracks", ts.URL, output.ID)
+	mp3 := []byte("ID3 dummy audio content")
+
+	t.Run("should return 201 when the track is uploaded", func(t *testing.T) {
+		body, contentType := multipartBody(map[string]string{"language": "pt-BR", "role": "ORIGINAL"}, "movie.pt-BR.mp3", mp3)
+		resp, err := http.Post(tracksUrl, contentType, body)
+		var track video_usecase.AudioTrackOutput
+		json.NewDecoder(resp.Body).Decode(&track)
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusCreated, resp.StatusCode)
+		assert.Equal(t, "pt-BR", track.Language)
+		assert.Equal(t, "ORIGINAL", track.Role)
+		assert.NotNil(t, track.Media)
+	})
+
+	t.Run("should accept a dub in an audio only container", func(t *testing.T) {
+		body, contentType := multipartBody(map[string]string{"language": "en", "role": "DUB"}, "movie.en.mp4", test.DummyMP4("audio"))
+		resp, err := http.Post(tracksUrl, contentType, body)
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusCreated, resp.StatusCode)
+	})
+
+	t.Run("should return 400 when a second original track is uploaded", func(t *testing.T) {
+		body, contentType := multipartBody(map[string]string{"language": "es", "role": "ORIGINAL"}, "movie.es.mp3", mp3)
+		resp, err := http.Post(tracksUrl, contentType, body)
+		var payload struct {
+			Errors []string `json:"errors"`
+		}
+		json.NewDecoder(resp.Body).Decode(&payload)
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		assert.Equal(t, []string{"'pt-BR' is already the ORIGINAL audio track"}, payload.Errors)
+	})
+
+	t.Run("should list the tracks of the video", func(t *testing.T) {
+		resp, err := http.Get(tracksUrl)
+		var body struct {
+			AudioTracks []video_usecase.AudioTrackOutput `json:"audioTracks"`
+		}
+		json.NewDecoder(resp.Body).Decode(&body)
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Len(t, body.AudioTracks, 2)
+	})
+
+	t.Run("should return 204 when the track is deleted", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodDelete, tracksUrl+"/en", nil)
+		resp, err := http.DefaultClient.Do(req)
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+	})
+
+	t.Run("should return 404 when the track does not exist", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodDelete, tracksUrl+"/en", nil)
+		resp, err := http.DefaultClient.Do(req)
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+}
@@ -11,9 +11,11 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/video"
 	usecase "github.com.br/gibranct/admin_do_catalogo/internal/usecases"
 	category_usecase "github.com.br/gibranct/admin_do_catalogo/internal/usecases/category"
 	"github.com.br/gibranct/admin_do_catalogo/pkg/test"
@@ -28,6 +30,7 @@ type databaseContainer struct {
 }
 
 var dbContainer *databaseContainer
+var mediaRootDir string
 
 func TestMain(t *testing.M) {
 	ctx := context.Background()
@@ -41,8 +44,10 @@ func TestMain(t *testing.M) {
 		connectionString:  connString,
 		PostgresContainer: container,
 	}
+	mediaRootDir, _ = os.MkdirTemp("", "media")
 	code := t.Run()
 	container.Terminate(ctx)
+	os.RemoveAll(mediaRootDir)
 	os.Exit(code)
 }
 
@@ -69,7 +74,7 @@ func runTestServer() (*httptest.Server, *application) {
 	dbContainer.db = db
 	app := &application{
 		logger:   slog.New(slog.NewTextHandler(os.Stdout, nil)),
-		useCases: usecase.NewUseCases(db),
+		useCases: usecase.NewUseCases(db, mediaRootDir, filepath.Join(mediaRootDir, "encoded"), video.DefaultReadinessRules(), nil),
 		config:   cfg,
 	}
 	return httptest.NewServer(app.routes()), app
@@ -80,11 +85,14 @@ func cleanUp() {
 	if err != nil {
 		log.Fatalf("failed to create transaction: %s", err)
 	}
+	tx.Exec("DELETE FROM videos_uploads")
 	tx.Exec("DELETE FROM videos_video_media")
 	tx.Exec("DELETE FROM videos_image_media")
 	tx.Exec("DELETE FROM videos_categories")
 	tx.Exec("DELETE FROM videos_genres")
 	tx.Exec("DELETE FROM videos_cast_members")
+	tx.Exec("DELETE FROM encoding_profiles")
+	tx.Exec("DELETE FROM series")
 	tx.Exec("DELETE FROM videos")
 	tx.Exec("DELETE FROM categories")
 	tx.Exec("DELETE FROM cast_members")
@@ -0,0 +1,110 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/video"
+	infra_messaging "github.com.br/gibranct/admin_do_catalogo/internal/infra/messaging"
+	video_usecase "github.com.br/gibranct/admin_do_catalogo/internal/usecases/video"
+)
+
+const encoderReconnectDelay = 5 * time.Second
+
+type encoderResult struct {
+	VideoId       int64              `json:"videoId"`
+	ResourceId    string             `json:"resourceId"`
+	Status        string             `json:"status"`
+	EncodedPath   string             `json:"encodedPath"`
+	FailureReason string             `json:"failureReason"`
+	Renditions    []encodedRendition `json:"renditions"`
+}
+
+type encodedRendition struct {
+	Bandwidth       int64    `json:"bandwidth"`
+	Width           int      `json:"width"`
+	Height          int      `json:"height"`
+	Codecs          []string `json:"codecs"`
+	Location        string   `json:"location"`
+	SegmentDuration float64  `json:"segmentDuration"`
+}
+
+func (app *application) consumeEncoderResults(ctx context.Context) {
+	if app.encoderConsumer == nil {
+		app.logger.Info("encoder result consumer disabled")
+		return
+	}
+
+	for {
+		err := app.encoderConsumer.Consume(ctx, app.handleEncoderResult)
+
+		if ctx.Err() != nil {
+			return
+		}
+
+		if err != nil {
+			app.logger.Error("encoder result consumer stopped", "error", err.Error())
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(encoderReconnectDelay):
+		}
+	}
+}
+
+func (app *application) handleEncoderResult(body []byte) error {
+	var message encoderResult
+
+	if err := json.Unmarshal(body, &message); err != nil {
+		return infra_messaging.Permanent(fmt.Errorf("invalid encoder result: %w", err))
+	}
+
+	if message.VideoId <= 0 || message.ResourceId == "" {
+		return infra_messaging.Permanent(errors.New("encoder result must contain 'videoId' and 'resourceId'"))
+	}
+
+	renditions := []video_usecase.RenditionCommand{}
+	for _, r := range message.Renditions {
+		renditions = append(renditions, video_usecase.RenditionCommand(r))
+	}
+
+	err := app.useCases.Video.UpdateMediaStatus.Execute(video_usecase.UpdateMediaStatusCommand{
+		VideoId:       message.VideoId,
+		ResourceId:    message.ResourceId,
+		Status:        message.Status,
+		EncodedPath:   message.EncodedPath,
+		FailureReason: message.FailureReason,
+		Renditions:    renditions,
+	})
+
+	if err != nil {
+		err = fmt.Errorf("could not update media %s of video %d: %w", message.ResourceId, message.VideoId, err)
+		if rejectedResult(err) {
+			return infra_messaging.Permanent(err)
+		}
+		return err
+	}
+
+	app.logger.Info("media status updated", "videoId", message.VideoId, "resourceId", message.ResourceId, "status", message.Status)
+
+	return nil
+}
+
+// a result the catalog refuses is refused again when redelivered, any other
+// failure (the database, the disk) may be gone by then
+func rejectedResult(err error) bool {
+	var invalidMedia video_usecase.InvalidMediaError
+	var invalidTransition video.InvalidMediaStatusTransitionError
+
+	return errors.As(err, &invalidMedia) ||
+		errors.As(err, &invalidTransition) ||
+		errors.Is(err, video.ErrVideoNotFound) ||
+		errors.Is(err, video.ErrResourceNotFound) ||
+		errors.Is(err, video.ErrUnknownMediaStatus) ||
+		errors.Is(err, video.ErrUnsupportedEncoderStatus)
+}
@@ -0,0 +1,149 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/encoding"
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/video"
+	infra_encoding "github.com.br/gibranct/admin_do_catalogo/internal/infra/encoding"
+	infra_messaging "github.com.br/gibranct/admin_do_catalogo/internal/infra/messaging"
+	video_usecase "github.com.br/gibranct/admin_do_catalogo/internal/usecases/video"
+	"github.com.br/gibranct/admin_do_catalogo/pkg/test"
+	amqp "github.com/rabbitmq/amqp091-go"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	resultsQueue     = "encoder.results"
+	deadLetterQueue  = "encoder.results.dlq"
+	encodingJobQueue = "video.encode"
+)
+
+func TestAMQPEncoderResults(t *testing.T) {
+	t.Cleanup(cleanUp)
+	ctx := context.Background()
+	url, broker, _ := test.InitBroker(ctx)
+	defer broker.Terminate(ctx)
+
+	// rejected results are dead-lettered, so the test can tell them apart from lost ones
+	broker.Exec(ctx, []string{
+		"rabbitmqctl", "set_policy", "encoder-results-dlx", `^encoder\.results$`,
+		fmt.Sprintf(`{"dead-letter-exchange":"","dead-letter-routing-key":"%s"}`, deadLetterQueue),
+		"--apply-to", "queues",
+	})
+
+	ts, app := runTestServer()
+	defer ts.Close()
+
+	_, output := app.useCases.Video.Create.Execute(video_usecase.CreateVideoCommand{
+		Title:       "dummy title",
+		Description: "dummy desc",
+		LaunchedAt:  2025,
+		Duration:    120.0,
+		Rating:      "Livre",
+		Video:       &video.Resource{Content: test.DummyMP4("video"), Name: "movie.mp4"},
+	})
+	created, _ := app.useCases.Video.FindOne.Execute(output.ID)
+
+	conn, err := amqp.Dial(url)
+	if err != nil {
+		t.Fatalf("failed to connect to broker: %s", err)
+	}
+	defer conn.Close()
+	ch, _ := conn.Channel()
+	defer ch.Close()
+	ch.QueueDeclare(deadLetterQueue, true, false, false, false, nil)
+
+	publisher := infra_messaging.NewAMQPPublisher(url, resultsQueue)
+	defer publisher.Close()
+	app.encoderConsumer = infra_messaging.NewAMQPConsumer(url, resultsQueue, app.logger)
+	consumerCtx, cancel := context.WithCancel(ctx)
+	stopped := make(chan struct{})
+	go func() {
+		app.consumeEncoderResults(consumerCtx)
+		close(stopped)
+	}()
+
+	t.Run("should ack the result once the media status is updated", func(t *testing.T) {
+		err := publisher.Publish(ctx, []byte(fmt.Sprintf(
+			`{"videoId": %d, "resourceId": "%d", "status": "PROCESSING"}`, output.ID, created.Video.ID,
+		)))
+
+		assert.Nil(t, err)
+		assert.Eventually(t, func() bool {
+			found, _ := app.useCases.Video.FindOne.Execute(output.ID)
+			return found.Video.Status == "PROCESSING"
+		}, 5*time.Second, 20*time.Millisecond)
+	})
+
+	t.Run("should dead-letter a result for an unknown video", func(t *testing.T) {
+		body := fmt.Sprintf(`{"videoId": %d, "resourceId": "%d", "status": "COMPLETED"}`, output.ID+1000, created.Video.ID)
+
+		err := publisher.Publish(ctx, []byte(body))
+
+		assert.Nil(t, err)
+		assert.Eventually(t, func() bool {
+			delivery, ok, _ := ch.Get(deadLetterQueue, true)
+			return ok && string(delivery.Body) == body
+		}, 5*time.Second, 20*time.Millisecond)
+		found, _ := app.useCases.Video.FindOne.Execute(output.ID)
+		assert.Equal(t, "PROCESSING", found.Video.Status)
+	})
+
+	t.Run("should leave no result unacknowledged", func(t *testing.T) {
+		cancel()
+		<-stopped
+
+		// unacknowledged deliveries go back to the queue once the consumer disconnects
+		queue, err := ch.QueueDeclarePassive(resultsQueue, true, false, false, false, nil)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 0, queue.Messages)
+	})
+}
+
+func TestAMQPEncodingJobRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	url, broker, _ := test.InitBroker(ctx)
+	defer broker.Terminate(ctx)
+
+	publisher := infra_messaging.NewAMQPPublisher(url, encodingJobQueue)
+	defer publisher.Close()
+	sut := infra_encoding.NewJobPublisher(publisher)
+	consumer := infra_messaging.NewAMQPConsumer(url, encodingJobQueue, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	status := video.PENDING
+	media := video.NewAudioVideoMediaWith(15, &status, "sum", "movie.mp4", "/videos/1/video/movie.mp4", "")
+	profile := encoding.NewProfile("default", []encoding.ProfileRendition{
+		*encoding.NewProfileRendition(640, 360, 800_000, "h264", "fmp4"),
+	})
+	profile.ID = 3
+
+	err := sut.Publish(*encoding.NewJob(1, video.VIDEO, *media, profile))
+	assert.Nil(t, err)
+
+	consumerCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	received := make(chan []byte, 1)
+	go consumer.Consume(consumerCtx, func(body []byte) error {
+		received <- body
+		return nil
+	})
+
+	select {
+	case body := <-received:
+		var job map[string]any
+		assert.Nil(t, json.Unmarshal(body, &job))
+		assert.Equal(t, "15", job["resourceId"])
+		assert.Equal(t, "Video", job["mediaType"])
+		assert.Equal(t, "/videos/1/video/movie.mp4", job["rawLocation"])
+		assert.Equal(t, "default", job["profile"].(map[string]any)["name"])
+	case <-consumerCtx.Done():
+		t.Fatal("the encoding job was not consumed")
+	}
+}
@@ -0,0 +1,109 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/video"
+	infra_messaging "github.com.br/gibranct/admin_do_catalogo/internal/infra/messaging"
+	video_usecase "github.com.br/gibranct/admin_do_catalogo/internal/usecases/video"
+	"github.com.br/gibranct/admin_do_catalogo/pkg/test"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncoderResultConsumer(t *testing.T) {
+	t.Cleanup(cleanUp)
+	ts, app := runTestServer()
+	defer ts.Close()
+
+	_, output := app.useCases.Video.Create.Execute(video_usecase.CreateVideoCommand{
+		Title:       "dummy title",
+		Description: "dummy desc",
+		LaunchedAt:  2025,
+		Duration:    120.0,
+		Rating:      "Livre",
+		Video:       &video.Resource{Content: test.DummyMP4("video"), Name: "movie.mp4"},
+	})
+	created, _ := app.useCases.Video.FindOne.Execute(output.ID)
+
+	messages := make(chan []byte, 3)
+	app.encoderConsumer = infra_messaging.NewChannelConsumer(messages, app.logger)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go app.consumeEncoderResults(ctx)
+
+	waitForStatus := func(status string) *video_usecase.VideoOutput {
+		var found *video_usecase.VideoOutput
+		assert.Eventually(t, func() bool {
+			found, _ = app.useCases.Video.FindOne.Execute(output.ID)
+			return found.Video.Status == status
+		}, 2*time.Second, 10*time.Millisecond)
+		return found
+	}
+
+	t.Run("should move the media to processing", func(t *testing.T) {
+		messages <- []byte(fmt.Sprintf(`{"videoId": %d, "resourceId": "%d", "status": "PROCESSING"}`, output.ID, created.Video.ID))
+
+		found := waitForStatus("PROCESSING")
+		assert.Equal(t, "", found.Video.EncodedLocation)
+	})
+
+	t.Run("should keep consuming after an invalid message", func(t *testing.T) {
+		messages <- []byte(`{"videoId": "broken"`)
+		messages <- []byte(fmt.Sprintf(
+			`{"videoId": %d, "resourceId": "%d", "status": "COMPLETED", "encodedPath": "/encoded/movie", "renditions": [
+				{"bandwidth": 5000000, "width": 1920, "height": 1080, "codecs": ["avc1.640028", "mp4a.40.2"], "location": "/encoded/movie/1080p", "segmentDuration": 6},
+				{"bandwidth": 800000, "width": 640, "height": 360, "codecs": ["avc1.42c01e", "mp4a.40.2"], "location": "/encoded/movie/360p", "segmentDuration": 6}
+			]}`,
+			output.ID, created.Video.ID,
+		))
+
+		found := waitForStatus("COMPLETED")
+		assert.Equal(t, "/encoded/movie", found.Video.EncodedLocation)
+		assert.Len(t, found.Video.Renditions, 2)
+		assert.Equal(t, "640x360", found.Video.Renditions[0].Resolution)
+	})
+
+	t.Run("should serve the manifests of the encoded renditions", func(t *testing.T) {
+		resp, err := http.Get(fmt.Sprintf("%s/v1/videos/%d/medias/Video/master.m3u8", ts.URL, output.ID))
+		content, _ := io.ReadAll(resp.Body)
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "application/vnd.apple.mpegurl", resp.Header.Get("Content-Type"))
+		assert.Contains(t, string(content), "/encoded/movie/360p/index.m3u8")
+
+		// the dummy file carries no duration, which a static DASH manifest requires
+		resp, err = http.Get(fmt.Sprintf("%s/v1/videos/%d/medias/Video/manifest.mpd", ts.URL, output.ID))
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+		resp, err = http.Get(fmt.Sprintf("%s/v1/videos/%d/medias/Trailer/master.m3u8", ts.URL, output.ID))
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+
+	t.Run("should only reject results that fail the same way when redelivered", func(t *testing.T) {
+		var permanent infra_messaging.PermanentError
+
+		for _, body := range []string{
+			`{"videoId": "broken"`,
+			`{"videoId": 1}`,
+			fmt.Sprintf(`{"videoId": %d, "resourceId": "%d", "status": "COMPLETED"}`, output.ID+1000, created.Video.ID),
+			fmt.Sprintf(`{"videoId": %d, "resourceId": "%d", "status": "DONE"}`, output.ID, created.Video.ID),
+			fmt.Sprintf(`{"videoId": %d, "resourceId": "%d", "status": "PENDING"}`, output.ID, created.Video.ID),
+			fmt.Sprintf(`{"videoId": %d, "resourceId": "%d", "status": "PROCESSING"}`, output.ID, created.Video.ID),
+		} {
+			assert.ErrorAs(t, app.handleEncoderResult([]byte(body)), &permanent, body)
+		}
+
+		assert.False(t, rejectedResult(errors.New("connection refused")))
+	})
+}
@@ -0,0 +1,293 @@
+package main
+
+import (
+	"errors"
+	"net/http"
+	"slices"
+	"strconv"
+
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain"
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/category"
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/encoding"
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/video"
+	encoding_usecase "github.com.br/gibranct/admin_do_catalogo/internal/usecases/encoding"
+	"github.com.br/gibranct/admin_do_catalogo/pkg/notification"
+)
+
+type encodingProfileInput struct {
+	Name       string `json:"name"`
+	Renditions []struct {
+		Width     int    `json:"width"`
+		Height    int    `json:"height"`
+		Bitrate   int64  `json:"bitrate"`
+		Codec     string `json:"codec"`
+		Container string `json:"container"`
+	} `json:"renditions"`
+}
+
+type profileAssignmentInput struct {
+	ProfileId *int64 `json:"profileId"`
+}
+
+func (input encodingProfileInput) toRenditionCommands() []encoding_usecase.RenditionCommand {
+	commands := []encoding_usecase.RenditionCommand{}
+	for _, r := range input.Renditions {
+		commands = append(commands, encoding_usecase.RenditionCommand(r))
+	}
+	return commands
+}
+
+func (app *application) createEncodingProfileHandler(w http.ResponseWriter, r *http.Request) {
+	var input encodingProfileInput
+
+	err := app.readJSON(w, r, &input)
+	if err != nil {
+		app.badRequestResponse(w, err)
+		return
+	}
+
+	noti, output := app.useCases.EncodingProfile.Create.Execute(encoding_usecase.CreateProfileCommand{
+		Name:       input.Name,
+		Renditions: input.toRenditionCommands(),
+	})
+
+	if output != nil {
+		app.writeJson(w, http.StatusCreated, envelope{"id": output.ID}, nil)
+		return
+	}
+
+	err = app.writeError(w, http.StatusBadRequest, "Could not save encoding profile", noti)
+	if err != nil {
+		app.serverErrorResponse(w, err)
+	}
+}
+
+func (app *application) listEncodingProfilesHandler(w http.ResponseWriter, r *http.Request) {
+	qs := r.URL.Query()
+
+	page, err := strconv.Atoi(qs.Get("page"))
+	if err != nil {
+		app.badRequestResponse(w, err)
+		return
+	}
+
+	perPage, err := strconv.Atoi(qs.Get("perPage"))
+	if err != nil {
+		app.badRequestResponse(w, err)
+		return
+	}
+
+	output, err := app.useCases.EncodingProfile.FindAll.Execute(domain.SearchQuery{
+		Sort:      qs.Get("sort"),
+		Term:      qs.Get("search"),
+		Page:      page,
+		PerPage:   perPage,
+		Direction: qs.Get("dir"),
+	})
+
+	if err != nil {
+		app.badRequestResponse(w, err)
+		return
+	}
+
+	app.writeJson(w, http.StatusOK, output, nil)
+}
+
+func (app *application) getEncodingProfileByIdHandler(w http.ResponseWriter, r *http.Request) {
+	profileId, ok := app.readIdParam(w, r, "id")
+	if !ok {
+		return
+	}
+
+	output, err := app.useCases.EncodingProfile.FindOne.Execute(profileId)
+
+	if errors.Is(err, encoding.ErrProfileNotFound) {
+		app.notFoundResponse(w)
+		return
+	}
+
+	if err != nil {
+		app.serverErrorResponse(w, err)
+		return
+	}
+
+	err = app.writeJson(w, http.StatusOK, output, nil)
+	if err != nil {
+		app.serverErrorResponse(w, err)
+	}
+}
+
+func (app *application) updateEncodingProfileHandler(w http.ResponseWriter, r *http.Request) {
+	profileId, ok := app.readIdParam(w, r, "id")
+	if !ok {
+		return
+	}
+
+	var input encodingProfileInput
+
+	err := app.readJSON(w, r, &input)
+	if err != nil {
+		app.badRequestResponse(w, err)
+		return
+	}
+
+	noti := app.useCases.EncodingProfile.Update.Execute(encoding_usecase.UpdateProfileCommand{
+		ID:         profileId,
+		Name:       input.Name,
+		Renditions: input.toRenditionCommands(),
+	})
+
+	if noti == nil || !noti.HasErrors() {
+		app.writeJson(w, http.StatusOK, envelope{"id": profileId}, nil)
+		return
+	}
+
+	app.encodingProfileErrorResponse(w, noti, "Could not update encoding profile")
+}
+
+func (app *application) deleteEncodingProfileByIdHandler(w http.ResponseWriter, r *http.Request) {
+	profileId, ok := app.readIdParam(w, r, "id")
+	if !ok {
+		return
+	}
+
+	err := app.useCases.EncodingProfile.DeleteById.Execute(profileId)
+
+	if err != nil {
+		app.serverErrorResponse(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (app *application) getVideoEncodingProfileHandler(w http.ResponseWriter, r *http.Request) {
+	videoId, ok := app.readIdParam(w, r, "id")
+	if !ok {
+		return
+	}
+
+	output, err := app.useCases.EncodingProfile.GetVideoProfile.Execute(videoId)
+
+	if isEncodingProfileNotFound(err) {
+		app.notFoundResponse(w)
+		return
+	}
+
+	if err != nil {
+		app.serverErrorResponse(w, err)
+		return
+	}
+
+	err = app.writeJson(w, http.StatusOK, output, nil)
+	if err != nil {
+		app.serverErrorResponse(w, err)
+	}
+}
+
+func (app *application) assignVideoEncodingProfileHandler(w http.ResponseWriter, r *http.Request) {
+	videoId, ok := app.readIdParam(w, r, "id")
+	if !ok {
+		return
+	}
+
+	profileId, ok := app.readProfileAssignment(w, r)
+	if !ok {
+		return
+	}
+
+	app.assignVideoEncodingProfile(w, videoId, profileId)
+}
+
+func (app *application) unassignVideoEncodingProfileHandler(w http.ResponseWriter, r *http.Request) {
+	videoId, ok := app.readIdParam(w, r, "id")
+	if !ok {
+		return
+	}
+
+	app.assignVideoEncodingProfile(w, videoId, nil)
+}
+
+func (app *application) assignVideoEncodingProfile(w http.ResponseWriter, videoId int64, profileId *int64) {
+	noti := app.useCases.EncodingProfile.AssignVideo.Execute(encoding_usecase.AssignVideoProfileCommand{
+		VideoId:   videoId,
+		ProfileId: profileId,
+	})
+
+	if noti == nil || !noti.HasErrors() {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	app.encodingProfileErrorResponse(w, noti, "Could not assign encoding profile")
+}
+
+func (app *application) assignCategoryEncodingProfileHandler(w http.ResponseWriter, r *http.Request) {
+	categoryId, ok := app.readIdParam(w, r, "id")
+	if !ok {
+		return
+	}
+
+	profileId, ok := app.readProfileAssignment(w, r)
+	if !ok {
+		return
+	}
+
+	app.assignCategoryEncodingProfile(w, categoryId, profileId)
+}
+
+func (app *application) unassignCategoryEncodingProfileHandler(w http.ResponseWriter, r *http.Request) {
+	categoryId, ok := app.readIdParam(w, r, "id")
+	if !ok {
+		return
+	}
+
+	app.assignCategoryEncodingProfile(w, categoryId, nil)
+}
+
+func (app *application) assignCategoryEncodingProfile(w http.ResponseWriter, categoryId int64, profileId *int64) {
+	noti := app.useCases.EncodingProfile.AssignCategory.Execute(encoding_usecase.AssignCategoryProfileCommand{
+		CategoryId: categoryId,
+		ProfileId:  profileId,
+	})
+
+	if noti == nil || !noti.HasErrors() {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	app.encodingProfileErrorResponse(w, noti, "Could not assign encoding profile")
+}
+
+func (app *application) readProfileAssignment(w http.ResponseWriter, r *http.Request) (*int64, bool) {
+	var input profileAssignmentInput
+
+	if err := app.readJSON(w, r, &input); err != nil {
+		app.badRequestResponse(w, err)
+		return nil, false
+	}
+
+	if input.ProfileId == nil {
+		app.badRequestResponse(w, errors.New("'profileId' should not be null"))
+		return nil, false
+	}
+
+	return input.ProfileId, true
+}
+
+func (app *application) encodingProfileErrorResponse(w http.ResponseWriter, noti *notification.Notification, msg string) {
+	if slices.ContainsFunc(noti.GetErrors(), isEncodingProfileNotFound) {
+		app.notFoundResponse(w)
+		return
+	}
+
+	if err := app.writeError(w, http.StatusBadRequest, msg, noti); err != nil {
+		app.serverErrorResponse(w, err)
+	}
+}
+
+func isEncodingProfileNotFound(err error) bool {
+	return errors.Is(err, encoding.ErrProfileNotFound) ||
+		errors.Is(err, video.ErrVideoNotFound) ||
+		errors.Is(err, category.ErrCategoryNotFound)
+}
@@ -0,0 +1,167 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"testing"
+
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/video"
+	infra_messaging "github.com.br/gibranct/admin_do_catalogo/internal/infra/messaging"
+	usecase "github.com.br/gibranct/admin_do_catalogo/internal/usecases"
+	category_usecase "github.com.br/gibranct/admin_do_catalogo/internal/usecases/category"
+	encoding_usecase "github.com.br/gibranct/admin_do_catalogo/internal/usecases/encoding"
+	video_usecase "github.com.br/gibranct/admin_do_catalogo/internal/usecases/video"
+	"github.com.br/gibranct/admin_do_catalogo/pkg/test"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodingProfiles(t *testing.T) {
+	t.Cleanup(cleanUp)
+	ts, app := runTestServer()
+	defer ts.Close()
+
+	jobs := make(chan []byte, 3)
+	app.useCases = usecase.NewUseCases(dbContainer.db, mediaRootDir, filepath.Join(mediaRootDir, "encoded"), video.DefaultReadinessRules(), infra_messaging.NewChannelPublisher(jobs))
+
+	_, aCategory := app.useCases.Category.Create.Execute(category_usecase.CreateCategoryCommand{
+		Name:        "movies",
+		Description: "dummy desc",
+	})
+
+	var profileId int64
+
+	t.Run("should create an encoding profile", func(t *testing.T) {
+		data, _ := json.Marshal(map[string]any{
+			"name": "default",
+			"renditions": []map[string]any{
+				{"width": 1920, "height": 1080, "bitrate": 5_000_000, "codec": "h264", "container": "fmp4"},
+				{"width": 640, "height": 360, "bitrate": 800_000, "codec": "h264", "container": "fmp4"},
+			},
+		})
+		resp, err := http.Post(fmt.Sprintf("%s/v1/encoding-profiles", ts.URL), conTypeApplicationJson, bytes.NewReader(data))
+		var body struct {
+			ID int64 `json:"id"`
+		}
+		json.NewDecoder(resp.Body).Decode(&body)
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusCreated, resp.StatusCode)
+		profileId = body.ID
+	})
+
+	t.Run("should reject an invalid encoding profile", func(t *testing.T) {
+		data, _ := json.Marshal(map[string]any{
+			"name":       "broken",
+			"renditions": []map[string]any{{"width": 1920, "height": 1080, "bitrate": 1, "codec": "mpeg2", "container": "fmp4"}},
+		})
+		resp, err := http.Post(fmt.Sprintf("%s/v1/encoding-profiles", ts.URL), conTypeApplicationJson, bytes.NewReader(data))
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("should list the encoding profiles", func(t *testing.T) {
+		resp, err := http.Get(fmt.Sprintf("%s/v1/encoding-profiles?page=1&perPage=10", ts.URL))
+		var body struct {
+			Total int                              `json:"total"`
+			Items []encoding_usecase.ProfileOutput `json:"items"`
+		}
+		json.NewDecoder(resp.Body).Decode(&body)
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, 1, body.Total)
+		assert.Len(t, body.Items[0].Renditions, 2)
+	})
+
+	t.Run("should make the profile the category default", func(t *testing.T) {
+		data, _ := json.Marshal(map[string]any{"profileId": profileId})
+		req, _ := http.NewRequest(http.MethodPut, fmt.Sprintf("%s/v1/categories/%d/encoding-profile", ts.URL, aCategory.ID), bytes.NewReader(data))
+		resp, err := http.DefaultClient.Do(req)
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+	})
+
+	var videoId int64
+
+	t.Run("should publish the resolved profile when the video media is pending", func(t *testing.T) {
+		_, output := app.useCases.Video.Create.Execute(video_usecase.CreateVideoCommand{
+			Title:       "dummy title",
+			Description: "dummy desc",
+			LaunchedAt:  2025,
+			Duration:    120.0,
+			Rating:      "Livre",
+			CategoryIds: []int64{aCategory.ID},
+			Video:       &video.Resource{Content: test.DummyMP4("video"), Name: "movie.mp4"},
+		})
+		videoId = output.ID
+
+		var job struct {
+			VideoId   int64  `json:"videoId"`
+			MediaType string `json:"mediaType"`
+			Profile   struct {
+				ID         int64            `json:"id"`
+				Renditions []map[string]any `json:"renditions"`
+			} `json:"profile"`
+		}
+		json.Unmarshal(<-jobs, &job)
+
+		assert.Equal(t, videoId, job.VideoId)
+		assert.Equal(t, "Video", job.MediaType)
+		assert.Equal(t, profileId, job.Profile.ID)
+		assert.Len(t, job.Profile.Renditions, 2)
+
+		resp, err := http.Get(fmt.Sprintf("%s/v1/videos/%d/encoding-profile", ts.URL, videoId))
+		var body encoding_usecase.ResolvedProfileOutput
+		json.NewDecoder(resp.Body).Decode(&body)
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "CATEGORY", body.Source)
+	})
+
+	t.Run("should fail the media when the expected renditions are missing", func(t *testing.T) {
+		found, _ := app.useCases.Video.FindOne.Execute(videoId)
+
+		err := app.useCases.Video.UpdateMediaStatus.Execute(video_usecase.UpdateMediaStatusCommand{
+			VideoId:     videoId,
+			ResourceId:  fmt.Sprint(found.Video.ID),
+			Status:      "COMPLETED",
+			EncodedPath: "/encoded/movie",
+			Renditions: []video_usecase.RenditionCommand{
+				{Bandwidth: 5_000_000, Width: 1920, Height: 1080, Codecs: []string{"avc1.640028"}, Location: "/encoded/movie/1080p", SegmentDuration: 6},
+			},
+		})
+		found, _ = app.useCases.Video.FindOne.Execute(videoId)
+
+		assert.Nil(t, err)
+		assert.Equal(t, "FAILED", found.Video.Status)
+		assert.Equal(t, "encoder did not produce the renditions expected by profile 'default': 640x360 h264", found.Video.FailureReason)
+	})
+
+	t.Run("should not assign a missing profile", func(t *testing.T) {
+		data, _ := json.Marshal(map[string]any{"profileId": profileId + 1})
+		req, _ := http.NewRequest(http.MethodPut, fmt.Sprintf("%s/v1/videos/%d/encoding-profile", ts.URL, videoId), bytes.NewReader(data))
+		resp, err := http.DefaultClient.Do(req)
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+
+	t.Run("should delete the encoding profile and its assignments", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodDelete, fmt.Sprintf("%s/v1/encoding-profiles/%d", ts.URL, profileId), nil)
+		resp, err := http.DefaultClient.Do(req)
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+		resp, err = http.Get(fmt.Sprintf("%s/v1/videos/%d/encoding-profile", ts.URL, videoId))
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+}
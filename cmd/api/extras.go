@@ -0,0 +1,275 @@
+package main
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/video"
+	video_usecase "github.com.br/gibranct/admin_do_catalogo/internal/usecases/video"
+	"github.com.br/gibranct/admin_do_catalogo/pkg/notification"
+)
+
+type extraInput struct {
+	Type  string `json:"type"`
+	Title string `json:"title"`
+}
+
+func (app *application) createExtraHandler(w http.ResponseWriter, r *http.Request) {
+	videoId, ok := app.readVideoId(w, r)
+	if !ok {
+		return
+	}
+
+	var input extraInput
+
+	if err := app.readJSON(w, r, &input); err != nil {
+		app.badRequestResponse(w, err)
+		return
+	}
+
+	noti, output := app.useCases.Video.CreateExtra.Execute(video_usecase.CreateExtraCommand{
+		VideoId: videoId,
+		Type:    input.Type,
+		Title:   input.Title,
+	})
+
+	if output != nil {
+		app.writeJson(w, http.StatusCreated, output, nil)
+		return
+	}
+
+	app.extraErrorResponse(w, noti, "Could not save extra")
+}
+
+func (app *application) listExtrasHandler(w http.ResponseWriter, r *http.Request) {
+	videoId, ok := app.readVideoId(w, r)
+	if !ok {
+		return
+	}
+
+	outputs, err := app.useCases.Video.ListExtras.Execute(videoId)
+
+	switch {
+	case errors.Is(err, video.ErrVideoNotFound):
+		app.notFoundResponse(w)
+	case err != nil:
+		app.serverErrorResponse(w, err)
+	default:
+		app.writeJson(w, http.StatusOK, envelope{"extras": outputs}, nil)
+	}
+}
+
+func (app *application) updateExtraHandler(w http.ResponseWriter, r *http.Request) {
+	videoId, extraId, ok := app.readExtraIds(w, r)
+	if !ok {
+		return
+	}
+
+	var input extraInput
+
+	if err := app.readJSON(w, r, &input); err != nil {
+		app.badRequestResponse(w, err)
+		return
+	}
+
+	noti, output := app.useCases.Video.UpdateExtra.Execute(video_usecase.UpdateExtraCommand{
+		VideoId: videoId,
+		ExtraId: extraId,
+		Type:    input.Type,
+		Title:   input.Title,
+	})
+
+	if output != nil {
+		app.writeJson(w, http.StatusOK, output, nil)
+		return
+	}
+
+	app.extraErrorResponse(w, noti, "Could not update extra")
+}
+
+func (app *application) deleteExtraHandler(w http.ResponseWriter, r *http.Request) {
+	videoId, extraId, ok := app.readExtraIds(w, r)
+	if !ok {
+		return
+	}
+
+	err := app.useCases.Video.DeleteExtra.Execute(video_usecase.ExtraCommand{VideoId: videoId, ExtraId: extraId})
+
+	switch {
+	case isExtraNotFound(err):
+		app.notFoundResponse(w)
+	case err != nil:
+		app.serverErrorResponse(w, err)
+	default:
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func (app *application) reorderExtrasHandler(w http.ResponseWriter, r *http.Request) {
+	videoId, ok := app.readVideoId(w, r)
+	if !ok {
+		return
+	}
+
+	var input struct {
+		ExtraIds []int64 `json:"extraIds"`
+	}
+
+	if err := app.readJSON(w, r, &input); err != nil {
+		app.badRequestResponse(w, err)
+		return
+	}
+
+	noti, outputs := app.useCases.Video.ReorderExtras.Execute(video_usecase.ReorderExtrasCommand{
+		VideoId:  videoId,
+		ExtraIds: input.ExtraIds,
+	})
+
+	if noti == nil || !noti.HasErrors() {
+		app.writeJson(w, http.StatusOK, envelope{"extras": outputs}, nil)
+		return
+	}
+
+	app.extraErrorResponse(w, noti, "Could not reorder extras")
+}
+
+func (app *application) promoteExtraHandler(w http.ResponseWriter, r *http.Request) {
+	videoId, extraId, ok := app.readExtraIds(w, r)
+	if !ok {
+		return
+	}
+
+	noti := app.useCases.Video.PromoteExtra.Execute(video_usecase.ExtraCommand{VideoId: videoId, ExtraId: extraId})
+
+	if noti == nil || !noti.HasErrors() {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	app.extraErrorResponse(w, noti, "Could not promote extra")
+}
+
+func (app *application) uploadExtraMediaHandler(w http.ResponseWriter, r *http.Request) {
+	videoId, extraId, ok := app.readExtraIds(w, r)
+	if !ok {
+		return
+	}
+
+	rc := http.NewResponseController(w)
+	rc.SetReadDeadline(time.Time{})
+	rc.SetWriteDeadline(time.Time{})
+
+	reader, err := r.MultipartReader()
+	if err != nil {
+		app.badRequestResponse(w, err)
+		return
+	}
+
+	var checksum string
+
+	for {
+		part, err := reader.NextPart()
+		if errors.Is(err, io.EOF) {
+			app.badRequestResponse(w, errors.New("multipart body must contain a 'file' part"))
+			return
+		}
+
+		if err != nil {
+			app.badRequestResponse(w, err)
+			return
+		}
+
+		if part.FormName() == "checksum" {
+			value, err := io.ReadAll(io.LimitReader(part, 256))
+			if err != nil {
+				app.badRequestResponse(w, err)
+				return
+			}
+			checksum = string(value)
+			continue
+		}
+
+		if part.FormName() != "file" {
+			continue
+		}
+
+		output, err := app.useCases.Video.UploadExtraMedia.Execute(video_usecase.UploadExtraMediaCommand{
+			VideoId: videoId,
+			ExtraId: extraId,
+			Resource: video.Resource{
+				Stream:      part,
+				Checksum:    checksum,
+				ContentType: part.Header.Get("Content-Type"),
+				Name:        part.FileName(),
+			},
+		})
+
+		var invalidErr video_usecase.InvalidMediaError
+
+		switch {
+		case isExtraNotFound(err):
+			app.notFoundResponse(w)
+		case errors.As(err, &invalidErr):
+			app.writeError(w, http.StatusBadRequest, "Could not upload extra media", invalidErr.Notification)
+		case errors.Is(err, video.ErrChecksumMismatch):
+			app.badRequestResponse(w, err)
+		case err != nil:
+			app.serverErrorResponse(w, err)
+		default:
+			app.writeJson(w, http.StatusCreated, output, nil)
+		}
+		return
+	}
+}
+
+func (app *application) getExtraMediaHandler(w http.ResponseWriter, r *http.Request) {
+	videoId, extraId, ok := app.readExtraIds(w, r)
+	if !ok {
+		return
+	}
+
+	resource, err := app.useCases.Video.GetExtraMedia.Execute(video_usecase.ExtraCommand{VideoId: videoId, ExtraId: extraId})
+
+	switch {
+	case isExtraNotFound(err), errors.Is(err, video.ErrResourceNotFound):
+		app.notFoundResponse(w)
+		return
+	case err != nil:
+		app.serverErrorResponse(w, err)
+		return
+	}
+
+	app.serveResource(w, r, resource)
+}
+
+func (app *application) readExtraIds(w http.ResponseWriter, r *http.Request) (int64, int64, bool) {
+	videoId, ok := app.readVideoId(w, r)
+	if !ok {
+		return 0, 0, false
+	}
+
+	extraId, ok := app.readIdParam(w, r, "extraId")
+	if !ok {
+		return 0, 0, false
+	}
+
+	return videoId, extraId, true
+}
+
+func (app *application) extraErrorResponse(w http.ResponseWriter, noti *notification.Notification, msg string) {
+	if slices.ContainsFunc(noti.GetErrors(), isExtraNotFound) {
+		app.notFoundResponse(w)
+		return
+	}
+
+	if err := app.writeError(w, http.StatusBadRequest, msg, noti); err != nil {
+		app.serverErrorResponse(w, err)
+	}
+}
+
+func isExtraNotFound(err error) bool {
+	return errors.Is(err, video.ErrVideoNotFound) || errors.Is(err, video.ErrExtraNotFound)
+}
@@ -0,0 +1,115 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	video_usecase "github.com.br/gibranct/admin_do_catalogo/internal/usecases/video"
+	"github.com.br/gibranct/admin_do_catalogo/pkg/test"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExtras(t *testing.T) {
+	t.Cleanup(cleanUp)
+	ts, app := runTestServer()
+	defer ts.Close()
+
+	_, output := app.useCases.Video.Create.Execute(video_usecase.CreateVideoCommand{
+		Title:       "dummy title",
+		Description: "dummy desc",
+		LaunchedAt:  2025,
+		Duration:    120.0,
+		Rating:      "Livre",
+	})
+	extrasUrl := fmt.Sprintf("%s/v1/videos/%d/extras", ts.URL, output.ID)
+
+	createExtra := func(body string) (*http.Response, video_usecase.ExtraOutput) {
+		resp, err := http.Post(extrasUrl, "application/json", bytes.NewBufferString(body))
+		assert.Nil(t, err)
+		var extra video_usecase.ExtraOutput
+		json.NewDecoder(resp.Body).Decode(&extra)
+		return resp, extra
+	}
+
+	send := func(method, url, body string) *http.Response {
+		req, _ := http.NewRequest(method, url, bytes.NewBufferString(body))
+		resp, err := http.DefaultClient.Do(req)
+		assert.Nil(t, err)
+		return resp
+	}
+
+	resp, trailer := createExtra(`{"type": "TRAILER", "title": "Official trailer"}`)
+	assert.Equal(t, http.StatusCreated, resp.StatusCode)
+	assert.Equal(t, 1, trailer.Position)
+
+	_, clip := createExtra(`{"type": "CLIP", "title": "Opening scene"}`)
+	assert.Equal(t, 2, clip.Position)
+
+	t.Run("should return 400 when the extra type is unknown", func(t *testing.T) {
+		resp, _ := createExtra(`{"type": "BLOOPER", "title": "Bloopers"}`)
+
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("should upload the extra media", func(t *testing.T) {
+		body, contentType := multipartBody(nil, "trailer.mp4", test.DummyMP4("trailer"))
+		resp, err := http.Post(fmt.Sprintf("%s/%d/media", extrasUrl, trailer.ID), contentType, body)
+		var extra video_usecase.ExtraOutput
+		json.NewDecoder(resp.Body).Decode(&extra)
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusCreated, resp.StatusCode)
+		assert.Equal(t, "PENDING", extra.Media.Status)
+	})
+
+	t.Run("should promote a trailer extra to primary trailer", func(t *testing.T) {
+		resp := send(http.MethodPost, fmt.Sprintf("%s/%d/primary", extrasUrl, trailer.ID), "")
+		assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+		resp, err := http.Get(fmt.Sprintf("%s/v1/videos/%d/medias/Trailer", ts.URL, output.ID))
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	})
+
+	t.Run("should return 400 when promoting an extra without media", func(t *testing.T) {
+		resp := send(http.MethodPost, fmt.Sprintf("%s/%d/primary", extrasUrl, clip.ID), "")
+
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("should reorder the extras", func(t *testing.T) {
+		resp := send(http.MethodPut, extrasUrl+"/order", fmt.Sprintf(`{"extraIds": [%d, %d]}`, clip.ID, trailer.ID))
+		var body struct {
+			Extras []video_usecase.ExtraOutput `json:"extras"`
+		}
+		json.NewDecoder(resp.Body).Decode(&body)
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, clip.ID, body.Extras[0].ID)
+		assert.True(t, body.Extras[1].Primary)
+	})
+
+	t.Run("should delete the extra", func(t *testing.T) {
+		resp := send(http.MethodDelete, fmt.Sprintf("%s/%d", extrasUrl, trailer.ID), "")
+		assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+		resp, err := http.Get(extrasUrl)
+		var body struct {
+			Extras []video_usecase.ExtraOutput `json:"extras"`
+		}
+		json.NewDecoder(resp.Body).Decode(&body)
+
+		assert.Nil(t, err)
+		assert.Len(t, body.Extras, 1)
+		assert.Equal(t, 1, body.Extras[0].Position)
+	})
+
+	t.Run("should return 404 when the extra does not exist", func(t *testing.T) {
+		resp := send(http.MethodPut, fmt.Sprintf("%s/%d", extrasUrl, 999), `{"type": "CLIP", "title": "Clip"}`)
+
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+}
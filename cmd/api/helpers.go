@@ -6,11 +6,16 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
+	"strconv"
 	"strings"
 )
 
 type envelope map[string]any
 
+// returned by readJSON when the body holds no JSON value at all
+var errEmptyBody = errors.New("body must not be empty")
+
 func (app *application) readJSON(w http.ResponseWriter, r *http.Request, dst any) error {
 	maxBytes := 1_048_576
 	r.Body = http.MaxBytesReader(w, r.Body, int64(maxBytes))
@@ -39,7 +44,7 @@ func (app *application) readJSON(w http.ResponseWriter, r *http.Request, dst any
 			return fmt.Errorf("body contains incorrect JSON type (at character %d)", unmarshalTypeError.Offset)
 
 		case errors.Is(err, io.EOF):
-			return errors.New("body must not be empty")
+			return errEmptyBody
 
 		case strings.HasPrefix(err.Error(), "json: unknown field "):
 			fieldName := strings.TrimPrefix(err.Error(), "json: unknown field ")
@@ -61,3 +66,49 @@ func (app *application) readJSON(w http.ResponseWriter, r *http.Request, dst any
 
 	return nil
 }
+
+func (app *application) readInt64List(qs url.Values, key string) ([]int64, error) {
+	value := qs.Get(key)
+	if value == "" {
+		return nil, nil
+	}
+
+	var ids []int64
+	for _, item := range strings.Split(value, ",") {
+		id, err := strconv.ParseInt(strings.TrimSpace(item), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%s must be a comma-separated list of ids", key)
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
+
+func (app *application) readOptionalInt(qs url.Values, key string) (*int, error) {
+	value := qs.Get(key)
+	if value == "" {
+		return nil, nil
+	}
+
+	i, err := strconv.Atoi(value)
+	if err != nil {
+		return nil, fmt.Errorf("%s must be an integer value", key)
+	}
+
+	return &i, nil
+}
+
+func (app *application) readOptionalBool(qs url.Values, key string) (*bool, error) {
+	value := qs.Get(key)
+	if value == "" {
+		return nil, nil
+	}
+
+	b, err := strconv.ParseBool(value)
+	if err != nil {
+		return nil, fmt.Errorf("%s must be a boolean value", key)
+	}
+
+	return &b, nil
+}
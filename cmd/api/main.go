@@ -7,9 +7,13 @@ import (
 	"fmt"
 	"log/slog"
 	"os"
+	"path/filepath"
 	"strconv"
+	"strings"
 	"time"
 
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/video"
+	infra_messaging "github.com.br/gibranct/admin_do_catalogo/internal/infra/messaging"
 	usecase "github.com.br/gibranct/admin_do_catalogo/internal/usecases"
 	"github.com/joho/godotenv"
 	_ "github.com/lib/pq"
@@ -24,12 +28,27 @@ type config struct {
 		maxIdleConns int
 		maxIdleTime  time.Duration
 	}
+	media struct {
+		rootDir    string
+		encodedDir string
+	}
+	encoder struct {
+		amqpUrl     string
+		resultQueue string
+		jobQueue    string
+	}
+	publication struct {
+		interval             time.Duration
+		readinessRules       string
+		defaultAudioLanguage string
+	}
 }
 
 type application struct {
 	config
-	logger   *slog.Logger
-	useCases usecase.UseCases
+	logger          *slog.Logger
+	useCases        usecase.UseCases
+	encoderConsumer infra_messaging.Consumer
 }
 
 func main() {
@@ -47,10 +66,36 @@ func main() {
 	flag.IntVar(&cfg.db.maxIdleConns, "db-max-idle-conns", cfg.db.maxIdleConns, "Postgres max idle connections")
 	flag.DurationVar(&cfg.db.maxIdleTime, "db-max-idle-time", cfg.db.maxIdleTime, "Postgres max connection idle time")
 
+	flag.StringVar(&cfg.media.rootDir, "media-root-dir", cfg.media.rootDir, "Root directory for stored media files")
+	flag.StringVar(&cfg.media.encodedDir, "media-encoded-dir", cfg.media.encodedDir, "Directory the encoder writes its output to, encoded media is only served from it")
+
+	flag.StringVar(&cfg.encoder.amqpUrl, "amqp-url", cfg.encoder.amqpUrl, "AMQP broker URL for encoder results")
+	flag.StringVar(&cfg.encoder.resultQueue, "encoder-result-queue", cfg.encoder.resultQueue, "Queue with encoder results")
+	flag.StringVar(&cfg.encoder.jobQueue, "encoder-job-queue", cfg.encoder.jobQueue, "Queue the encoding jobs are published to")
+
+	flag.DurationVar(&cfg.publication.interval, "publish-interval", cfg.publication.interval, "Interval between scheduled publication checks")
+	flag.StringVar(&cfg.publication.readinessRules, "readiness-rules", cfg.publication.readinessRules, "Comma separated readiness rules required to publish (empty enables all)")
+	flag.StringVar(&cfg.publication.defaultAudioLanguage, "default-audio-language", cfg.publication.defaultAudioLanguage, "Require a COMPLETED audio track in this language to publish (empty disables it)")
+
 	flag.Parse()
 
 	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
 
+	readinessRules, err := video.ReadinessRulesByName(splitList(cfg.publication.readinessRules))
+
+	if err != nil {
+		logger.Error(err.Error())
+		os.Exit(1)
+	}
+
+	if language := cfg.publication.defaultAudioLanguage; language != "" {
+		if !video.IsLanguageTag(language) {
+			logger.Error(fmt.Sprintf("default audio language '%s' is not a BCP 47 tag", language))
+			os.Exit(1)
+		}
+		readinessRules = append(readinessRules, video.DefaultAudioTrackRule(language))
+	}
+
 	db, err := OpenDB(*cfg)
 
 	if err != nil {
@@ -61,12 +106,30 @@ func main() {
 
 	logger.Info("database connection pool stablished")
 
+	var encoderJobs infra_messaging.Publisher
+
+	if cfg.encoder.amqpUrl != "" {
+		publisher := infra_messaging.NewAMQPPublisher(cfg.encoder.amqpUrl, cfg.encoder.jobQueue)
+		defer publisher.Close()
+		encoderJobs = publisher
+	}
+
 	app := &application{
 		logger:   logger,
 		config:   *cfg,
-		useCases: usecase.NewUseCases(db),
+		useCases: usecase.NewUseCases(db, cfg.media.rootDir, cfg.media.encodedDir, readinessRules, encoderJobs),
+	}
+
+	if cfg.encoder.amqpUrl != "" {
+		app.encoderConsumer = infra_messaging.NewAMQPConsumer(cfg.encoder.amqpUrl, cfg.encoder.resultQueue, logger)
 	}
 
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go app.consumeEncoderResults(ctx)
+	go app.runPublicationScheduler(ctx)
+
 	app.server()
 }
 
@@ -125,7 +188,39 @@ func GetEnvs() (*config, error) {
 		return nil, err
 	}
 
-	return &config{
+	mediaRootDir := os.Getenv("MEDIA_ROOT_DIR")
+
+	if mediaRootDir == "" {
+		mediaRootDir = "./media"
+	}
+
+	mediaEncodedDir := os.Getenv("MEDIA_ENCODED_DIR")
+
+	if mediaEncodedDir == "" {
+		mediaEncodedDir = filepath.Join(mediaRootDir, "encoded")
+	}
+
+	encoderResultQueue := os.Getenv("ENCODER_RESULT_QUEUE")
+
+	if encoderResultQueue == "" {
+		encoderResultQueue = "video.encoded"
+	}
+
+	encoderJobQueue := os.Getenv("ENCODER_JOB_QUEUE")
+
+	if encoderJobQueue == "" {
+		encoderJobQueue = "video.encode"
+	}
+
+	publishInterval := time.Minute
+
+	if value := os.Getenv("PUBLISH_INTERVAL"); value != "" {
+		if publishInterval, err = time.ParseDuration(value); err != nil {
+			return nil, err
+		}
+	}
+
+	cfg := &config{
 		port: port,
 		db: struct {
 			dsn          string
@@ -138,5 +233,23 @@ func GetEnvs() (*config, error) {
 			maxIdleConns: maxIdleConn,
 			maxIdleTime:  time.Duration(maxIdleTime) * time.Minute,
 		},
-	}, nil
+	}
+	cfg.media.rootDir = mediaRootDir
+	cfg.media.encodedDir = mediaEncodedDir
+	cfg.encoder.amqpUrl = os.Getenv("AMQP_URL")
+	cfg.encoder.resultQueue = encoderResultQueue
+	cfg.encoder.jobQueue = encoderJobQueue
+	cfg.publication.interval = publishInterval
+	cfg.publication.readinessRules = os.Getenv("PUBLISH_READINESS_RULES")
+	cfg.publication.defaultAudioLanguage = os.Getenv("PUBLISH_DEFAULT_AUDIO_LANGUAGE")
+
+	return cfg, nil
+}
+
+func splitList(value string) []string {
+	if strings.TrimSpace(value) == "" {
+		return nil
+	}
+
+	return strings.Split(value, ",")
 }
@@ -0,0 +1,53 @@
+package main
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/video"
+	video_usecase "github.com.br/gibranct/admin_do_catalogo/internal/usecases/video"
+	"github.com/go-chi/chi/v5"
+)
+
+func (app *application) hlsManifestHandler(w http.ResponseWriter, r *http.Request) {
+	app.serveManifest(w, r, video.HLS)
+}
+
+func (app *application) dashManifestHandler(w http.ResponseWriter, r *http.Request) {
+	app.serveManifest(w, r, video.DASH)
+}
+
+func (app *application) serveManifest(w http.ResponseWriter, r *http.Request, format video.ManifestFormat) {
+	videoId, ok := app.readVideoId(w, r)
+	if !ok {
+		return
+	}
+
+	mediaType, err := video.GetVideoType(chi.URLParam(r, "type"))
+	if err != nil {
+		app.badRequestResponse(w, err)
+		return
+	}
+
+	if mediaType != video.VIDEO && mediaType != video.TRAILER {
+		app.badRequestResponse(w, errors.New("only Video and Trailer medias have manifests"))
+		return
+	}
+
+	resource, err := app.useCases.Video.GetManifest.Execute(video_usecase.GetManifestCommand{
+		VideoId: videoId,
+		Type:    mediaType,
+		Format:  format,
+	})
+
+	switch {
+	case errors.Is(err, video.ErrVideoNotFound), errors.Is(err, video.ErrResourceNotFound):
+		app.notFoundResponse(w)
+		return
+	case err != nil:
+		app.serverErrorResponse(w, err)
+		return
+	}
+
+	app.serveResource(w, r, resource)
+}
@@ -0,0 +1,212 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/video"
+	video_usecase "github.com.br/gibranct/admin_do_catalogo/internal/usecases/video"
+	"github.com/go-chi/chi/v5"
+)
+
+func (app *application) uploadMediaHandler(w http.ResponseWriter, r *http.Request) {
+	videoId, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		app.badRequestResponse(w, errors.New("invalid id"))
+		return
+	}
+
+	if videoId <= 0 {
+		app.notFoundResponse(w)
+		return
+	}
+
+	mediaType, err := video.GetVideoType(chi.URLParam(r, "type"))
+	if err != nil {
+		app.badRequestResponse(w, err)
+		return
+	}
+
+	rc := http.NewResponseController(w)
+	rc.SetReadDeadline(time.Time{})
+	rc.SetWriteDeadline(time.Time{})
+
+	reader, err := r.MultipartReader()
+	if err != nil {
+		app.badRequestResponse(w, err)
+		return
+	}
+
+	var checksum string
+
+	for {
+		part, err := reader.NextPart()
+		if errors.Is(err, io.EOF) {
+			app.badRequestResponse(w, errors.New("multipart body must contain a 'file' part"))
+			return
+		}
+
+		if err != nil {
+			app.badRequestResponse(w, err)
+			return
+		}
+
+		if part.FormName() == "checksum" {
+			value, err := io.ReadAll(io.LimitReader(part, 256))
+			if err != nil {
+				app.badRequestResponse(w, err)
+				return
+			}
+			checksum = string(value)
+			continue
+		}
+
+		if part.FormName() != "file" {
+			continue
+		}
+
+		command := video_usecase.UploadMediaCommand{
+			VideoId: videoId,
+			Type:    mediaType,
+			Resource: video.Resource{
+				Stream:      part,
+				Checksum:    checksum,
+				ContentType: part.Header.Get("Content-Type"),
+				Name:        part.FileName(),
+			},
+		}
+
+		output, err := app.useCases.Video.UploadMedia.Execute(command)
+
+		var invalidErr video_usecase.InvalidMediaError
+
+		switch {
+		case errors.Is(err, video.ErrVideoNotFound):
+			app.notFoundResponse(w)
+		case errors.As(err, &invalidErr):
+			app.writeError(w, http.StatusBadRequest, "Could not upload media", invalidErr.Notification)
+		case errors.Is(err, video.ErrChecksumMismatch):
+			app.badRequestResponse(w, err)
+		case err != nil:
+			app.serverErrorResponse(w, err)
+		default:
+			app.writeJson(w, http.StatusCreated, output, nil)
+		}
+		return
+	}
+}
+
+func (app *application) getMediaHandler(w http.ResponseWriter, r *http.Request) {
+	videoId, ok := app.readVideoId(w, r)
+	if !ok {
+		return
+	}
+
+	mediaType, err := video.GetVideoType(chi.URLParam(r, "type"))
+	if err != nil {
+		app.badRequestResponse(w, err)
+		return
+	}
+
+	var encoded bool
+	switch r.URL.Query().Get("variant") {
+	case "", "raw":
+	case "encoded":
+		if mediaType != video.VIDEO && mediaType != video.TRAILER {
+			app.badRequestResponse(w, errors.New("only Video and Trailer medias are encoded"))
+			return
+		}
+		encoded = true
+	default:
+		app.badRequestResponse(w, errors.New("'variant' should be raw or encoded"))
+		return
+	}
+
+	resource, err := app.useCases.Video.GetMedia.Execute(video_usecase.GetMediaCommand{
+		VideoId: videoId,
+		Type:    mediaType,
+		Encoded: encoded,
+	})
+
+	switch {
+	case errors.Is(err, video.ErrVideoNotFound), errors.Is(err, video.ErrResourceNotFound):
+		app.notFoundResponse(w)
+		return
+	case err != nil:
+		app.serverErrorResponse(w, err)
+		return
+	}
+
+	app.serveResource(w, r, resource)
+}
+
+func (app *application) serveResource(w http.ResponseWriter, r *http.Request, resource *video.Resource) {
+	if closer, ok := resource.Stream.(io.Closer); ok {
+		defer closer.Close()
+	}
+
+	disposition := "inline"
+	if r.URL.Query().Get("download") == "true" {
+		disposition = "attachment"
+	}
+
+	w.Header().Set("Content-Type", resource.ContentType)
+	w.Header().Set("Content-Disposition", mime.FormatMediaType(disposition, map[string]string{"filename": resource.Name}))
+	if resource.Checksum != "" {
+		w.Header().Set("ETag", fmt.Sprintf("%q", resource.Checksum))
+	}
+
+	rc := http.NewResponseController(w)
+	rc.SetWriteDeadline(time.Time{})
+
+	content, ok := resource.Stream.(io.ReadSeeker)
+	if !ok {
+		w.Header().Set("Content-Length", strconv.FormatInt(resource.Size, 10))
+		w.WriteHeader(http.StatusOK)
+		io.Copy(w, resource.Stream)
+		return
+	}
+
+	http.ServeContent(w, r, resource.Name, resource.ModifiedAt, content)
+}
+
+func (app *application) retryMediaHandler(w http.ResponseWriter, r *http.Request) {
+	videoId, ok := app.readVideoId(w, r)
+	if !ok {
+		return
+	}
+
+	mediaType, err := video.GetVideoType(chi.URLParam(r, "type"))
+	if err != nil {
+		app.badRequestResponse(w, err)
+		return
+	}
+
+	if mediaType != video.VIDEO && mediaType != video.TRAILER {
+		app.badRequestResponse(w, errors.New("only Video and Trailer medias can be retried"))
+		return
+	}
+
+	output, err := app.useCases.Video.RetryMedia.Execute(video_usecase.RetryMediaCommand{
+		VideoId: videoId,
+		Type:    mediaType,
+	})
+
+	var transitionErr video.InvalidMediaStatusTransitionError
+
+	switch {
+	case errors.Is(err, video.ErrVideoNotFound), errors.Is(err, video.ErrResourceNotFound):
+		app.notFoundResponse(w)
+	case errors.As(err, &transitionErr):
+		app.writeError(w, http.StatusConflict, err.Error(), nil)
+	case err != nil:
+		app.serverErrorResponse(w, err)
+	default:
+		app.writeJson(w, http.StatusOK, output, nil)
+	}
+}
@@ -0,0 +1,296 @@
+package main
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/video"
+	video_usecase "github.com.br/gibranct/admin_do_catalogo/internal/usecases/video"
+	"github.com.br/gibranct/admin_do_catalogo/pkg/test"
+	"github.com/stretchr/testify/assert"
+)
+
+func multipartBody(fields map[string]string, fileName string, content []byte) (*bytes.Buffer, string) {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	for key, value := range fields {
+		writer.WriteField(key, value)
+	}
+	part, _ := writer.CreateFormFile("file", fileName)
+	part.Write(content)
+	writer.Close()
+	return body, writer.FormDataContentType()
+}
+
+func TestUploadMedia(t *testing.T) {
+	t.Cleanup(cleanUp)
+	ts, app := runTestServer()
+	defer ts.Close()
+
+	_, output := app.useCases.Video.Create.Execute(video_usecase.CreateVideoCommand{
+		Title:       "dummy title",
+		Description: "dummy desc",
+		LaunchedAt:  2025,
+		Duration:    120.0,
+		Rating:      "Livre",
+	})
+
+	t.Run("should return 201 when video media is uploaded", func(t *testing.T) {
+		body, contentType := multipartBody(nil, "movie.mp4", test.DummyMP4("video content"))
+		resp, err := http.Post(
+			fmt.Sprintf("%s/v1/videos/%d/medias/Video", ts.URL, output.ID),
+			contentType,
+			body,
+		)
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusCreated, resp.StatusCode)
+
+		found, _ := app.useCases.Video.FindOne.Execute(output.ID)
+		assert.Equal(t, "movie.mp4", found.Video.Name)
+		assert.Equal(t, "PENDING", found.Video.Status)
+	})
+
+	t.Run("should return 400 when checksum does not match", func(t *testing.T) {
+		body, contentType := multipartBody(map[string]string{"checksum": "invalid"}, "banner.png", test.DummyPNG(1280, 720))
+		resp, err := http.Post(
+			fmt.Sprintf("%s/v1/videos/%d/medias/Banner", ts.URL, output.ID),
+			contentType,
+			body,
+		)
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("should return 400 when content does not match the media type", func(t *testing.T) {
+		body, contentType := multipartBody(nil, "banner.png", test.DummyMP4("video content"))
+		resp, err := http.Post(
+			fmt.Sprintf("%s/v1/videos/%d/medias/Banner", ts.URL, output.ID),
+			contentType,
+			body,
+		)
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		var payload struct {
+			Errors []string `json:"errors"`
+		}
+		json.NewDecoder(resp.Body).Decode(&payload)
+		assert.Equal(t, []string{"'Banner' must be image content but got video/mp4"}, payload.Errors)
+	})
+
+	t.Run("should return 400 when media type is unknown", func(t *testing.T) {
+		body, contentType := multipartBody(nil, "movie.mp4", []byte("video content"))
+		resp, err := http.Post(
+			fmt.Sprintf("%s/v1/videos/%d/medias/Poster", ts.URL, output.ID),
+			contentType,
+			body,
+		)
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("should return 404 when video does not exist", func(t *testing.T) {
+		body, contentType := multipartBody(nil, "movie.mp4", test.DummyMP4("video content"))
+		resp, err := http.Post(
+			fmt.Sprintf("%s/v1/videos/%d/medias/Video", ts.URL, 999),
+			contentType,
+			body,
+		)
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+}
+
+func TestGetMedia(t *testing.T) {
+	t.Cleanup(cleanUp)
+	ts, app := runTestServer()
+	defer ts.Close()
+
+	content := test.DummyMP4("0123456789")
+	sum := sha256.Sum256(content)
+	checksum := hex.EncodeToString(sum[:])
+	_, output := app.useCases.Video.Create.Execute(video_usecase.CreateVideoCommand{
+		Title:       "dummy title",
+		Description: "dummy desc",
+		LaunchedAt:  2025,
+		Duration:    120.0,
+		Rating:      "Livre",
+		Trailer:     &video.Resource{Content: content, Name: "trailer.mp4"},
+	})
+	trailerUrl := fmt.Sprintf("%s/v1/videos/%d/medias/Trailer", ts.URL, output.ID)
+
+	t.Run("should return the full media", func(t *testing.T) {
+		resp, err := http.Get(trailerUrl)
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "video/mp4", resp.Header.Get("Content-Type"))
+		assert.Equal(t, `inline; filename=trailer.mp4`, resp.Header.Get("Content-Disposition"))
+		assert.Equal(t, fmt.Sprintf("%q", checksum), resp.Header.Get("ETag"))
+		assert.Equal(t, "bytes", resp.Header.Get("Accept-Ranges"))
+		body, _ := io.ReadAll(resp.Body)
+		assert.Equal(t, content, body)
+	})
+
+	t.Run("should return a byte range", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodGet, trailerUrl+"?download=true", nil)
+		req.Header.Set("Range", "bytes=2-5")
+		resp, err := http.DefaultClient.Do(req)
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusPartialContent, resp.StatusCode)
+		assert.Equal(t, fmt.Sprintf("bytes 2-5/%d", len(content)), resp.Header.Get("Content-Range"))
+		assert.Equal(t, `attachment; filename=trailer.mp4`, resp.Header.Get("Content-Disposition"))
+		body, _ := io.ReadAll(resp.Body)
+		assert.Equal(t, content[2:6], body)
+	})
+
+	t.Run("should ignore the range when If-Range does not match", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodGet, trailerUrl, nil)
+		req.Header.Set("Range", "bytes=2-5")
+		req.Header.Set("If-Range", `"stale"`)
+		resp, err := http.DefaultClient.Do(req)
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	})
+
+	t.Run("should return 404 when media was not uploaded", func(t *testing.T) {
+		resp, err := http.Get(fmt.Sprintf("%s/v1/videos/%d/medias/Banner", ts.URL, output.ID))
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+}
+
+func TestGetEncodedMedia(t *testing.T) {
+	t.Cleanup(cleanUp)
+	ts, app := runTestServer()
+	defer ts.Close()
+
+	_, output := app.useCases.Video.Create.Execute(video_usecase.CreateVideoCommand{
+		Title:       "dummy title",
+		Description: "dummy desc",
+		LaunchedAt:  2025,
+		Duration:    120.0,
+		Rating:      "Livre",
+		Video:       &video.Resource{Content: test.DummyMP4("video"), Name: "movie.mp4"},
+	})
+	created, _ := app.useCases.Video.FindOne.Execute(output.ID)
+	encodedUrl := fmt.Sprintf("%s/v1/videos/%d/medias/Video?variant=encoded", ts.URL, output.ID)
+
+	t.Run("should return 404 when media is not completed", func(t *testing.T) {
+		resp, err := http.Get(encodedUrl)
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+
+	t.Run("should return the encoded media once completed", func(t *testing.T) {
+		encodedPath := filepath.Join(mediaRootDir, "encoded", "movie-encoded.mp4")
+		os.MkdirAll(filepath.Dir(encodedPath), 0o755)
+		os.WriteFile(encodedPath, []byte("encoded content"), 0o644)
+		app.useCases.Video.UpdateMediaStatus.Execute(video_usecase.UpdateMediaStatusCommand{
+			VideoId:     output.ID,
+			ResourceId:  fmt.Sprint(created.Video.ID),
+			Status:      "COMPLETED",
+			EncodedPath: encodedPath,
+		})
+
+		resp, err := http.Get(encodedUrl)
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, `inline; filename=movie-encoded.mp4`, resp.Header.Get("Content-Disposition"))
+		body, _ := io.ReadAll(resp.Body)
+		assert.Equal(t, []byte("encoded content"), body)
+	})
+
+	t.Run("should return 400 for an unknown variant", func(t *testing.T) {
+		resp, err := http.Get(fmt.Sprintf("%s/v1/videos/%d/medias/Video?variant=hd", ts.URL, output.ID))
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("should return 400 for image medias", func(t *testing.T) {
+		resp, err := http.Get(fmt.Sprintf("%s/v1/videos/%d/medias/Banner?variant=encoded", ts.URL, output.ID))
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+}
+
+func TestRetryMedia(t *testing.T) {
+	t.Cleanup(cleanUp)
+	ts, app := runTestServer()
+	defer ts.Close()
+
+	_, output := app.useCases.Video.Create.Execute(video_usecase.CreateVideoCommand{
+		Title:       "dummy title",
+		Description: "dummy desc",
+		LaunchedAt:  2025,
+		Duration:    120.0,
+		Rating:      "Livre",
+		Video:       &video.Resource{Content: test.DummyMP4("video"), Name: "movie.mp4"},
+	})
+	created, _ := app.useCases.Video.FindOne.Execute(output.ID)
+	retryUrl := fmt.Sprintf("%s/v1/videos/%d/medias/Video/retry", ts.URL, output.ID)
+
+	t.Run("should return 409 when media has not failed", func(t *testing.T) {
+		resp, err := http.Post(retryUrl, "", nil)
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusConflict, resp.StatusCode)
+	})
+
+	t.Run("should move a failed media back to pending", func(t *testing.T) {
+		app.useCases.Video.UpdateMediaStatus.Execute(video_usecase.UpdateMediaStatusCommand{
+			VideoId:       output.ID,
+			ResourceId:    fmt.Sprint(created.Video.ID),
+			Status:        "FAILED",
+			FailureReason: "corrupted stream",
+		})
+
+		failed, _ := app.useCases.Video.FindOne.Execute(output.ID)
+		assert.Equal(t, "FAILED", failed.Video.Status)
+		assert.Equal(t, "corrupted stream", failed.Video.FailureReason)
+
+		resp, err := http.Post(retryUrl, "", nil)
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		var media video_usecase.AudioVideoMediaOutput
+		json.NewDecoder(resp.Body).Decode(&media)
+		assert.Equal(t, "PENDING", media.Status)
+		assert.Equal(t, "", media.FailureReason)
+		assert.Equal(t, 1, media.Attempts)
+	})
+
+	t.Run("should return 400 for image medias", func(t *testing.T) {
+		resp, err := http.Post(fmt.Sprintf("%s/v1/videos/%d/medias/Banner/retry", ts.URL, output.ID), "", nil)
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("should return 404 when trailer was not uploaded", func(t *testing.T) {
+		resp, err := http.Post(fmt.Sprintf("%s/v1/videos/%d/medias/Trailer/retry", ts.URL, output.ID), "", nil)
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+}
@@ -0,0 +1,132 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/video"
+	video_usecase "github.com.br/gibranct/admin_do_catalogo/internal/usecases/video"
+)
+
+func (app *application) submitVideoHandler(w http.ResponseWriter, r *http.Request) {
+	videoId, ok := app.readVideoId(w, r)
+	if !ok {
+		return
+	}
+
+	output, err := app.useCases.Video.Submit.Execute(videoId)
+	app.publicationResponse(w, output, err)
+}
+
+func (app *application) approveVideoHandler(w http.ResponseWriter, r *http.Request) {
+	videoId, ok := app.readVideoId(w, r)
+	if !ok {
+		return
+	}
+
+	var input struct {
+		PublishAt *time.Time `json:"publishAt"`
+	}
+
+	// the body is optional, approving without one publishes immediately; chunked
+	// requests carry no length, so an empty body is only known once it is read
+	if err := app.readJSON(w, r, &input); err != nil && !errors.Is(err, errEmptyBody) {
+		app.badRequestResponse(w, err)
+		return
+	}
+
+	output, err := app.useCases.Video.Publish.Execute(video_usecase.PublishVideoCommand{
+		VideoId:   videoId,
+		PublishAt: input.PublishAt,
+	})
+	app.publicationResponse(w, output, err)
+}
+
+func (app *application) rejectVideoHandler(w http.ResponseWriter, r *http.Request) {
+	videoId, ok := app.readVideoId(w, r)
+	if !ok {
+		return
+	}
+
+	output, err := app.useCases.Video.Reject.Execute(videoId)
+	app.publicationResponse(w, output, err)
+}
+
+func (app *application) unpublishVideoHandler(w http.ResponseWriter, r *http.Request) {
+	videoId, ok := app.readVideoId(w, r)
+	if !ok {
+		return
+	}
+
+	output, err := app.useCases.Video.Unpublish.Execute(videoId)
+	app.publicationResponse(w, output, err)
+}
+
+func (app *application) getReadinessHandler(w http.ResponseWriter, r *http.Request) {
+	videoId, ok := app.readVideoId(w, r)
+	if !ok {
+		return
+	}
+
+	output, err := app.useCases.Video.Readiness.Execute(videoId)
+
+	switch {
+	case errors.Is(err, video.ErrVideoNotFound):
+		app.notFoundResponse(w)
+	case err != nil:
+		app.serverErrorResponse(w, err)
+	default:
+		app.writeJson(w, http.StatusOK, output, nil)
+	}
+}
+
+func (app *application) publicationResponse(w http.ResponseWriter, output *video_usecase.PublicationOutput, err error) {
+	var transitionErr video.InvalidPublicationTransitionError
+	var notReadyErr video_usecase.VideoNotReadyError
+
+	switch {
+	case errors.Is(err, video.ErrVideoNotFound):
+		app.notFoundResponse(w)
+	case errors.As(err, &transitionErr), errors.Is(err, video.ErrPublicationConflict):
+		app.writeError(w, http.StatusConflict, err.Error(), nil)
+	case errors.As(err, &notReadyErr):
+		app.writeError(w, http.StatusBadRequest, "Video is not ready to be published", notReadyErr.Notification)
+	case err != nil:
+		app.serverErrorResponse(w, err)
+	default:
+		app.writeJson(w, http.StatusOK, output, nil)
+	}
+}
+
+func (app *application) runPublicationScheduler(ctx context.Context) {
+	if app.config.publication.interval <= 0 {
+		app.logger.Info("publication scheduler disabled")
+		return
+	}
+
+	ticker := time.NewTicker(app.config.publication.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			app.publishDueVideos(now.UTC())
+		}
+	}
+}
+
+func (app *application) publishDueVideos(now time.Time) {
+	published, err := app.useCases.Video.PublishDue.Execute(now)
+
+	for _, videoId := range published {
+		app.logger.Info("video published", "videoId", videoId)
+	}
+
+	if err != nil {
+		app.logger.Error("could not publish scheduled videos", "error", err.Error())
+	}
+}
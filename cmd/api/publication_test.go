@@ -0,0 +1,188 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/video"
+	category_usecase "github.com.br/gibranct/admin_do_catalogo/internal/usecases/category"
+	genre_usecase "github.com.br/gibranct/admin_do_catalogo/internal/usecases/genre"
+	video_usecase "github.com.br/gibranct/admin_do_catalogo/internal/usecases/video"
+	"github.com.br/gibranct/admin_do_catalogo/pkg/test"
+	"github.com/stretchr/testify/assert"
+)
+
+func createReadyVideo(app *application) int64 {
+	_, category := app.useCases.Category.Create.Execute(category_usecase.CreateCategoryCommand{
+		Name:        "category 1",
+		Description: "dummy desc",
+	})
+	categoryIds := []int64{category.ID}
+	_, genre := app.useCases.Genre.Create.Execute(genre_usecase.CreateGenreCommand{
+		Name:        "genre 1",
+		CategoryIds: &categoryIds,
+	})
+	_, output := app.useCases.Video.Create.Execute(video_usecase.CreateVideoCommand{
+		Title:       "dummy title",
+		Description: "dummy desc",
+		LaunchedAt:  2025,
+		Duration:    120.0,
+		Rating:      "Livre",
+		CategoryIds: categoryIds,
+		GenreIds:    []int64{genre.ID},
+		Video:       &video.Resource{Content: test.DummyMP4("video"), Name: "movie.mp4"},
+		Banner:      &video.Resource{Content: test.DummyPNG(1280, 720), Name: "banner.png"},
+		Thumbnail:   &video.Resource{Content: test.DummyPNG(640, 360), Name: "thumbnail.png"},
+	})
+	created, _ := app.useCases.Video.FindOne.Execute(output.ID)
+	app.useCases.Video.UpdateMediaStatus.Execute(video_usecase.UpdateMediaStatusCommand{
+		VideoId:     output.ID,
+		ResourceId:  fmt.Sprint(created.Video.ID),
+		Status:      "COMPLETED",
+		EncodedPath: "/encoded/movie",
+	})
+
+	return output.ID
+}
+
+func TestPublicationWorkflow(t *testing.T) {
+	t.Cleanup(cleanUp)
+	ts, app := runTestServer()
+	defer ts.Close()
+
+	videoId := createReadyVideo(app)
+	videoUrl := fmt.Sprintf("%s/v1/videos/%d", ts.URL, videoId)
+	publishAt := time.Now().UTC().Add(time.Hour).Truncate(time.Second)
+
+	readOutput := func(resp *http.Response) video_usecase.PublicationOutput {
+		var body video_usecase.PublicationOutput
+		json.NewDecoder(resp.Body).Decode(&body)
+		return body
+	}
+
+	t.Run("should return 409 when approving a draft", func(t *testing.T) {
+		resp, err := http.Post(videoUrl+"/approve", "", nil)
+		expecBody := `{"errors":[],"message":"publication status cannot change from DRAFT to SCHEDULED"}`
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusConflict, resp.StatusCode)
+		assert.Equal(t, expecBody, test.ReadRespBody(*resp))
+	})
+
+	t.Run("should submit the video for review", func(t *testing.T) {
+		resp, err := http.Post(videoUrl+"/submit", "", nil)
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "IN_REVIEW", readOutput(resp).PublicationStatus)
+	})
+
+	t.Run("should schedule the publication", func(t *testing.T) {
+		data, _ := json.Marshal(map[string]any{"publishAt": publishAt})
+		resp, err := http.Post(videoUrl+"/approve", "application/json", bytes.NewReader(data))
+		body := readOutput(resp)
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "SCHEDULED", body.PublicationStatus)
+		assert.True(t, publishAt.Equal(*body.PublishAt))
+		assert.False(t, body.Published)
+	})
+
+	t.Run("should publish immediately when approved with an empty chunked body", func(t *testing.T) {
+		otherUrl := fmt.Sprintf("%s/v1/videos/%d", ts.URL, createReadyVideo(app))
+		http.Post(otherUrl+"/submit", "", nil)
+		req, _ := http.NewRequest(http.MethodPost, otherUrl+"/approve", io.MultiReader())
+		req.TransferEncoding = []string{"chunked"}
+
+		resp, err := http.DefaultClient.Do(req)
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "PUBLISHED", readOutput(resp).PublicationStatus)
+	})
+
+	t.Run("should publish the video once it is due", func(t *testing.T) {
+		app.publishDueVideos(time.Now().UTC())
+		found, _ := app.useCases.Video.FindOne.Execute(videoId)
+		assert.Equal(t, "SCHEDULED", found.PublicationStatus)
+
+		app.publishDueVideos(publishAt.Add(time.Minute))
+		found, _ = app.useCases.Video.FindOne.Execute(videoId)
+		assert.Equal(t, "PUBLISHED", found.PublicationStatus)
+		assert.True(t, found.Published)
+	})
+
+	t.Run("should unpublish the video", func(t *testing.T) {
+		resp, err := http.Post(videoUrl+"/unpublish", "", nil)
+		body := readOutput(resp)
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "UNPUBLISHED", body.PublicationStatus)
+		assert.Nil(t, body.PublishAt)
+		assert.False(t, body.Published)
+	})
+
+	t.Run("should return 404 when video does not exists", func(t *testing.T) {
+		resp, err := http.Post(fmt.Sprintf("%s/v1/videos/%d/submit", ts.URL, 999), "", nil)
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+}
+
+func TestPublishVideoThatIsNotReady(t *testing.T) {
+	t.Cleanup(cleanUp)
+	ts, app := runTestServer()
+	defer ts.Close()
+
+	_, output := app.useCases.Video.Create.Execute(video_usecase.CreateVideoCommand{
+		Title:       "dummy title",
+		Description: "dummy desc",
+		LaunchedAt:  2025,
+		Duration:    120.0,
+		Rating:      "Livre",
+	})
+	videoUrl := fmt.Sprintf("%s/v1/videos/%d", ts.URL, output.ID)
+
+	t.Run("should list every unmet readiness rule", func(t *testing.T) {
+		resp, err := http.Get(videoUrl + "/readiness")
+		var body video_usecase.ReadinessOutput
+		json.NewDecoder(resp.Body).Decode(&body)
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.False(t, body.Ready)
+		assert.Len(t, body.Checks, 6)
+		assert.Equal(t, "rating", body.Checks[5].Rule)
+		assert.True(t, body.Checks[5].Passed)
+	})
+
+	t.Run("should refuse the publication", func(t *testing.T) {
+		http.Post(videoUrl+"/submit", "", nil)
+		resp, err := http.Post(videoUrl+"/approve", "", nil)
+		expecBody := `{"errors":["'Video' media must be COMPLETED","'Banner' media must be present",` +
+			`"'Thumbnail' media must be present","at least one category must be attached",` +
+			`"at least one genre must be attached"],"message":"Video is not ready to be published"}`
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		assert.Equal(t, expecBody, test.ReadRespBody(*resp))
+
+		found, _ := app.useCases.Video.FindOne.Execute(output.ID)
+		assert.Equal(t, "IN_REVIEW", found.PublicationStatus)
+	})
+
+	t.Run("should return 404 when video does not exists", func(t *testing.T) {
+		resp, err := http.Get(fmt.Sprintf("%s/v1/videos/%d/readiness", ts.URL, 999))
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+}
@@ -19,6 +19,8 @@ func (app *application) routes() http.Handler {
 		r.Put("/categories/{id}", app.updateCategoryHandler)
 		r.Post("/categories/{id}/activate", app.activateCategoryHandler)
 		r.Post("/categories/{id}/deactivate", app.deactivateCategoryHandler)
+		r.Put("/categories/{id}/encoding-profile", app.assignCategoryEncodingProfileHandler)
+		r.Delete("/categories/{id}/encoding-profile", app.unassignCategoryEncodingProfileHandler)
 
 		r.Post("/cast-members", app.createCastMemberHandler)
 		r.Get("/cast-members", app.listCastMemberHandler)
@@ -28,7 +30,66 @@ func (app *application) routes() http.Handler {
 		r.Get("/genres", app.listGenresHandler)
 		r.Delete("/genres/{id}", app.deleteGenreByIdHandler)
 
+		r.Post("/series", app.createSeriesHandler)
+		r.Get("/series", app.listSeriesHandler)
+		r.Get("/series/{id}", app.getSeriesByIdHandler)
+		r.Put("/series/{id}", app.updateSeriesHandler)
+		r.Delete("/series/{id}", app.deleteSeriesByIdHandler)
+		r.Post("/series/{id}/seasons", app.createSeasonHandler)
+		r.Put("/series/{id}/seasons/{seasonId}", app.updateSeasonHandler)
+		r.Delete("/series/{id}/seasons/{seasonId}", app.deleteSeasonHandler)
+
+		r.Post("/encoding-profiles", app.createEncodingProfileHandler)
+		r.Get("/encoding-profiles", app.listEncodingProfilesHandler)
+		r.Get("/encoding-profiles/{id}", app.getEncodingProfileByIdHandler)
+		r.Put("/encoding-profiles/{id}", app.updateEncodingProfileHandler)
+		r.Delete("/encoding-profiles/{id}", app.deleteEncodingProfileByIdHandler)
+
+		r.Get("/ratings/equivalents", app.getRatingEquivalentsHandler)
+
 		r.Post("/videos", app.createVideoHandler)
+		r.Get("/videos", app.listVideosHandler)
+		r.Get("/videos/{id}", app.getVideoByIdHandler)
+		r.Put("/videos/{id}", app.updateVideoHandler)
+		r.Delete("/videos/{id}", app.deleteVideoByIdHandler)
+		r.Post("/videos/{id}/submit", app.submitVideoHandler)
+		r.Post("/videos/{id}/approve", app.approveVideoHandler)
+		r.Post("/videos/{id}/reject", app.rejectVideoHandler)
+		r.Post("/videos/{id}/unpublish", app.unpublishVideoHandler)
+		r.Get("/videos/{id}/readiness", app.getReadinessHandler)
+		r.Get("/videos/{id}/encoding-profile", app.getVideoEncodingProfileHandler)
+		r.Put("/videos/{id}/encoding-profile", app.assignVideoEncodingProfileHandler)
+		r.Delete("/videos/{id}/encoding-profile", app.unassignVideoEncodingProfileHandler)
+		r.Post("/videos/{id}/medias/{type}", app.uploadMediaHandler)
+		r.Get("/videos/{id}/medias/{type}", app.getMediaHandler)
+		r.Post("/videos/{id}/medias/{type}/retry", app.retryMediaHandler)
+		r.Get("/videos/{id}/medias/{type}/master.m3u8", app.hlsManifestHandler)
+		r.Get("/videos/{id}/medias/{type}/manifest.mpd", app.dashManifestHandler)
+		r.Post("/videos/{id}/text-tracks", app.uploadTextTrackHandler)
+		r.Get("/videos/{id}/text-tracks", app.listTextTracksHandler)
+		r.Get("/videos/{id}/text-tracks/{language}/{kind}", app.getTextTrackHandler)
+		r.Post("/videos/{id}/audio-tracks", app.uploadAudioTrackHandler)
+		r.Get("/videos/{id}/audio-tracks", app.listAudioTracksHandler)
+		r.Delete("/videos/{id}/audio-tracks/{language}", app.deleteAudioTrackHandler)
+		r.Post("/videos/{id}/extras", app.createExtraHandler)
+		r.Get("/videos/{id}/extras", app.listExtrasHandler)
+		r.Put("/videos/{id}/extras/order", app.reorderExtrasHandler)
+		r.Put("/videos/{id}/extras/{extraId}", app.updateExtraHandler)
+		r.Delete("/videos/{id}/extras/{extraId}", app.deleteExtraHandler)
+		r.Post("/videos/{id}/extras/{extraId}/primary", app.promoteExtraHandler)
+		r.Post("/videos/{id}/extras/{extraId}/media", app.uploadExtraMediaHandler)
+		r.Get("/videos/{id}/extras/{extraId}/media", app.getExtraMediaHandler)
+		r.Post("/videos/{id}/artwork", app.uploadArtworkHandler)
+		r.Get("/videos/{id}/artwork", app.listArtworkHandler)
+		r.Get("/videos/{id}/artwork/best", app.bestArtworkHandler)
+		r.Get("/videos/{id}/artwork/{artworkId}", app.getArtworkImageHandler)
+		r.Delete("/videos/{id}/artwork/{artworkId}", app.deleteArtworkHandler)
+
+		r.Options("/videos/{id}/uploads", app.tusResumable(app.uploadOptionsHandler))
+		r.Post("/videos/{id}/uploads", app.tusResumable(app.createUploadHandler))
+		r.Head("/videos/{id}/uploads/{uploadId}", app.tusResumable(app.getUploadOffsetHandler))
+		r.Patch("/videos/{id}/uploads/{uploadId}", app.tusResumable(app.appendUploadChunkHandler))
+		r.Delete("/videos/{id}/uploads/{uploadId}", app.tusResumable(app.terminateUploadHandler))
 	})
 
 	return router
@@ -0,0 +1,274 @@
+package main
+
+import (
+	"errors"
+	"net/http"
+	"slices"
+	"strconv"
+
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain"
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/series"
+	series_usecase "github.com.br/gibranct/admin_do_catalogo/internal/usecases/series"
+	"github.com.br/gibranct/admin_do_catalogo/pkg/notification"
+)
+
+type seriesInput struct {
+	Title       string  `json:"title"`
+	Description string  `json:"description"`
+	CategoryIds []int64 `json:"categoryIds"`
+	GenreIds    []int64 `json:"genreIds"`
+}
+
+type seasonInput struct {
+	Number   int    `json:"number"`
+	Title    string `json:"title"`
+	Episodes []struct {
+		VideoId int64  `json:"videoId"`
+		Number  int    `json:"number"`
+		Title   string `json:"title"`
+	} `json:"episodes"`
+}
+
+func (input seasonInput) toEpisodeCommands() []series_usecase.EpisodeCommand {
+	commands := []series_usecase.EpisodeCommand{}
+	for _, episode := range input.Episodes {
+		commands = append(commands, series_usecase.EpisodeCommand(episode))
+	}
+	return commands
+}
+
+func (app *application) createSeriesHandler(w http.ResponseWriter, r *http.Request) {
+	var input seriesInput
+
+	err := app.readJSON(w, r, &input)
+	if err != nil {
+		app.badRequestResponse(w, err)
+		return
+	}
+
+	noti, output := app.useCases.Series.Create.Execute(series_usecase.CreateSeriesCommand{
+		Title:       input.Title,
+		Description: input.Description,
+		CategoryIds: input.CategoryIds,
+		GenreIds:    input.GenreIds,
+	})
+
+	if output != nil {
+		app.writeJson(w, http.StatusCreated, envelope{"id": output.ID}, nil)
+		return
+	}
+
+	err = app.writeError(w, http.StatusBadRequest, "Could not save series", noti)
+	if err != nil {
+		app.serverErrorResponse(w, err)
+	}
+}
+
+func (app *application) listSeriesHandler(w http.ResponseWriter, r *http.Request) {
+	qs := r.URL.Query()
+
+	page, err := strconv.Atoi(qs.Get("page"))
+	if err != nil {
+		app.badRequestResponse(w, err)
+		return
+	}
+
+	perPage, err := strconv.Atoi(qs.Get("perPage"))
+	if err != nil {
+		app.badRequestResponse(w, err)
+		return
+	}
+
+	output, err := app.useCases.Series.FindAll.Execute(domain.SearchQuery{
+		Sort:      qs.Get("sort"),
+		Term:      qs.Get("search"),
+		Page:      page,
+		PerPage:   perPage,
+		Direction: qs.Get("dir"),
+	})
+
+	if err != nil {
+		app.badRequestResponse(w, err)
+		return
+	}
+
+	app.writeJson(w, http.StatusOK, output, nil)
+}
+
+func (app *application) getSeriesByIdHandler(w http.ResponseWriter, r *http.Request) {
+	seriesId, ok := app.readIdParam(w, r, "id")
+	if !ok {
+		return
+	}
+
+	output, err := app.useCases.Series.FindOne.Execute(seriesId)
+
+	if errors.Is(err, series.ErrSeriesNotFound) {
+		app.notFoundResponse(w)
+		return
+	}
+
+	if err != nil {
+		app.serverErrorResponse(w, err)
+		return
+	}
+
+	err = app.writeJson(w, http.StatusOK, output, nil)
+	if err != nil {
+		app.serverErrorResponse(w, err)
+	}
+}
+
+func (app *application) updateSeriesHandler(w http.ResponseWriter, r *http.Request) {
+	seriesId, ok := app.readIdParam(w, r, "id")
+	if !ok {
+		return
+	}
+
+	var input seriesInput
+
+	err := app.readJSON(w, r, &input)
+	if err != nil {
+		app.badRequestResponse(w, err)
+		return
+	}
+
+	noti := app.useCases.Series.Update.Execute(series_usecase.UpdateSeriesCommand{
+		ID:          seriesId,
+		Title:       input.Title,
+		Description: input.Description,
+		CategoryIds: input.CategoryIds,
+		GenreIds:    input.GenreIds,
+	})
+
+	if noti == nil || !noti.HasErrors() {
+		app.writeJson(w, http.StatusOK, envelope{"id": seriesId}, nil)
+		return
+	}
+
+	app.seriesErrorResponse(w, noti, "Could not update series")
+}
+
+func (app *application) deleteSeriesByIdHandler(w http.ResponseWriter, r *http.Request) {
+	seriesId, ok := app.readIdParam(w, r, "id")
+	if !ok {
+		return
+	}
+
+	err := app.useCases.Series.DeleteById.Execute(seriesId)
+
+	if err != nil {
+		app.serverErrorResponse(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (app *application) createSeasonHandler(w http.ResponseWriter, r *http.Request) {
+	seriesId, ok := app.readIdParam(w, r, "id")
+	if !ok {
+		return
+	}
+
+	var input seasonInput
+
+	err := app.readJSON(w, r, &input)
+	if err != nil {
+		app.badRequestResponse(w, err)
+		return
+	}
+
+	noti, output := app.useCases.Series.CreateSeason.Execute(series_usecase.CreateSeasonCommand{
+		SeriesId: seriesId,
+		Number:   input.Number,
+		Title:    input.Title,
+		Episodes: input.toEpisodeCommands(),
+	})
+
+	if output != nil {
+		app.writeJson(w, http.StatusCreated, envelope{"id": output.ID}, nil)
+		return
+	}
+
+	app.seriesErrorResponse(w, noti, "Could not save season")
+}
+
+func (app *application) updateSeasonHandler(w http.ResponseWriter, r *http.Request) {
+	seriesId, ok := app.readIdParam(w, r, "id")
+	if !ok {
+		return
+	}
+
+	seasonId, ok := app.readIdParam(w, r, "seasonId")
+	if !ok {
+		return
+	}
+
+	var input seasonInput
+
+	err := app.readJSON(w, r, &input)
+	if err != nil {
+		app.badRequestResponse(w, err)
+		return
+	}
+
+	noti := app.useCases.Series.UpdateSeason.Execute(series_usecase.UpdateSeasonCommand{
+		SeriesId: seriesId,
+		SeasonId: seasonId,
+		Number:   input.Number,
+		Title:    input.Title,
+		Episodes: input.toEpisodeCommands(),
+	})
+
+	if noti == nil || !noti.HasErrors() {
+		app.writeJson(w, http.StatusOK, envelope{"id": seasonId}, nil)
+		return
+	}
+
+	app.seriesErrorResponse(w, noti, "Could not update season")
+}
+
+func (app *application) deleteSeasonHandler(w http.ResponseWriter, r *http.Request) {
+	seriesId, ok := app.readIdParam(w, r, "id")
+	if !ok {
+		return
+	}
+
+	seasonId, ok := app.readIdParam(w, r, "seasonId")
+	if !ok {
+		return
+	}
+
+	err := app.useCases.Series.DeleteSeason.Execute(series_usecase.DeleteSeasonCommand{
+		SeriesId: seriesId,
+		SeasonId: seasonId,
+	})
+
+	if isSeriesNotFound(err) {
+		app.notFoundResponse(w)
+		return
+	}
+
+	if err != nil {
+		app.serverErrorResponse(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (app *application) seriesErrorResponse(w http.ResponseWriter, noti *notification.Notification, msg string) {
+	if slices.ContainsFunc(noti.GetErrors(), isSeriesNotFound) {
+		app.notFoundResponse(w)
+		return
+	}
+
+	if err := app.writeError(w, http.StatusBadRequest, msg, noti); err != nil {
+		app.serverErrorResponse(w, err)
+	}
+}
+
+func isSeriesNotFound(err error) bool {
+	return errors.Is(err, series.ErrSeriesNotFound) || errors.Is(err, series.ErrSeasonNotFound)
+}
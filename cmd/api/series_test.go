@@ -0,0 +1,163 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	category_usecase "github.com.br/gibranct/admin_do_catalogo/internal/usecases/category"
+	series_usecase "github.com.br/gibranct/admin_do_catalogo/internal/usecases/series"
+	video_usecase "github.com.br/gibranct/admin_do_catalogo/internal/usecases/video"
+	"github.com.br/gibranct/admin_do_catalogo/pkg/test"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSeriesWithSeasons(t *testing.T) {
+	t.Cleanup(cleanUp)
+	ts, app := runTestServer()
+	defer ts.Close()
+
+	_, category1 := app.useCases.Category.Create.Execute(category_usecase.CreateCategoryCommand{
+		Name:        "category 1",
+		Description: "dummy desc",
+	})
+	_, category2 := app.useCases.Category.Create.Execute(category_usecase.CreateCategoryCommand{
+		Name:        "category 2",
+		Description: "dummy desc",
+	})
+	videoIds := []int64{}
+	// the second episode overrides the series categories with its own
+	for i, categoryIds := range [][]int64{nil, {category2.ID}} {
+		_, output := app.useCases.Video.Create.Execute(video_usecase.CreateVideoCommand{
+			Title:       []string{"Secrets", "Lies"}[i],
+			Description: "dummy desc",
+			LaunchedAt:  2017,
+			Duration:    50.0,
+			Rating:      "16",
+			CategoryIds: categoryIds,
+		})
+		videoIds = append(videoIds, output.ID)
+	}
+
+	var seriesId, seasonId int64
+
+	t.Run("should create a series", func(t *testing.T) {
+		data, _ := json.Marshal(map[string]any{
+			"title":       "Dark",
+			"description": "time travel",
+			"categoryIds": []int64{category1.ID},
+		})
+		resp, err := http.Post(fmt.Sprintf("%s/v1/series", ts.URL), conTypeApplicationJson, bytes.NewReader(data))
+		var body struct {
+			ID int64 `json:"id"`
+		}
+		json.NewDecoder(resp.Body).Decode(&body)
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusCreated, resp.StatusCode)
+		seriesId = body.ID
+	})
+
+	t.Run("should create a season with episodes", func(t *testing.T) {
+		data, _ := json.Marshal(map[string]any{
+			"number": 1,
+			"title":  "Season 1",
+			"episodes": []map[string]any{
+				{"videoId": videoIds[1], "number": 2, "title": "Lies"},
+				{"videoId": videoIds[0], "number": 1, "title": "Secrets"},
+			},
+		})
+		resp, err := http.Post(fmt.Sprintf("%s/v1/series/%d/seasons", ts.URL, seriesId), conTypeApplicationJson, bytes.NewReader(data))
+		var body struct {
+			ID int64 `json:"id"`
+		}
+		json.NewDecoder(resp.Body).Decode(&body)
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusCreated, resp.StatusCode)
+		seasonId = body.ID
+	})
+
+	t.Run("should reject a duplicated season number", func(t *testing.T) {
+		data, _ := json.Marshal(map[string]any{
+			"number":   1,
+			"title":    "Season 1 again",
+			"episodes": []map[string]any{{"videoId": videoIds[0], "number": 1}},
+		})
+		resp, err := http.Post(fmt.Sprintf("%s/v1/series/%d/seasons", ts.URL, seriesId), conTypeApplicationJson, bytes.NewReader(data))
+		expecBody := fmt.Sprintf(`{"errors":["season number 1 already exists in this series",`+
+			`"video %d is already an episode of another season"],"message":"Could not save season"}`, videoIds[0])
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		assert.Equal(t, expecBody, test.ReadRespBody(*resp))
+	})
+
+	t.Run("should return the series with ordered episodes and inherited categories", func(t *testing.T) {
+		resp, err := http.Get(fmt.Sprintf("%s/v1/series/%d", ts.URL, seriesId))
+		var body series_usecase.SeriesOutput
+		json.NewDecoder(resp.Body).Decode(&body)
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Len(t, body.Seasons, 1)
+		assert.Equal(t, seasonId, body.Seasons[0].ID)
+		episodes := body.Seasons[0].Episodes
+		assert.Equal(t, videoIds[0], episodes[0].VideoId)
+		assert.Equal(t, []int64{category1.ID}, episodes[0].CategoryIds)
+		assert.True(t, episodes[0].InheritsCategories)
+		assert.Equal(t, videoIds[1], episodes[1].VideoId)
+		assert.Equal(t, []int64{category2.ID}, episodes[1].CategoryIds)
+		assert.False(t, episodes[1].InheritsCategories)
+	})
+
+	t.Run("should return the episode video with the inherited categories", func(t *testing.T) {
+		resp, err := http.Get(fmt.Sprintf("%s/v1/videos/%d", ts.URL, videoIds[0]))
+		var body video_usecase.VideoOutput
+		json.NewDecoder(resp.Body).Decode(&body)
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, []int64{category1.ID}, body.CategoryIds)
+		assert.True(t, body.InheritsCategories)
+	})
+
+	t.Run("should filter episode videos by the inherited categories", func(t *testing.T) {
+		resp, err := http.Get(fmt.Sprintf("%s/v1/videos?page=1&perPage=10&categoryIds=%d", ts.URL, category1.ID))
+		var body struct {
+			Total int                              `json:"total"`
+			Items []video_usecase.ListVideosOutput `json:"items"`
+		}
+		json.NewDecoder(resp.Body).Decode(&body)
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, 1, body.Total)
+		assert.Equal(t, videoIds[0], body.Items[0].ID)
+	})
+
+	t.Run("should return 404 when season belongs to another series", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodDelete, fmt.Sprintf("%s/v1/series/%d/seasons/%d", ts.URL, seriesId+1, seasonId), nil)
+		resp, err := http.DefaultClient.Do(req)
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+
+	t.Run("should delete the series with its seasons", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodDelete, fmt.Sprintf("%s/v1/series/%d", ts.URL, seriesId), nil)
+		resp, err := http.DefaultClient.Do(req)
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+		var seasons int
+		dbContainer.db.QueryRow("SELECT COUNT(*) FROM seasons WHERE series_id = $1", seriesId).Scan(&seasons)
+		assert.Equal(t, 0, seasons)
+
+		getResp, err := http.Get(fmt.Sprintf("%s/v1/series/%d", ts.URL, seriesId))
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusNotFound, getResp.StatusCode)
+	})
+}
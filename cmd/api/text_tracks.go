@@ -0,0 +1,129 @@
+package main
+
+import (
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/video"
+	video_usecase "github.com.br/gibranct/admin_do_catalogo/internal/usecases/video"
+	"github.com/go-chi/chi/v5"
+)
+
+func (app *application) uploadTextTrackHandler(w http.ResponseWriter, r *http.Request) {
+	videoId, ok := app.readVideoId(w, r)
+	if !ok {
+		return
+	}
+
+	reader, err := r.MultipartReader()
+	if err != nil {
+		app.badRequestResponse(w, err)
+		return
+	}
+
+	fields := map[string]string{}
+
+	for {
+		part, err := reader.NextPart()
+		if errors.Is(err, io.EOF) {
+			app.badRequestResponse(w, errors.New("multipart body must contain a 'file' part"))
+			return
+		}
+
+		if err != nil {
+			app.badRequestResponse(w, err)
+			return
+		}
+
+		switch part.FormName() {
+		case "language", "kind", "checksum":
+			value, err := io.ReadAll(io.LimitReader(part, 256))
+			if err != nil {
+				app.badRequestResponse(w, err)
+				return
+			}
+			fields[part.FormName()] = string(value)
+			continue
+		case "file":
+		default:
+			continue
+		}
+
+		output, err := app.useCases.Video.UploadTextTrack.Execute(video_usecase.UploadTextTrackCommand{
+			VideoId:  videoId,
+			Language: fields["language"],
+			Kind:     fields["kind"],
+			Resource: video.Resource{
+				Stream:      part,
+				Checksum:    fields["checksum"],
+				ContentType: part.Header.Get("Content-Type"),
+				Name:        part.FileName(),
+			},
+		})
+
+		var invalidErr video_usecase.InvalidMediaError
+
+		switch {
+		case errors.Is(err, video.ErrVideoNotFound):
+			app.notFoundResponse(w)
+		case errors.As(err, &invalidErr):
+			app.writeError(w, http.StatusBadRequest, "Could not upload text track", invalidErr.Notification)
+		case errors.Is(err, video.ErrChecksumMismatch):
+			app.badRequestResponse(w, err)
+		case err != nil:
+			app.serverErrorResponse(w, err)
+		default:
+			app.writeJson(w, http.StatusCreated, output, nil)
+		}
+		return
+	}
+}
+
+func (app *application) listTextTracksHandler(w http.ResponseWriter, r *http.Request) {
+	videoId, ok := app.readVideoId(w, r)
+	if !ok {
+		return
+	}
+
+	outputs, err := app.useCases.Video.ListTextTracks.Execute(videoId)
+
+	switch {
+	case errors.Is(err, video.ErrVideoNotFound):
+		app.notFoundResponse(w)
+	case err != nil:
+		app.serverErrorResponse(w, err)
+	default:
+		app.writeJson(w, http.StatusOK, envelope{"textTracks": outputs}, nil)
+	}
+}
+
+func (app *application) getTextTrackHandler(w http.ResponseWriter, r *http.Request) {
+	videoId, ok := app.readVideoId(w, r)
+	if !ok {
+		return
+	}
+
+	kind, err := video.StringToTextTrackKind(chi.URLParam(r, "kind"))
+	if err != nil {
+		app.badRequestResponse(w, err)
+		return
+	}
+
+	resource, err := app.useCases.Video.GetTextTrack.Execute(video_usecase.GetTextTrackCommand{
+		VideoId:  videoId,
+		Language: chi.URLParam(r, "language"),
+		Kind:     kind,
+	})
+
+	switch {
+	case errors.Is(err, video.ErrVideoNotFound), errors.Is(err, video.ErrResourceNotFound):
+		app.notFoundResponse(w)
+		return
+	case err != nil:
+		app.serverErrorResponse(w, err)
+		return
+	}
+
+	app.serveResource(w, r, resource)
+}
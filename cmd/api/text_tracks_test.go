@@ -0,0 +1,93 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	video_usecase "github.com.br/gibranct/admin_do_catalogo/internal/usecases/video"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTextTracks(t *testing.T) {
+	t.Cleanup(cleanUp)
+	ts, app := runTestServer()
+	defer ts.Close()
+
+	_, output := app.useCases.Video.Create.Execute(video_usecase.CreateVideoCommand{
+		Title:       "dummy title",
+		Description: "dummy desc",
+		LaunchedAt:  2025,
+		Duration:    120.0,
+		Rating:      "Livre",
+	})
+	tracksUrl := fmt.Sprintf("%s/v1/videos/%d/text-tracks", ts.URL, output.ID)
+	srt := []byte("1\r\n00:00:01,000 --> 00:00:04,000\r\n<b>Olá</b>\r\n")
+
+	t.Run("should return 201 when the track is uploaded", func(t *testing.T) {
+		body, contentType := multipartBody(map[string]string{"language": "pt-BR", "kind": "CAPTIONS"}, "movie.srt", srt)
+		resp, err := http.Post(tracksUrl, contentType, body)
+		var track video_usecase.TextTrackOutput
+		json.NewDecoder(resp.Body).Decode(&track)
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusCreated, resp.StatusCode)
+		assert.Equal(t, "pt-BR", track.Language)
+		assert.Equal(t, "CAPTIONS", track.Kind)
+		assert.Equal(t, "movie.vtt", track.Name)
+		assert.Equal(t, 1, track.Cues)
+	})
+
+	t.Run("should list the tracks of the video", func(t *testing.T) {
+		resp, err := http.Get(tracksUrl)
+		var body struct {
+			TextTracks []video_usecase.TextTrackOutput `json:"textTracks"`
+		}
+		json.NewDecoder(resp.Body).Decode(&body)
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Len(t, body.TextTracks, 1)
+	})
+
+	t.Run("should return the track normalized to WebVTT", func(t *testing.T) {
+		resp, err := http.Get(tracksUrl + "/pt-BR/CAPTIONS")
+		content, _ := io.ReadAll(resp.Body)
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "text/vtt; charset=utf-8", resp.Header.Get("Content-Type"))
+		assert.Equal(t, "WEBVTT\n\n00:00:01.000 --> 00:00:04.000\n<b>Olá</b>\n", string(content))
+	})
+
+	t.Run("should return 400 when cue timings are invalid", func(t *testing.T) {
+		invalid := []byte("1\n00:00:04,000 --> 00:00:01,000\nOlá\n")
+		body, contentType := multipartBody(map[string]string{"language": "pt-BR", "kind": "SUBTITLES"}, "movie.srt", invalid)
+		resp, err := http.Post(tracksUrl, contentType, body)
+		var payload struct {
+			Errors []string `json:"errors"`
+		}
+		json.NewDecoder(resp.Body).Decode(&payload)
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		assert.Equal(t, []string{"cue 1 ends at 00:00:01.000 before it starts at 00:00:04.000"}, payload.Errors)
+	})
+
+	t.Run("should return 404 when the track does not exist", func(t *testing.T) {
+		resp, err := http.Get(tracksUrl + "/en/FORCED")
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+
+	t.Run("should return 404 when video does not exist", func(t *testing.T) {
+		body, contentType := multipartBody(map[string]string{"language": "en", "kind": "SUBTITLES"}, "movie.srt", srt)
+		resp, err := http.Post(fmt.Sprintf("%s/v1/videos/%d/text-tracks", ts.URL, 999), contentType, body)
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+}
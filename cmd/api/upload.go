@@ -0,0 +1,233 @@
+package main
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/upload"
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/video"
+	upload_usecase "github.com.br/gibranct/admin_do_catalogo/internal/usecases/upload"
+	video_usecase "github.com.br/gibranct/admin_do_catalogo/internal/usecases/video"
+	"github.com/go-chi/chi/v5"
+)
+
+const (
+	tusVersion    = "1.0.0"
+	tusExtensions = "creation,termination"
+	tusChunkType  = "application/offset+octet-stream"
+)
+
+func (app *application) tusResumable(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Tus-Resumable", tusVersion)
+
+		if r.Method != http.MethodOptions && r.Header.Get("Tus-Resumable") != tusVersion {
+			w.Header().Set("Tus-Version", tusVersion)
+			app.writeError(w, http.StatusPreconditionFailed, "unsupported tus protocol version", nil)
+			return
+		}
+
+		next(w, r)
+	}
+}
+
+func (app *application) uploadOptionsHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Tus-Version", tusVersion)
+	w.Header().Set("Tus-Extension", tusExtensions)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (app *application) createUploadHandler(w http.ResponseWriter, r *http.Request) {
+	videoId, ok := app.readVideoId(w, r)
+	if !ok {
+		return
+	}
+
+	length, err := strconv.ParseInt(r.Header.Get("Upload-Length"), 10, 64)
+	if err != nil {
+		app.badRequestResponse(w, errors.New("'Upload-Length' header must be a valid integer"))
+		return
+	}
+
+	metadata, err := parseUploadMetadata(r.Header.Get("Upload-Metadata"))
+	if err != nil {
+		app.badRequestResponse(w, err)
+		return
+	}
+
+	mediaType := video.VIDEO
+	if value, ok := metadata["mediaType"]; ok {
+		if mediaType, err = video.GetVideoType(value); err != nil {
+			app.badRequestResponse(w, err)
+			return
+		}
+	}
+
+	command := upload_usecase.CreateUploadCommand{
+		VideoId:   videoId,
+		MediaType: mediaType,
+		Length:    length,
+		FileName:  metadata["filename"],
+		Checksum:  metadata["checksum"],
+	}
+
+	noti, output := app.useCases.Upload.Create.Execute(command)
+
+	if noti.HasErrors() {
+		if slices.ContainsFunc(noti.GetErrors(), isVideoNotFound) {
+			app.notFoundResponse(w)
+			return
+		}
+
+		if err = app.writeError(w, http.StatusBadRequest, "Could not create upload", noti); err != nil {
+			app.serverErrorResponse(w, err)
+		}
+		return
+	}
+
+	w.Header().Set("Location", fmt.Sprintf("/v1/videos/%d/uploads/%s", videoId, output.ID))
+	w.Header().Set("Upload-Offset", strconv.FormatInt(output.Offset, 10))
+	w.WriteHeader(http.StatusCreated)
+}
+
+func (app *application) getUploadOffsetHandler(w http.ResponseWriter, r *http.Request) {
+	videoId, ok := app.readVideoId(w, r)
+	if !ok {
+		return
+	}
+
+	output, err := app.useCases.Upload.FindOne.Execute(upload_usecase.GetUploadCommand{
+		VideoId:  videoId,
+		UploadId: chi.URLParam(r, "uploadId"),
+	})
+
+	if err != nil {
+		app.uploadErrorResponse(w, err)
+		return
+	}
+
+	w.Header().Set("Upload-Offset", strconv.FormatInt(output.Offset, 10))
+	w.Header().Set("Upload-Length", strconv.FormatInt(output.Length, 10))
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(http.StatusOK)
+}
+
+func (app *application) appendUploadChunkHandler(w http.ResponseWriter, r *http.Request) {
+	videoId, ok := app.readVideoId(w, r)
+	if !ok {
+		return
+	}
+
+	if r.Header.Get("Content-Type") != tusChunkType {
+		app.writeError(w, http.StatusUnsupportedMediaType, fmt.Sprintf("Content-Type must be %s", tusChunkType), nil)
+		return
+	}
+
+	offset, err := strconv.ParseInt(r.Header.Get("Upload-Offset"), 10, 64)
+	if err != nil || offset < 0 {
+		app.badRequestResponse(w, errors.New("'Upload-Offset' header must be a valid integer"))
+		return
+	}
+
+	rc := http.NewResponseController(w)
+	rc.SetReadDeadline(time.Time{})
+	rc.SetWriteDeadline(time.Time{})
+
+	output, err := app.useCases.Upload.AppendChunk.Execute(upload_usecase.AppendChunkCommand{
+		VideoId:  videoId,
+		UploadId: chi.URLParam(r, "uploadId"),
+		Offset:   offset,
+		Chunk:    r.Body,
+	})
+
+	if err != nil {
+		app.uploadErrorResponse(w, err)
+		return
+	}
+
+	w.Header().Set("Upload-Offset", strconv.FormatInt(output.Offset, 10))
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (app *application) terminateUploadHandler(w http.ResponseWriter, r *http.Request) {
+	videoId, ok := app.readVideoId(w, r)
+	if !ok {
+		return
+	}
+
+	err := app.useCases.Upload.Terminate.Execute(upload_usecase.TerminateUploadCommand{
+		VideoId:  videoId,
+		UploadId: chi.URLParam(r, "uploadId"),
+	})
+
+	if err != nil {
+		app.uploadErrorResponse(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (app *application) readVideoId(w http.ResponseWriter, r *http.Request) (int64, bool) {
+	return app.readIdParam(w, r, "id")
+}
+
+func (app *application) readIdParam(w http.ResponseWriter, r *http.Request, key string) (int64, bool) {
+	id, err := strconv.ParseInt(chi.URLParam(r, key), 10, 64)
+	if err != nil {
+		app.badRequestResponse(w, errors.New("invalid id"))
+		return 0, false
+	}
+
+	if id <= 0 {
+		app.notFoundResponse(w)
+		return 0, false
+	}
+
+	return id, true
+}
+
+func (app *application) uploadErrorResponse(w http.ResponseWriter, err error) {
+	var invalidErr video_usecase.InvalidMediaError
+
+	switch {
+	case errors.Is(err, upload.ErrUploadNotFound), errors.Is(err, video.ErrVideoNotFound):
+		app.notFoundResponse(w)
+	case errors.As(err, &invalidErr):
+		app.writeError(w, http.StatusBadRequest, "Could not upload media", invalidErr.Notification)
+	case errors.Is(err, upload.ErrOffsetMismatch):
+		app.writeError(w, http.StatusConflict, err.Error(), nil)
+	case errors.Is(err, video.ErrChecksumMismatch):
+		app.badRequestResponse(w, err)
+	default:
+		app.serverErrorResponse(w, err)
+	}
+}
+
+func parseUploadMetadata(header string) (map[string]string, error) {
+	metadata := map[string]string{}
+
+	for _, pair := range strings.Split(header, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+
+		key, encoded, _ := strings.Cut(pair, " ")
+
+		value, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("invalid 'Upload-Metadata' value for key %s", key)
+		}
+
+		metadata[key] = string(value)
+	}
+
+	return metadata, nil
+}
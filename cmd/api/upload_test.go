@@ -0,0 +1,191 @@
+package main
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/video"
+	video_usecase "github.com.br/gibranct/admin_do_catalogo/internal/usecases/video"
+	"github.com.br/gibranct/admin_do_catalogo/pkg/test"
+	"github.com/stretchr/testify/assert"
+)
+
+func tusRequest(method, url string, body []byte, headers map[string]string) *http.Response {
+	req, _ := http.NewRequest(method, url, bytes.NewReader(body))
+	req.Header.Set("Tus-Resumable", "1.0.0")
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		panic(err)
+	}
+	return resp
+}
+
+func uploadMetadata(fileName, mediaType string) string {
+	return fmt.Sprintf(
+		"filename %s,mediaType %s",
+		base64.StdEncoding.EncodeToString([]byte(fileName)),
+		base64.StdEncoding.EncodeToString([]byte(mediaType)),
+	)
+}
+
+func TestResumableUpload(t *testing.T) {
+	t.Cleanup(cleanUp)
+	ts, app := runTestServer()
+	defer ts.Close()
+
+	_, output := app.useCases.Video.Create.Execute(video_usecase.CreateVideoCommand{
+		Title:       "dummy title",
+		Description: "dummy desc",
+		LaunchedAt:  2025,
+		Duration:    120.0,
+		Rating:      "Livre",
+	})
+	uploadsUrl := fmt.Sprintf("%s/v1/videos/%d/uploads", ts.URL, output.ID)
+
+	t.Run("should advertise the tus protocol", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodOptions, uploadsUrl, nil)
+		resp, err := http.DefaultClient.Do(req)
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+		assert.Equal(t, "1.0.0", resp.Header.Get("Tus-Version"))
+		assert.Equal(t, "creation,termination", resp.Header.Get("Tus-Extension"))
+	})
+
+	t.Run("should return 412 when tus version is missing", func(t *testing.T) {
+		resp, err := http.Post(uploadsUrl, "", nil)
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusPreconditionFailed, resp.StatusCode)
+	})
+
+	t.Run("should upload a trailer in chunks", func(t *testing.T) {
+		content := test.DummyMP4("0123456789")
+		length := fmt.Sprint(len(content))
+		resp := tusRequest(http.MethodPost, uploadsUrl, nil, map[string]string{
+			"Upload-Length":   length,
+			"Upload-Metadata": uploadMetadata("trailer.mp4", "Trailer"),
+		})
+		assert.Equal(t, http.StatusCreated, resp.StatusCode)
+		location := ts.URL + resp.Header.Get("Location")
+
+		resp = tusRequest(http.MethodPatch, location, content[:5], map[string]string{
+			"Content-Type":  "application/offset+octet-stream",
+			"Upload-Offset": "0",
+		})
+		assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+		assert.Equal(t, "5", resp.Header.Get("Upload-Offset"))
+
+		resp = tusRequest(http.MethodHead, location, nil, nil)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "5", resp.Header.Get("Upload-Offset"))
+		assert.Equal(t, length, resp.Header.Get("Upload-Length"))
+
+		resp = tusRequest(http.MethodPatch, location, []byte("xyz"), map[string]string{
+			"Content-Type":  "application/offset+octet-stream",
+			"Upload-Offset": "0",
+		})
+		assert.Equal(t, http.StatusConflict, resp.StatusCode)
+
+		resp = tusRequest(http.MethodPatch, location, content[5:], map[string]string{
+			"Content-Type":  "application/offset+octet-stream",
+			"Upload-Offset": "5",
+		})
+		assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+		assert.Equal(t, length, resp.Header.Get("Upload-Offset"))
+
+		found, _ := app.useCases.Video.FindOne.Execute(output.ID)
+		assert.Equal(t, "trailer.mp4", found.Trailer.Name)
+		assert.Equal(t, "PENDING", found.Trailer.Status)
+	})
+
+	t.Run("should return 400 when uploaded content is not a video", func(t *testing.T) {
+		content := test.DummyPNG(1280, 720)
+		resp := tusRequest(http.MethodPost, uploadsUrl, nil, map[string]string{
+			"Upload-Length":   fmt.Sprint(len(content)),
+			"Upload-Metadata": uploadMetadata("movie.mp4", "Video"),
+		})
+		location := ts.URL + resp.Header.Get("Location")
+
+		resp = tusRequest(http.MethodPatch, location, content, map[string]string{
+			"Content-Type":  "application/offset+octet-stream",
+			"Upload-Offset": "0",
+		})
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		assert.Contains(t, test.ReadRespBody(*resp), "'Video' must be video content but got image/png")
+	})
+
+	t.Run("should terminate an upload", func(t *testing.T) {
+		resp := tusRequest(http.MethodPost, uploadsUrl, nil, map[string]string{
+			"Upload-Length":   "10",
+			"Upload-Metadata": uploadMetadata("movie.mp4", "Video"),
+		})
+		location := ts.URL + resp.Header.Get("Location")
+
+		resp = tusRequest(http.MethodDelete, location, nil, nil)
+		assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+		resp = tusRequest(http.MethodHead, location, nil, nil)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+
+	t.Run("should return 400 when media type is not audio/video", func(t *testing.T) {
+		resp := tusRequest(http.MethodPost, uploadsUrl, nil, map[string]string{
+			"Upload-Length":   "10",
+			"Upload-Metadata": uploadMetadata("banner.png", "Banner"),
+		})
+
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("should return 404 when video does not exist", func(t *testing.T) {
+		resp := tusRequest(http.MethodPost, fmt.Sprintf("%s/v1/videos/%d/uploads", ts.URL, 987654), nil, map[string]string{
+			"Upload-Length":   "10",
+			"Upload-Metadata": uploadMetadata("movie.mp4", "Video"),
+		})
+
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+}
+
+func TestResumableUploadOfKnownContent(t *testing.T) {
+	t.Cleanup(cleanUp)
+	ts, app := runTestServer()
+	defer ts.Close()
+
+	content := test.DummyMP4("shared trailer")
+	sum := sha256.Sum256(content)
+	checksum := hex.EncodeToString(sum[:])
+	command := video_usecase.CreateVideoCommand{
+		Title:       "dummy title",
+		Description: "dummy desc",
+		LaunchedAt:  2025,
+		Duration:    120.0,
+		Rating:      "Livre",
+	}
+	command.Trailer = &video.Resource{Content: content, Name: "trailer.mp4"}
+	app.useCases.Video.Create.Execute(command)
+	command.Trailer = nil
+	_, other := app.useCases.Video.Create.Execute(command)
+
+	resp := tusRequest(http.MethodPost, fmt.Sprintf("%s/v1/videos/%d/uploads", ts.URL, other.ID), nil, map[string]string{
+		"Upload-Length": fmt.Sprint(len(content)),
+		"Upload-Metadata": uploadMetadata("trailer.mp4", "Trailer") +
+			",checksum " + base64.StdEncoding.EncodeToString([]byte(checksum)),
+	})
+
+	assert.Equal(t, http.StatusCreated, resp.StatusCode)
+	assert.Equal(t, fmt.Sprint(len(content)), resp.Header.Get("Upload-Offset"))
+
+	found, _ := app.useCases.Video.FindOne.Execute(other.ID)
+	assert.Equal(t, checksum, found.Trailer.Checksum)
+	assert.Equal(t, "PENDING", found.Trailer.Status)
+}
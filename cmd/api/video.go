@@ -1,23 +1,32 @@
 package main
 
 import (
+	"errors"
 	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
 
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain"
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/video"
 	video_usecase "github.com.br/gibranct/admin_do_catalogo/internal/usecases/video"
+	"github.com/go-chi/chi/v5"
 )
 
 func (app *application) createVideoHandler(w http.ResponseWriter, r *http.Request) {
 	var input struct {
-		Title       string  `json:"title"`
-		Description string  `json:"description"`
-		LaunchedAt  int     `json:"yearLaunched"`
-		Duration    float64 `json:"duration"`
-		Opened      bool    `json:"opened"`
-		Published   bool    `json:"published"`
-		Rating      string  `json:"rating"`
-		CategoryIds []int64 `json:"categoryIds"`
-		GenreIds    []int64 `json:"genreIds"`
-		MemberIds   []int64 `json:"memberIds"`
+		Title       string       `json:"title"`
+		Description string       `json:"description"`
+		LaunchedAt  int          `json:"yearLaunched"`
+		Duration    float64      `json:"duration"`
+		Opened      bool         `json:"opened"`
+		Rating      string       `json:"rating"`
+		CategoryIds []int64      `json:"categoryIds"`
+		GenreIds    []int64      `json:"genreIds"`
+		MemberIds   []int64      `json:"memberIds"`
+		Ratings     ratingInputs `json:"ratings"`
+		Descriptors []string     `json:"descriptors"`
 	}
 
 	err := app.readJSON(w, r, &input)
@@ -32,11 +41,12 @@ func (app *application) createVideoHandler(w http.ResponseWriter, r *http.Reques
 		LaunchedAt:  input.LaunchedAt,
 		Duration:    input.Duration,
 		Opened:      input.Opened,
-		Published:   input.Published,
 		Rating:      input.Rating,
 		CategoryIds: input.CategoryIds,
 		GenreIds:    input.GenreIds,
 		MemberIds:   input.MemberIds,
+		Ratings:     input.Ratings.toCommands(),
+		Descriptors: input.Descriptors,
 	}
 
 	noti, output := app.useCases.Video.Create.Execute(command)
@@ -51,3 +61,275 @@ func (app *application) createVideoHandler(w http.ResponseWriter, r *http.Reques
 	}
 
 }
+
+func (app *application) getVideoByIdHandler(w http.ResponseWriter, r *http.Request) {
+	videoIdStr := chi.URLParam(r, "id")
+	videoId, err := strconv.ParseInt(videoIdStr, 10, 64)
+	if err != nil {
+		app.badRequestResponse(w, errors.New("invalid id"))
+		return
+	}
+
+	if videoId <= 0 {
+		app.notFoundResponse(w)
+		return
+	}
+
+	output, err := app.useCases.Video.FindOne.Execute(videoId)
+
+	if errors.Is(err, video.ErrVideoNotFound) {
+		app.notFoundResponse(w)
+		return
+	}
+
+	if err != nil {
+		app.serverErrorResponse(w, err)
+		return
+	}
+
+	err = app.writeJson(w, http.StatusOK, output, nil)
+	if err != nil {
+		app.serverErrorResponse(w, err)
+	}
+}
+
+func (app *application) listVideosHandler(w http.ResponseWriter, r *http.Request) {
+	qs := r.URL.Query()
+
+	page, err := strconv.Atoi(qs.Get("page"))
+	if err != nil {
+		app.badRequestResponse(w, err)
+		return
+	}
+
+	perPage, err := strconv.Atoi(qs.Get("perPage"))
+	if err != nil {
+		app.badRequestResponse(w, err)
+		return
+	}
+
+	query := video.VideoSearchQuery{
+		SearchQuery: domain.SearchQuery{
+			Sort:      qs.Get("sort"),
+			Term:      qs.Get("search"),
+			Page:      page,
+			PerPage:   perPage,
+			Direction: qs.Get("dir"),
+		},
+	}
+
+	if query.CategoryIds, err = app.readInt64List(qs, "categoryIds"); err != nil {
+		app.badRequestResponse(w, err)
+		return
+	}
+	if query.GenreIds, err = app.readInt64List(qs, "genreIds"); err != nil {
+		app.badRequestResponse(w, err)
+		return
+	}
+	if query.CastMemberIds, err = app.readInt64List(qs, "memberIds"); err != nil {
+		app.badRequestResponse(w, err)
+		return
+	}
+	if query.Published, err = app.readOptionalBool(qs, "published"); err != nil {
+		app.badRequestResponse(w, err)
+		return
+	}
+	if query.Opened, err = app.readOptionalBool(qs, "opened"); err != nil {
+		app.badRequestResponse(w, err)
+		return
+	}
+	if query.YearLaunchedFrom, err = app.readOptionalInt(qs, "yearLaunchedFrom"); err != nil {
+		app.badRequestResponse(w, err)
+		return
+	}
+	if query.YearLaunchedTo, err = app.readOptionalInt(qs, "yearLaunchedTo"); err != nil {
+		app.badRequestResponse(w, err)
+		return
+	}
+	if query.Descriptors, err = readDescriptorList(qs, "descriptors"); err != nil {
+		app.badRequestResponse(w, err)
+		return
+	}
+	if query.ExcludedDescriptors, err = readDescriptorList(qs, "excludeDescriptors"); err != nil {
+		app.badRequestResponse(w, err)
+		return
+	}
+
+	if ratingStr := qs.Get("rating"); ratingStr != "" {
+		rating, err := video.StringToRating(ratingStr)
+		if err != nil {
+			app.badRequestResponse(w, err)
+			return
+		}
+		query.Rating = &rating
+	}
+
+	if err = query.Validate(); err != nil {
+		app.badRequestResponse(w, err)
+		return
+	}
+
+	output, err := app.useCases.Video.FindAll.Execute(query)
+
+	if err != nil {
+		app.serverErrorResponse(w, err)
+		return
+	}
+
+	app.writeJson(w, http.StatusOK, output, nil)
+}
+
+func (app *application) updateVideoHandler(w http.ResponseWriter, r *http.Request) {
+	videoIdStr := chi.URLParam(r, "id")
+	videoId, err := strconv.ParseInt(videoIdStr, 10, 64)
+	if err != nil {
+		app.badRequestResponse(w, errors.New("invalid id"))
+		return
+	}
+
+	if videoId <= 0 {
+		app.notFoundResponse(w)
+		return
+	}
+
+	var input struct {
+		Title       string       `json:"title"`
+		Description string       `json:"description"`
+		LaunchedAt  int          `json:"yearLaunched"`
+		Duration    float64      `json:"duration"`
+		Opened      bool         `json:"opened"`
+		Rating      string       `json:"rating"`
+		CategoryIds []int64      `json:"categoryIds"`
+		GenreIds    []int64      `json:"genreIds"`
+		MemberIds   []int64      `json:"memberIds"`
+		Ratings     ratingInputs `json:"ratings"`
+		Descriptors []string     `json:"descriptors"`
+	}
+
+	err = app.readJSON(w, r, &input)
+	if err != nil {
+		app.badRequestResponse(w, err)
+		return
+	}
+
+	command := video_usecase.UpdateVideoCommand{
+		ID:          videoId,
+		Title:       input.Title,
+		Description: input.Description,
+		LaunchedAt:  input.LaunchedAt,
+		Duration:    input.Duration,
+		Opened:      input.Opened,
+		Rating:      input.Rating,
+		CategoryIds: input.CategoryIds,
+		GenreIds:    input.GenreIds,
+		MemberIds:   input.MemberIds,
+		Ratings:     input.Ratings.toCommands(),
+		Descriptors: input.Descriptors,
+	}
+
+	noti := app.useCases.Video.Update.Execute(command)
+
+	if noti == nil || !noti.HasErrors() {
+		app.writeJson(w, http.StatusOK, envelope{"id": videoId}, nil)
+		return
+	}
+
+	if slices.ContainsFunc(noti.GetErrors(), isVideoNotFound) {
+		app.notFoundResponse(w)
+		return
+	}
+
+	err = app.writeError(w, http.StatusBadRequest, "Could not update video", noti)
+	if err != nil {
+		app.serverErrorResponse(w, err)
+	}
+}
+
+type ratingInput struct {
+	System    string `json:"system"`
+	Territory string `json:"territory"`
+	Value     string `json:"value"`
+}
+
+type ratingInputs []ratingInput
+
+func (inputs ratingInputs) toCommands() []video_usecase.RatingCommand {
+	commands := []video_usecase.RatingCommand{}
+	for _, input := range inputs {
+		commands = append(commands, video_usecase.RatingCommand(input))
+	}
+	return commands
+}
+
+func (app *application) getRatingEquivalentsHandler(w http.ResponseWriter, r *http.Request) {
+	qs := r.URL.Query()
+
+	output, err := app.useCases.Video.RatingEquivalents.Execute(video_usecase.RatingCommand{
+		System: qs.Get("system"),
+		Value:  qs.Get("value"),
+	})
+
+	if err != nil {
+		app.badRequestResponse(w, err)
+		return
+	}
+
+	err = app.writeJson(w, http.StatusOK, envelope{"equivalents": output}, nil)
+	if err != nil {
+		app.serverErrorResponse(w, err)
+	}
+}
+
+func isVideoNotFound(err error) bool {
+	return errors.Is(err, video.ErrVideoNotFound)
+}
+
+func (app *application) deleteVideoByIdHandler(w http.ResponseWriter, r *http.Request) {
+	videoIdStr := chi.URLParam(r, "id")
+	videoId, err := strconv.ParseInt(videoIdStr, 10, 64)
+	if err != nil {
+		app.badRequestResponse(w, errors.New("invalid id"))
+		return
+	}
+
+	if videoId <= 0 {
+		app.notFoundResponse(w)
+		return
+	}
+
+	command := video_usecase.DeleteVideoCommand{
+		VideoId: videoId,
+	}
+
+	err = app.useCases.Video.DeleteById.Execute(command)
+
+	if errors.Is(err, video.ErrVideoNotFound) {
+		app.notFoundResponse(w)
+		return
+	}
+
+	if err != nil {
+		app.serverErrorResponse(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func readDescriptorList(qs url.Values, key string) ([]video.ContentDescriptor, error) {
+	value := qs.Get(key)
+	if value == "" {
+		return nil, nil
+	}
+
+	var descriptors []video.ContentDescriptor
+	for _, item := range strings.Split(value, ",") {
+		descriptor, err := video.StringToContentDescriptor(strings.TrimSpace(item))
+		if err != nil {
+			return nil, err
+		}
+		descriptors = append(descriptors, descriptor)
+	}
+
+	return descriptors, nil
+}
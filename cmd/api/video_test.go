@@ -8,9 +8,12 @@ import (
 	"testing"
 
 	"github.com.br/gibranct/admin_do_catalogo/internal/domain/castmember"
+	infra_series "github.com.br/gibranct/admin_do_catalogo/internal/infra/series"
+	infra_video "github.com.br/gibranct/admin_do_catalogo/internal/infra/video"
 	castmember_usecase "github.com.br/gibranct/admin_do_catalogo/internal/usecases/castmember"
 	category_usecase "github.com.br/gibranct/admin_do_catalogo/internal/usecases/category"
 	genre_usecase "github.com.br/gibranct/admin_do_catalogo/internal/usecases/genre"
+	video_usecase "github.com.br/gibranct/admin_do_catalogo/internal/usecases/video"
 	"github.com.br/gibranct/admin_do_catalogo/pkg/test"
 	"github.com/stretchr/testify/assert"
 )
@@ -41,7 +44,6 @@ func TestCreateVideo(t *testing.T) {
 			"yearLaunched": 2025,
 			"duration":     120.0,
 			"opened":       false,
-			"published":    true,
 			"rating":       "Livre",
 			"categoryIds":  categoryIds,
 			"genreIds":     []int{int(genre.ID)},
@@ -60,3 +62,357 @@ func TestCreateVideo(t *testing.T) {
 		assert.Equal(t, expectedBody, body)
 	})
 }
+
+func TestFindVideoById(t *testing.T) {
+	t.Cleanup(cleanUp)
+	ts, app := runTestServer()
+	defer ts.Close()
+
+	t.Run("should return 200 when video exists", func(t *testing.T) {
+		_, category := app.useCases.Category.Create.Execute(category_usecase.CreateCategoryCommand{
+			Name:        "dummy name",
+			Description: "dummy desc",
+		})
+		command := video_usecase.CreateVideoCommand{
+			Title:       "dummy title",
+			Description: "dummy desc",
+			LaunchedAt:  2025,
+			Duration:    120.0,
+			Rating:      "Livre",
+			CategoryIds: []int64{category.ID},
+			Ratings: []video_usecase.RatingCommand{
+				{System: "MPAA", Territory: "US", Value: "G"},
+				{System: "BBFC", Territory: "GB", Value: "U"},
+			},
+		}
+		_, output := app.useCases.Video.Create.Execute(command)
+
+		resp, err := http.Get(
+			fmt.Sprintf("%s/v1/videos/%d", ts.URL, output.ID),
+		)
+		var body video_usecase.VideoOutput
+		json.NewDecoder(resp.Body).Decode(&body)
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, output.ID, body.ID)
+		assert.Equal(t, command.Title, body.Title)
+		assert.Equal(t, command.Rating, body.Rating)
+		assert.Equal(t, []int64{category.ID}, body.CategoryIds)
+		assert.Empty(t, body.GenreIds)
+		assert.Equal(t, []video_usecase.RatingOutput{
+			{System: "BBFC", Territory: "GB", Value: "U"},
+			{System: "MPAA", Territory: "US", Value: "G"},
+		}, body.Ratings)
+		assert.Nil(t, body.Video)
+	})
+
+	t.Run("should return 404 when video does not exists", func(t *testing.T) {
+		resp, err := http.Get(
+			fmt.Sprintf("%s/v1/videos/%d", ts.URL, 999),
+		)
+		expecBody := `{"errors":[],"message":"the requested resource could not be found"}`
+		body := test.ReadRespBody(*resp)
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+		assert.Equal(t, expecBody, body)
+	})
+}
+
+func TestFindAllVideos(t *testing.T) {
+	t.Cleanup(cleanUp)
+	ts, app := runTestServer()
+	defer ts.Close()
+
+	_, category1 := app.useCases.Category.Create.Execute(category_usecase.CreateCategoryCommand{
+		Name:        "category 1",
+		Description: "dummy desc",
+	})
+	_, category2 := app.useCases.Category.Create.Execute(category_usecase.CreateCategoryCommand{
+		Name:        "category 2",
+		Description: "dummy desc",
+	})
+	command1 := video_usecase.CreateVideoCommand{
+		Title:       "video a",
+		Description: "dummy desc",
+		LaunchedAt:  1999,
+		Duration:    120.0,
+		Rating:      "Livre",
+		CategoryIds: []int64{category1.ID},
+	}
+	command2 := video_usecase.CreateVideoCommand{
+		Title:       "video b",
+		Description: "dummy desc",
+		LaunchedAt:  2010,
+		Duration:    90.0,
+		Rating:      "16",
+		CategoryIds: []int64{category2.ID},
+		Descriptors: []string{"VIOLENCE", "EXTREME_VIOLENCE"},
+	}
+	_, video1 := app.useCases.Video.Create.Execute(command1)
+	_, video2 := app.useCases.Video.Create.Execute(command2)
+	app.useCases.Video.Submit.Execute(video1.ID)
+	// publishes without readiness rules, the listing only cares about the flag
+	video_usecase.DefaultPublishVideoUseCase{
+		Gateway:       infra_video.NewVideoGateway(dbContainer.db),
+		SeriesGateway: infra_series.NewSeriesGateway(dbContainer.db),
+	}.Execute(video_usecase.PublishVideoCommand{VideoId: video1.ID})
+
+	t.Run("should return 200 when find all videos without filter sorted by title DESC", func(t *testing.T) {
+		resp, err := http.Get(
+			fmt.Sprintf("%s/v1/videos?page=1&perPage=10&sort=title&dir=DESC", ts.URL),
+		)
+		var body struct {
+			Total int                              `json:"total"`
+			Items []video_usecase.ListVideosOutput `json:"items"`
+		}
+		json.NewDecoder(resp.Body).Decode(&body)
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, 2, body.Total)
+		assert.Equal(t, video2.ID, body.Items[0].ID)
+		assert.Equal(t, video1.ID, body.Items[1].ID)
+	})
+
+	t.Run("should return 200 when find all videos filtered by category and year", func(t *testing.T) {
+		resp, err := http.Get(
+			fmt.Sprintf(
+				"%s/v1/videos?page=1&perPage=10&categoryIds=%d&yearLaunchedFrom=1990&yearLaunchedTo=2000&published=true",
+				ts.URL, category1.ID,
+			),
+		)
+		var body struct {
+			Total int                              `json:"total"`
+			Items []video_usecase.ListVideosOutput `json:"items"`
+		}
+		json.NewDecoder(resp.Body).Decode(&body)
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, 1, body.Total)
+		assert.Equal(t, video1.ID, body.Items[0].ID)
+		assert.Equal(t, command1.Rating, body.Items[0].Rating)
+	})
+
+	t.Run("should return 200 when find all videos filtered by descriptors", func(t *testing.T) {
+		resp, err := http.Get(
+			fmt.Sprintf("%s/v1/videos?page=1&perPage=10&descriptors=EXTREME_VIOLENCE,NUDITY", ts.URL),
+		)
+		var body struct {
+			Total int                              `json:"total"`
+			Items []video_usecase.ListVideosOutput `json:"items"`
+		}
+		json.NewDecoder(resp.Body).Decode(&body)
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, 1, body.Total)
+		assert.Equal(t, video2.ID, body.Items[0].ID)
+		assert.Equal(t, []string{"EXTREME_VIOLENCE", "VIOLENCE"}, body.Items[0].Descriptors)
+	})
+
+	t.Run("should return 200 when find all videos excluding descriptors", func(t *testing.T) {
+		resp, err := http.Get(
+			fmt.Sprintf("%s/v1/videos?page=1&perPage=10&excludeDescriptors=VIOLENCE", ts.URL),
+		)
+		var body struct {
+			Total int                              `json:"total"`
+			Items []video_usecase.ListVideosOutput `json:"items"`
+		}
+		json.NewDecoder(resp.Body).Decode(&body)
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, 1, body.Total)
+		assert.Equal(t, video1.ID, body.Items[0].ID)
+		assert.Equal(t, []string{}, body.Items[0].Descriptors)
+	})
+
+	t.Run("should return 400 when descriptor is unknown", func(t *testing.T) {
+		resp, err := http.Get(
+			fmt.Sprintf("%s/v1/videos?page=1&perPage=10&descriptors=GAMBLING", ts.URL),
+		)
+		expecBody := `{"errors":[],"message":"unknown content descriptor 'GAMBLING'"}`
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		assert.Equal(t, expecBody, test.ReadRespBody(*resp))
+	})
+
+	t.Run("should return 400 when sort column is invalid", func(t *testing.T) {
+		resp, err := http.Get(
+			fmt.Sprintf("%s/v1/videos?page=1&perPage=10&sort=name", ts.URL),
+		)
+		expecBody := `{"errors":[],"message":"can only sort by 'title', 'year_launched', 'duration' and 'created_at'"}`
+		body := test.ReadRespBody(*resp)
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		assert.Equal(t, expecBody, body)
+	})
+}
+
+func TestUpdateVideo(t *testing.T) {
+	t.Cleanup(cleanUp)
+	ts, app := runTestServer()
+	defer ts.Close()
+
+	_, category1 := app.useCases.Category.Create.Execute(category_usecase.CreateCategoryCommand{
+		Name:        "category 1",
+		Description: "dummy desc",
+	})
+	_, category2 := app.useCases.Category.Create.Execute(category_usecase.CreateCategoryCommand{
+		Name:        "category 2",
+		Description: "dummy desc",
+	})
+	_, output := app.useCases.Video.Create.Execute(video_usecase.CreateVideoCommand{
+		Title:       "dummy title",
+		Description: "dummy desc",
+		LaunchedAt:  2025,
+		Duration:    120.0,
+		Rating:      "Livre",
+		CategoryIds: []int64{category1.ID},
+	})
+
+	t.Run("should return 200 when update is success", func(t *testing.T) {
+		data, _ := json.Marshal(map[string]any{
+			"title":        "new title",
+			"description":  "new desc",
+			"yearLaunched": 2020,
+			"duration":     90.0,
+			"opened":       true,
+			"rating":       "16",
+			"categoryIds":  []int64{category2.ID},
+			"genreIds":     []int64{},
+			"memberIds":    []int64{},
+		})
+		req, _ := http.NewRequest(
+			http.MethodPut,
+			fmt.Sprintf("%s/v1/videos/%d", ts.URL, output.ID),
+			bytes.NewBuffer(data),
+		)
+		resp, err := http.DefaultClient.Do(req)
+		expecBody := fmt.Sprintf(`{"id":%d}`, output.ID)
+		body := test.ReadRespBody(*resp)
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, expecBody, body)
+
+		updated, _ := app.useCases.Video.FindOne.Execute(output.ID)
+		assert.Equal(t, "new title", updated.Title)
+		assert.Equal(t, "16", updated.Rating)
+		assert.Equal(t, []int64{category2.ID}, updated.CategoryIds)
+	})
+
+	t.Run("should return 400 when categories do not exist", func(t *testing.T) {
+		data, _ := json.Marshal(map[string]any{
+			"title":        "new title",
+			"description":  "new desc",
+			"yearLaunched": 2020,
+			"duration":     90.0,
+			"rating":       "16",
+			"categoryIds":  []int64{999},
+		})
+		req, _ := http.NewRequest(
+			http.MethodPut,
+			fmt.Sprintf("%s/v1/videos/%d", ts.URL, output.ID),
+			bytes.NewBuffer(data),
+		)
+		resp, err := http.DefaultClient.Do(req)
+		expecBody := `{"errors":["missing categories ids: 999"],"message":"Could not update video"}`
+		body := test.ReadRespBody(*resp)
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		assert.Equal(t, expecBody, body)
+	})
+
+	t.Run("should return 404 when video does not exist", func(t *testing.T) {
+		data, _ := json.Marshal(map[string]any{
+			"title":        "new title",
+			"description":  "new desc",
+			"yearLaunched": 2020,
+			"duration":     90.0,
+			"rating":       "16",
+		})
+		req, _ := http.NewRequest(
+			http.MethodPut,
+			fmt.Sprintf("%s/v1/videos/%d", ts.URL, 999),
+			bytes.NewBuffer(data),
+		)
+		resp, err := http.DefaultClient.Do(req)
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+}
+
+func TestDeleteVideoById(t *testing.T) {
+	t.Cleanup(cleanUp)
+	ts, app := runTestServer()
+	defer ts.Close()
+
+	_, category := app.useCases.Category.Create.Execute(category_usecase.CreateCategoryCommand{
+		Name:        "dummy name",
+		Description: "dummy desc",
+	})
+	_, output := app.useCases.Video.Create.Execute(video_usecase.CreateVideoCommand{
+		Title:       "dummy title",
+		Description: "dummy desc",
+		LaunchedAt:  2025,
+		Duration:    120.0,
+		Rating:      "Livre",
+		CategoryIds: []int64{category.ID},
+	})
+
+	t.Run("should delete video and its relations", func(t *testing.T) {
+		url := fmt.Sprintf("%s/v1/videos/%d", ts.URL, output.ID)
+		deleteReq, _ := http.NewRequest(http.MethodDelete, url, nil)
+		resp, err := http.DefaultClient.Do(deleteReq)
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+		var relations int
+		dbContainer.db.QueryRow("SELECT COUNT(*) FROM videos_categories WHERE video_id = $1", output.ID).Scan(&relations)
+		assert.Equal(t, 0, relations)
+
+		getResp, err := http.Get(url)
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusNotFound, getResp.StatusCode)
+	})
+
+	t.Run("should return 404 when the video does not exist", func(t *testing.T) {
+		url := fmt.Sprintf("%s/v1/videos/%d", ts.URL, output.ID)
+		deleteReq, _ := http.NewRequest(http.MethodDelete, url, nil)
+		resp, err := http.DefaultClient.Do(deleteReq)
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+}
+
+func TestGetRatingEquivalents(t *testing.T) {
+	t.Cleanup(cleanUp)
+	ts, _ := runTestServer()
+	defer ts.Close()
+
+	t.Run("should return the equivalent ratings in other systems", func(t *testing.T) {
+		resp, err := http.Get(fmt.Sprintf("%s/v1/ratings/equivalents?system=MPAA&value=R", ts.URL))
+		expecBody := `{"equivalents":[{"system":"ClassInd","value":"18","minimumAge":18},` +
+			`{"system":"PEGI","value":"18","minimumAge":18},{"system":"BBFC","value":"18","minimumAge":18}]}`
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, expecBody, test.ReadRespBody(*resp))
+	})
+
+	t.Run("should return 400 when rating is invalid", func(t *testing.T) {
+		resp, err := http.Get(fmt.Sprintf("%s/v1/ratings/equivalents?system=MPAA&value=15", ts.URL))
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+}
@@ -1,12 +1,15 @@
 package category
 
 import (
+	"errors"
 	"time"
 
 	"github.com.br/gibranct/admin_do_catalogo/internal/domain"
 	"github.com.br/gibranct/admin_do_catalogo/pkg/validator"
 )
 
+var ErrCategoryNotFound = errors.New("category not found")
+
 type Category struct {
 	ID          int64
 	Name        string
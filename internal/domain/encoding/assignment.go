@@ -0,0 +1,50 @@
+package encoding
+
+type ProfileSource uint8
+
+const (
+	VIDEO_PROFILE ProfileSource = iota
+	CATEGORY_PROFILE
+)
+
+type CategoryDefault struct {
+	CategoryId int64
+	ProfileId  int64
+}
+
+// the profiles that apply to a video, either assigned to it or defaulted by its categories
+type Assignments struct {
+	VideoProfileId   *int64
+	CategoryDefaults []CategoryDefault
+}
+
+// a profile assigned to the video wins over the defaults of its categories,
+// between categories the one with the lowest id wins so the choice is stable
+func (a Assignments) Resolve() (int64, ProfileSource, bool) {
+	if a.VideoProfileId != nil {
+		return *a.VideoProfileId, VIDEO_PROFILE, true
+	}
+
+	var resolved *CategoryDefault
+	for i, d := range a.CategoryDefaults {
+		if resolved == nil || d.CategoryId < resolved.CategoryId {
+			resolved = &a.CategoryDefaults[i]
+		}
+	}
+
+	if resolved == nil {
+		return 0, 0, false
+	}
+
+	return resolved.ProfileId, CATEGORY_PROFILE, true
+}
+
+func (s ProfileSource) String() string {
+	switch s {
+	case VIDEO_PROFILE:
+		return "VIDEO"
+	case CATEGORY_PROFILE:
+		return "CATEGORY"
+	}
+	return "unknown"
+}
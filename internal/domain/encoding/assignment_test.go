@@ -0,0 +1,26 @@
+package encoding_test
+
+import (
+	"testing"
+
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/encoding"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResolveAssignments(t *testing.T) {
+	videoProfileId := int64(3)
+	defaults := []encoding.CategoryDefault{{CategoryId: 9, ProfileId: 1}, {CategoryId: 4, ProfileId: 2}}
+
+	profileId, source, found := encoding.Assignments{VideoProfileId: &videoProfileId, CategoryDefaults: defaults}.Resolve()
+	assert.True(t, found)
+	assert.Equal(t, int64(3), profileId)
+	assert.Equal(t, "VIDEO", source.String())
+
+	profileId, source, found = encoding.Assignments{CategoryDefaults: defaults}.Resolve()
+	assert.True(t, found)
+	assert.Equal(t, int64(2), profileId)
+	assert.Equal(t, "CATEGORY", source.String())
+
+	_, _, found = encoding.Assignments{}.Resolve()
+	assert.False(t, found)
+}
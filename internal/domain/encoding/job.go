@@ -0,0 +1,27 @@
+package encoding
+
+import (
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/video"
+)
+
+// what the encoder needs to produce the ladder of a media, a nil profile leaves
+// the ladder to the encoder defaults
+type Job struct {
+	VideoId int64
+	Type    video.VideoMediaType
+	Media   video.AudioVideoMedia
+	Profile *Profile
+}
+
+type JobPublisher interface {
+	Publish(job Job) error
+}
+
+func NewJob(videoId int64, aType video.VideoMediaType, media video.AudioVideoMedia, profile *Profile) *Job {
+	return &Job{
+		VideoId: videoId,
+		Type:    aType,
+		Media:   media,
+		Profile: profile,
+	}
+}
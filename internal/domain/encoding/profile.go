@@ -0,0 +1,129 @@
+package encoding
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain"
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/video"
+	"github.com.br/gibranct/admin_do_catalogo/pkg/validator"
+)
+
+var ErrProfileNotFound = errors.New("encoding profile not found")
+
+// codec families mapped to the RFC 6381 prefixes the encoder reports
+var codecPrefixes = map[string][]string{
+	"h264": {"avc1", "avc3"},
+	"h265": {"hvc1", "hev1"},
+	"vp9":  {"vp09"},
+	"av1":  {"av01"},
+}
+
+var codecs = []string{"av1", "h264", "h265", "vp9"}
+
+var containers = []string{"fmp4", "ts", "webm"}
+
+type ProfileRendition struct {
+	Width     int
+	Height    int
+	Bitrate   int64
+	Codec     string
+	Container string
+}
+
+type Profile struct {
+	ID         int64
+	Name       string
+	Renditions []ProfileRendition
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+type ProfileGateway interface {
+	Create(aProfile *Profile) error
+	FindById(profileId int64) (*Profile, error)
+	Update(aProfile Profile) error
+	DeleteById(profileId int64) error
+	FindAll(query domain.SearchQuery) (*domain.Pagination[Profile], error)
+	AssignToVideo(videoId int64, profileId *int64) error
+	AssignToCategory(categoryId int64, profileId *int64) error
+	FindAssignments(videoId int64) (*Assignments, error)
+	SaveMediaProfile(mediaId int64, aProfile *Profile) error
+	FindMediaProfile(mediaId int64) (*Profile, error)
+}
+
+func NewProfile(name string, renditions []ProfileRendition) *Profile {
+	now := time.Now().UTC()
+	return &Profile{
+		Name:       name,
+		Renditions: normalize(renditions),
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+}
+
+func NewProfileRendition(width, height int, bitrate int64, codec, container string) *ProfileRendition {
+	return &ProfileRendition{
+		Width:     width,
+		Height:    height,
+		Bitrate:   bitrate,
+		Codec:     codec,
+		Container: container,
+	}
+}
+
+func (p *Profile) Update(name string, renditions []ProfileRendition) *Profile {
+	p.Name = name
+	p.Renditions = normalize(renditions)
+	p.UpdatedAt = time.Now().UTC()
+	return p
+}
+
+func (p *Profile) Validate(handler validator.ValidationHandler) {
+	NewProfileValidator(*p, handler).Validate()
+}
+
+func (r ProfileRendition) Resolution() string {
+	return fmt.Sprintf("%dx%d", r.Width, r.Height)
+}
+
+func (r ProfileRendition) String() string {
+	return fmt.Sprintf("%s %s", r.Resolution(), r.Codec)
+}
+
+// the bandwidth reported by the encoder is measured, so only the resolution
+// and the codec family are compared against what the profile asked for
+func (r ProfileRendition) MatchedBy(encoded video.Rendition) bool {
+	if r.Width != encoded.Width || r.Height != encoded.Height {
+		return false
+	}
+
+	return slices.ContainsFunc(encoded.Codecs, func(codec string) bool {
+		return slices.ContainsFunc(codecPrefixes[r.Codec], func(prefix string) bool {
+			return strings.HasPrefix(codec, prefix)
+		})
+	})
+}
+
+func (p Profile) MissingRenditions(encoded []video.Rendition) []ProfileRendition {
+	missing := []ProfileRendition{}
+	for _, expected := range p.Renditions {
+		if !slices.ContainsFunc(encoded, expected.MatchedBy) {
+			missing = append(missing, expected)
+		}
+	}
+	return missing
+}
+
+func normalize(renditions []ProfileRendition) []ProfileRendition {
+	normalized := []ProfileRendition{}
+	for _, r := range renditions {
+		r.Codec = strings.ToLower(strings.TrimSpace(r.Codec))
+		r.Container = strings.ToLower(strings.TrimSpace(r.Container))
+		normalized = append(normalized, r)
+	}
+	return normalized
+}
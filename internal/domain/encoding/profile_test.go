@@ -0,0 +1,95 @@
+package encoding_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/encoding"
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/video"
+	"github.com.br/gibranct/admin_do_catalogo/pkg/notification"
+	"github.com/stretchr/testify/assert"
+)
+
+func ladder() []encoding.ProfileRendition {
+	return []encoding.ProfileRendition{
+		*encoding.NewProfileRendition(1920, 1080, 5_000_000, "H264", " fmp4 "),
+		*encoding.NewProfileRendition(640, 360, 800_000, "h264", "fmp4"),
+	}
+}
+
+func TestProfileCreation(t *testing.T) {
+	p := encoding.NewProfile("default", ladder())
+
+	n := notification.CreateNotification()
+	p.Validate(n)
+
+	assert.False(t, n.HasErrors())
+	assert.Equal(t, "default", p.Name)
+	assert.Equal(t, "h264", p.Renditions[0].Codec)
+	assert.Equal(t, "fmp4", p.Renditions[0].Container)
+	assert.Equal(t, "1920x1080 h264", p.Renditions[0].String())
+	assert.False(t, p.CreatedAt.IsZero())
+	assert.Equal(t, p.CreatedAt, p.UpdatedAt)
+}
+
+func TestProfileUpdate(t *testing.T) {
+	p := encoding.NewProfile("default", ladder())
+	updatedAt := p.UpdatedAt
+
+	time.Sleep(1 * time.Millisecond)
+	p.Update("mobile", ladder()[1:])
+
+	assert.Equal(t, "mobile", p.Name)
+	assert.Len(t, p.Renditions, 1)
+	assert.True(t, p.UpdatedAt.After(updatedAt))
+}
+
+func TestProfileValidation(t *testing.T) {
+	tests := []struct {
+		name       string
+		renditions []encoding.ProfileRendition
+		expected   string
+	}{
+		{" ", ladder(), "'name' should not be null or empty"},
+		{strings.Repeat("a", 256), ladder(), "'name' must be between 1 and 255 characters"},
+		{"default", nil, "'renditions' should not be empty"},
+		{"default", make([]encoding.ProfileRendition, 13), "'renditions' must have at most 12 entries"},
+		{"default", []encoding.ProfileRendition{*encoding.NewProfileRendition(0, 720, 1, "h264", "ts")}, "rendition 0: resolution must be greater than 0 but got 0x720"},
+		{"default", []encoding.ProfileRendition{*encoding.NewProfileRendition(1280, 720, 0, "h264", "ts")}, "rendition 0: 'bitrate' must be greater than 0"},
+		{"default", []encoding.ProfileRendition{*encoding.NewProfileRendition(1280, 720, 1, "mpeg2", "ts")}, "rendition 0: codec 'mpeg2' must be one of av1, h264, h265, vp9"},
+		{"default", []encoding.ProfileRendition{*encoding.NewProfileRendition(1280, 720, 1, "vp9", "avi")}, "rendition 0: container 'avi' must be one of fmp4, ts, webm"},
+		{"default", []encoding.ProfileRendition{
+			*encoding.NewProfileRendition(1280, 720, 1, "vp9", "webm"),
+			*encoding.NewProfileRendition(1280, 720, 1, "vp9", "fmp4"),
+		}, "rendition 1: 1280x720 vp9 at 1 bps is duplicated"},
+	}
+
+	for _, test := range tests {
+		p := encoding.NewProfile(test.name, test.renditions)
+
+		n := notification.CreateNotification()
+		p.Validate(n)
+
+		messages := []string{}
+		for _, err := range n.GetErrors() {
+			messages = append(messages, err.Error())
+		}
+		assert.Contains(t, messages, test.expected)
+	}
+}
+
+func TestMissingRenditions(t *testing.T) {
+	p := encoding.NewProfile("default", ladder())
+
+	missing := p.MissingRenditions([]video.Rendition{
+		*video.NewRendition(5_100_000, 1920, 1080, []string{"avc1.640028", "mp4a.40.2"}, "/1080p", 6),
+		*video.NewRendition(900_000, 640, 360, []string{"hvc1.1.6.L93.B0"}, "/360p", 6),
+	})
+
+	assert.Equal(t, []encoding.ProfileRendition{p.Renditions[1]}, missing)
+	assert.Empty(t, p.MissingRenditions([]video.Rendition{
+		*video.NewRendition(900_000, 640, 360, []string{"avc3.42c01e"}, "/360p", 6),
+		*video.NewRendition(5_100_000, 1920, 1080, []string{"avc1.640028"}, "/1080p", 6),
+	}))
+}
@@ -0,0 +1,69 @@
+package encoding
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com.br/gibranct/admin_do_catalogo/pkg/validator"
+)
+
+const (
+	NAME_MAX_LENGTH = 255
+	MAX_RENDITIONS  = 12
+)
+
+type ProfileValidator struct {
+	profile  Profile
+	vHandler validator.ValidationHandler
+}
+
+func (pv ProfileValidator) Validate() {
+	name := strings.TrimSpace(pv.profile.Name)
+	if name == "" {
+		pv.vHandler.Add(errors.New("'name' should not be null or empty"))
+	}
+	if len(name) > NAME_MAX_LENGTH {
+		pv.vHandler.Add(errors.New("'name' must be between 1 and 255 characters"))
+	}
+
+	renditions := pv.profile.Renditions
+	if len(renditions) == 0 {
+		pv.vHandler.Add(errors.New("'renditions' should not be empty"))
+	}
+	if len(renditions) > MAX_RENDITIONS {
+		pv.vHandler.Add(fmt.Errorf("'renditions' must have at most %d entries", MAX_RENDITIONS))
+	}
+
+	for i, r := range renditions {
+		if r.Width <= 0 || r.Height <= 0 {
+			pv.vHandler.Add(fmt.Errorf("rendition %d: resolution must be greater than 0 but got %s", i, r.Resolution()))
+		}
+
+		if r.Bitrate <= 0 {
+			pv.vHandler.Add(fmt.Errorf("rendition %d: 'bitrate' must be greater than 0", i))
+		}
+
+		if _, ok := codecPrefixes[r.Codec]; !ok {
+			pv.vHandler.Add(fmt.Errorf("rendition %d: codec '%s' must be one of %s", i, r.Codec, strings.Join(codecs, ", ")))
+		}
+
+		if !slices.Contains(containers, r.Container) {
+			pv.vHandler.Add(fmt.Errorf("rendition %d: container '%s' must be one of %s", i, r.Container, strings.Join(containers, ", ")))
+		}
+
+		if slices.ContainsFunc(renditions[:i], func(previous ProfileRendition) bool {
+			return previous.Resolution() == r.Resolution() && previous.Codec == r.Codec && previous.Bitrate == r.Bitrate
+		}) {
+			pv.vHandler.Add(fmt.Errorf("rendition %d: %s at %d bps is duplicated", i, r, r.Bitrate))
+		}
+	}
+}
+
+func NewProfileValidator(p Profile, handler validator.ValidationHandler) *ProfileValidator {
+	return &ProfileValidator{
+		profile:  p,
+		vHandler: handler,
+	}
+}
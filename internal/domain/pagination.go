@@ -2,6 +2,7 @@ package domain
 
 import (
 	"errors"
+	"fmt"
 	"slices"
 	"strings"
 )
@@ -47,19 +48,38 @@ func (sq SearchQuery) SortColumn() string {
 }
 
 func (sq *SearchQuery) Validate() error {
+	return sq.ValidateSortableBy(safeValues)
+}
+
+func (sq *SearchQuery) ValidateSortableBy(columns []string) error {
 	if sq.Page < 1 {
 		return errors.New("invalid page")
 	}
+
 	if sq.PerPage < 1 {
 		return errors.New("perPage should be greater than zero")
 	}
 
-	if sq.Sort != "" && !slices.Contains(safeValues, sq.Sort) {
-		return errors.New("can only sort by 'name' and 'description'")
+	if sq.Sort != "" && !slices.Contains(columns, sq.Sort) {
+		return fmt.Errorf("can only sort by %s", joinColumns(columns))
 	}
+
 	if sq.Direction != "" && !slices.Contains([]string{"ASC", "DESC"}, strings.ToUpper(sq.Direction)) {
 		return errors.New("invalid direction")
 	}
 
 	return nil
 }
+
+func joinColumns(columns []string) string {
+	quoted := make([]string, 0, len(columns))
+	for _, c := range columns {
+		quoted = append(quoted, "'"+c+"'")
+	}
+
+	if len(quoted) == 1 {
+		return quoted[0]
+	}
+
+	return strings.Join(quoted[:len(quoted)-1], ", ") + " and " + quoted[len(quoted)-1]
+}
@@ -0,0 +1,136 @@
+package series
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com.br/gibranct/admin_do_catalogo/pkg/validator"
+)
+
+var ErrSeasonNotFound = errors.New("season not found")
+
+type Episode struct {
+	VideoId     int64
+	Number      int
+	Title       string
+	CategoryIds []int64
+	GenreIds    []int64
+}
+
+type Season struct {
+	ID        int64
+	SeriesId  int64
+	Number    int
+	Title     string
+	Episodes  []Episode
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type SeasonGateway interface {
+	Create(aSeason *Season) error
+	FindById(seasonId int64) (*Season, error)
+	FindBySeriesId(seriesId int64) ([]*Season, error)
+	Update(aSeason Season) error
+	DeleteById(seasonId int64) error
+	FindEpisodeSeasons(videoIds []int64) (map[int64]int64, error)
+}
+
+func NewEpisode(videoId int64, number int, title string) Episode {
+	return Episode{
+		VideoId: videoId,
+		Number:  number,
+		Title:   title,
+	}
+}
+
+// the categories and genres of an episode are the ones of its video, a video
+// without them inherits the ones of the series
+func (e Episode) InheritsCategories() bool {
+	return len(e.CategoryIds) == 0
+}
+
+func (e Episode) InheritsGenres() bool {
+	return len(e.GenreIds) == 0
+}
+
+func (e Episode) EffectiveCategoryIds(s Series) []int64 {
+	if e.InheritsCategories() {
+		return s.CategoryIds
+	}
+	return e.CategoryIds
+}
+
+func (e Episode) EffectiveGenreIds(s Series) []int64 {
+	if e.InheritsGenres() {
+		return s.GenreIds
+	}
+	return e.GenreIds
+}
+
+func NewSeason(seriesId int64, number int, title string) *Season {
+	now := time.Now().UTC()
+	return &Season{
+		SeriesId:  seriesId,
+		Number:    number,
+		Title:     title,
+		Episodes:  []Episode{},
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+func (s *Season) Update(number int, title string) *Season {
+	s.Number = number
+	s.Title = title
+	s.UpdatedAt = time.Now().UTC()
+	return s
+}
+
+func (s *Season) ReplaceEpisodes(episodes []Episode) *Season {
+	s.Episodes = slices.Clone(episodes)
+	slices.SortStableFunc(s.Episodes, func(a, b Episode) int { return a.Number - b.Number })
+	s.UpdatedAt = time.Now().UTC()
+	return s
+}
+
+func (s *Season) VideoIds() []int64 {
+	ids := []int64{}
+	for _, episode := range s.Episodes {
+		ids = append(ids, episode.VideoId)
+	}
+	return ids
+}
+
+func (s *Season) Validate(handler validator.ValidationHandler) {
+	if s.Number < 1 {
+		handler.Add(errors.New("'number' must be greater than zero"))
+	}
+	if len(strings.TrimSpace(s.Title)) > TITLE_MAX_LENGTH {
+		handler.Add(errors.New("'title' must be at most 255 characters"))
+	}
+
+	numbers := map[int]bool{}
+	videoIds := map[int64]bool{}
+
+	for _, episode := range s.Episodes {
+		if episode.Number < 1 {
+			handler.Add(fmt.Errorf("episode number must be greater than zero but got %d", episode.Number))
+		} else if numbers[episode.Number] {
+			handler.Add(fmt.Errorf("episode number %d is used more than once", episode.Number))
+		}
+		numbers[episode.Number] = true
+
+		if videoIds[episode.VideoId] {
+			handler.Add(fmt.Errorf("video %d is linked to more than one episode", episode.VideoId))
+		}
+		videoIds[episode.VideoId] = true
+
+		if len(episode.Title) > TITLE_MAX_LENGTH {
+			handler.Add(fmt.Errorf("episode %d 'title' must be at most 255 characters", episode.Number))
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package series_test
+
+import (
+	"testing"
+
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/series"
+	"github.com.br/gibranct/admin_do_catalogo/pkg/notification"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSeasonKeepsEpisodesOrderedByNumber(t *testing.T) {
+	season := series.NewSeason(1, 2, "Season 2")
+	season.ReplaceEpisodes([]series.Episode{
+		series.NewEpisode(30, 3, ""),
+		series.NewEpisode(10, 1, ""),
+		series.NewEpisode(20, 2, ""),
+	})
+
+	n := notification.CreateNotification()
+	season.Validate(n)
+
+	assert.False(t, n.HasErrors())
+	assert.Equal(t, []int64{10, 20, 30}, season.VideoIds())
+}
+
+func TestSeasonValidation(t *testing.T) {
+	season := series.NewSeason(1, 0, "")
+	season.ReplaceEpisodes([]series.Episode{
+		series.NewEpisode(10, 1, ""),
+		series.NewEpisode(11, 1, ""),
+		series.NewEpisode(10, 0, ""),
+	})
+
+	n := notification.CreateNotification()
+	season.Validate(n)
+
+	assert.Len(t, n.GetErrors(), 4)
+	assert.EqualError(t, n.GetErrors()[0], "'number' must be greater than zero")
+	assert.EqualError(t, n.GetErrors()[1], "episode number must be greater than zero but got 0")
+	assert.EqualError(t, n.GetErrors()[2], "video 10 is linked to more than one episode")
+	assert.EqualError(t, n.GetErrors()[3], "episode number 1 is used more than once")
+}
+
+func TestEpisodeInheritsFromSeries(t *testing.T) {
+	aSeries := series.NewSeries("Dark", "time travel", []int64{1, 2}, []int64{3})
+	inherited := series.NewEpisode(10, 1, "Secrets")
+	overridden := series.Episode{VideoId: 11, Number: 2, Title: "Lies", CategoryIds: []int64{5}}
+
+	assert.True(t, inherited.InheritsCategories())
+	assert.Equal(t, []int64{1, 2}, inherited.EffectiveCategoryIds(*aSeries))
+	assert.Equal(t, []int64{3}, inherited.EffectiveGenreIds(*aSeries))
+	assert.False(t, overridden.InheritsCategories())
+	assert.Equal(t, []int64{5}, overridden.EffectiveCategoryIds(*aSeries))
+	assert.Equal(t, []int64{3}, overridden.EffectiveGenreIds(*aSeries))
+}
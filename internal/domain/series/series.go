@@ -0,0 +1,65 @@
+package series
+
+import (
+	"errors"
+	"time"
+
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain"
+	"github.com.br/gibranct/admin_do_catalogo/pkg/validator"
+)
+
+var ErrSeriesNotFound = errors.New("series not found")
+
+type Series struct {
+	ID          int64
+	Title       string
+	Description string
+	CategoryIds []int64
+	GenreIds    []int64
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+type SeriesGateway interface {
+	Create(aSeries *Series) error
+	FindById(seriesId int64) (*Series, error)
+	FindByEpisode(videoId int64) (*Series, error)
+	Update(aSeries Series) error
+	DeleteById(seriesId int64) error
+	FindAll(query domain.SearchQuery) (*domain.Pagination[Series], error)
+}
+
+func NewSeries(
+	title string,
+	description string,
+	categoryIds []int64,
+	genreIds []int64,
+) *Series {
+	now := time.Now().UTC()
+	return &Series{
+		Title:       title,
+		Description: description,
+		CategoryIds: categoryIds,
+		GenreIds:    genreIds,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+}
+
+func (s *Series) Update(
+	title string,
+	description string,
+	categoryIds []int64,
+	genreIds []int64,
+) *Series {
+	s.Title = title
+	s.Description = description
+	s.CategoryIds = categoryIds
+	s.GenreIds = genreIds
+	s.UpdatedAt = time.Now().UTC()
+	return s
+}
+
+func (s *Series) Validate(handler validator.ValidationHandler) {
+	NewSeriesValidator(*s, handler).Validate()
+}
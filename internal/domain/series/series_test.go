@@ -0,0 +1,60 @@
+package series_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/series"
+	"github.com.br/gibranct/admin_do_catalogo/pkg/notification"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSeriesCreation(t *testing.T) {
+	s := series.NewSeries("Dark", "time travel", []int64{1}, []int64{2, 3})
+
+	n := notification.CreateNotification()
+	s.Validate(n)
+
+	assert.False(t, n.HasErrors())
+	assert.Equal(t, "Dark", s.Title)
+	assert.Equal(t, []int64{1}, s.CategoryIds)
+	assert.Equal(t, []int64{2, 3}, s.GenreIds)
+	assert.False(t, s.CreatedAt.IsZero())
+	assert.Equal(t, s.CreatedAt, s.UpdatedAt)
+}
+
+func TestSeriesUpdate(t *testing.T) {
+	s := series.NewSeries("Dark", "time travel", []int64{1}, nil)
+	updatedAt := s.UpdatedAt
+
+	time.Sleep(1 * time.Millisecond)
+	s.Update("Dark (2017)", "", nil, []int64{4})
+
+	assert.Equal(t, "Dark (2017)", s.Title)
+	assert.Nil(t, s.CategoryIds)
+	assert.Equal(t, []int64{4}, s.GenreIds)
+	assert.True(t, s.UpdatedAt.After(updatedAt))
+}
+
+func TestSeriesValidation(t *testing.T) {
+	tests := []struct {
+		title       string
+		description string
+		expected    string
+	}{
+		{" ", "desc", "'title' should not be null or empty"},
+		{strings.Repeat("a", 256), "desc", "'title' must be between 1 and 255 characters"},
+		{"Dark", strings.Repeat("a", 4001), "'description' must be at most 4000 characters"},
+	}
+
+	for _, test := range tests {
+		s := series.NewSeries(test.title, test.description, nil, nil)
+
+		n := notification.CreateNotification()
+		s.Validate(n)
+
+		assert.Len(t, n.GetErrors(), 1)
+		assert.EqualError(t, n.GetErrors()[0], test.expected)
+	}
+}
@@ -0,0 +1,38 @@
+package series
+
+import (
+	"errors"
+	"strings"
+
+	"github.com.br/gibranct/admin_do_catalogo/pkg/validator"
+)
+
+const (
+	TITLE_MAX_LENGTH       = 255
+	DESCRIPTION_MAX_LENGTH = 4_000
+)
+
+type SeriesValidator struct {
+	series   Series
+	vHandler validator.ValidationHandler
+}
+
+func (sv SeriesValidator) Validate() {
+	title := strings.TrimSpace(sv.series.Title)
+	if title == "" {
+		sv.vHandler.Add(errors.New("'title' should not be null or empty"))
+	}
+	if len(title) > TITLE_MAX_LENGTH {
+		sv.vHandler.Add(errors.New("'title' must be between 1 and 255 characters"))
+	}
+	if len(sv.series.Description) > DESCRIPTION_MAX_LENGTH {
+		sv.vHandler.Add(errors.New("'description' must be at most 4000 characters"))
+	}
+}
+
+func NewSeriesValidator(s Series, handler validator.ValidationHandler) *SeriesValidator {
+	return &SeriesValidator{
+		series:   s,
+		vHandler: handler,
+	}
+}
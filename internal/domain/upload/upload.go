@@ -0,0 +1,101 @@
+package upload
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"io"
+	"time"
+
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/video"
+	"github.com.br/gibranct/admin_do_catalogo/pkg/validator"
+)
+
+var ErrUploadNotFound = errors.New("upload not found")
+var ErrOffsetMismatch = errors.New("upload offset mismatch")
+
+type Upload struct {
+	ID          string
+	VideoId     int64
+	MediaType   video.VideoMediaType
+	Length      int64
+	Offset      int64
+	FileName    string
+	Checksum    string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	FinalizedAt *time.Time
+}
+
+type UploadGateway interface {
+	Create(upload *Upload) error
+	FindById(uploadId string) (*Upload, error)
+	UpdateOffset(upload Upload, previousOffset int64) error
+	Finalize(upload Upload) error
+	DeleteById(uploadId string) error
+}
+
+type ChunkStorage interface {
+	Append(uploadId string, offset int64, chunk io.Reader) (int64, error)
+	Open(uploadId string) (io.ReadCloser, error)
+	Remove(uploadId string) error
+}
+
+func NewUpload(
+	videoId int64,
+	mediaType video.VideoMediaType,
+	length int64,
+	fileName string,
+	checksum string,
+) *Upload {
+	now := time.Now().UTC()
+	return &Upload{
+		ID:        newUploadId(),
+		VideoId:   videoId,
+		MediaType: mediaType,
+		Length:    length,
+		Offset:    0,
+		FileName:  fileName,
+		Checksum:  checksum,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+func (u *Upload) Advance(written int64) error {
+	if u.Offset+written > u.Length {
+		return errors.New("upload offset exceeds upload length")
+	}
+	u.Offset += written
+	u.UpdatedAt = time.Now().UTC()
+	return nil
+}
+
+func (u *Upload) Remaining() int64 {
+	return u.Length - u.Offset
+}
+
+func (u *Upload) IsCompleted() bool {
+	return u.Offset == u.Length
+}
+
+// the media was stored from the upload, its chunks are no longer needed
+func (u *Upload) Finalize() {
+	now := time.Now().UTC()
+	u.FinalizedAt = &now
+	u.UpdatedAt = now
+}
+
+func (u *Upload) IsFinalized() bool {
+	return u.FinalizedAt != nil
+}
+
+func (u *Upload) Validate(handler validator.ValidationHandler) {
+	NewUploadValidator(*u, handler).Validate()
+}
+
+func newUploadId() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,69 @@
+package upload_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/upload"
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/video"
+	"github.com.br/gibranct/admin_do_catalogo/pkg/notification"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUploadCreation(t *testing.T) {
+	u := upload.NewUpload(10, video.VIDEO, 100, "movie.mp4", "checksum")
+
+	n := notification.CreateNotification()
+	u.Validate(n)
+
+	assert.False(t, n.HasErrors())
+	assert.Len(t, u.ID, 32)
+	assert.Equal(t, int64(10), u.VideoId)
+	assert.Equal(t, video.VIDEO, u.MediaType)
+	assert.Equal(t, int64(100), u.Length)
+	assert.Equal(t, int64(0), u.Offset)
+	assert.False(t, u.IsCompleted())
+	assert.False(t, u.CreatedAt.IsZero())
+	assert.NotEqual(t, u.ID, upload.NewUpload(10, video.VIDEO, 100, "movie.mp4", "").ID)
+}
+
+func TestUploadAdvance(t *testing.T) {
+	u := upload.NewUpload(10, video.TRAILER, 100, "trailer.mp4", "")
+	updatedAt := u.UpdatedAt
+
+	time.Sleep(1 * time.Millisecond)
+
+	assert.Nil(t, u.Advance(60))
+	assert.Equal(t, int64(60), u.Offset)
+	assert.Equal(t, int64(40), u.Remaining())
+	assert.True(t, u.UpdatedAt.After(updatedAt))
+
+	assert.Nil(t, u.Advance(40))
+	assert.True(t, u.IsCompleted())
+
+	assert.EqualError(t, u.Advance(1), "upload offset exceeds upload length")
+	assert.Equal(t, int64(100), u.Offset)
+}
+
+func TestUploadValidation(t *testing.T) {
+	u := upload.NewUpload(10, video.BANNER, 0, " ", "")
+
+	n := notification.CreateNotification()
+	u.Validate(n)
+
+	assert.Len(t, n.GetErrors(), 3)
+	assert.Equal(t, "'mediaType' must be Video or Trailer", n.GetErrors()[0].Error())
+	assert.Equal(t, "'length' should be greater than zero", n.GetErrors()[1].Error())
+	assert.Equal(t, "'filename' should not be empty", n.GetErrors()[2].Error())
+}
+
+func TestUploadFinalize(t *testing.T) {
+	u := upload.NewUpload(10, video.VIDEO, 100, "movie.mp4", "")
+
+	assert.False(t, u.IsFinalized())
+
+	u.Finalize()
+
+	assert.True(t, u.IsFinalized())
+	assert.Equal(t, *u.FinalizedAt, u.UpdatedAt)
+}
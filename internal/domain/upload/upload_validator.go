@@ -0,0 +1,35 @@
+package upload
+
+import (
+	"errors"
+	"strings"
+
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/video"
+	"github.com.br/gibranct/admin_do_catalogo/pkg/validator"
+)
+
+type UploadValidator struct {
+	upload   Upload
+	vHandler validator.ValidationHandler
+}
+
+func (uv UploadValidator) Validate() {
+	if uv.upload.MediaType != video.VIDEO && uv.upload.MediaType != video.TRAILER {
+		uv.vHandler.Add(errors.New("'mediaType' must be Video or Trailer"))
+	}
+
+	if uv.upload.Length <= 0 {
+		uv.vHandler.Add(errors.New("'length' should be greater than zero"))
+	}
+
+	if strings.TrimSpace(uv.upload.FileName) == "" {
+		uv.vHandler.Add(errors.New("'filename' should not be empty"))
+	}
+}
+
+func NewUploadValidator(upload Upload, vHandler validator.ValidationHandler) *UploadValidator {
+	return &UploadValidator{
+		upload:   upload,
+		vHandler: vHandler,
+	}
+}
@@ -0,0 +1,228 @@
+package video
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com.br/gibranct/admin_do_catalogo/pkg/validator"
+)
+
+var ErrArtworkNotFound = errors.New("artwork not found")
+
+type ArtworkRole uint8
+
+const (
+	POSTER ArtworkRole = iota
+	BACKDROP
+	LOGO
+	SQUARE_TILE
+)
+
+// logos keep the proportions of the title treatment, so only their width is checked
+var artworkRules = map[ArtworkRole]imageRule{
+	POSTER:      {minWidth: 600, minHeight: 900, aspectWidth: 2, aspectHeight: 3},
+	BACKDROP:    {minWidth: 1280, minHeight: 720, aspectWidth: 16, aspectHeight: 9},
+	LOGO:        {minWidth: 400},
+	SQUARE_TILE: {minWidth: 400, minHeight: 400, aspectWidth: 1, aspectHeight: 1},
+}
+
+var knownAspectRatios = [][2]int{{16, 9}, {4, 3}, {21, 9}, {2, 1}, {3, 2}, {1, 1}, {2, 3}, {3, 4}, {4, 5}, {9, 16}}
+
+// an empty language marks artwork without text, usable for any language
+type Artwork struct {
+	ID       int64
+	Role     ArtworkRole
+	Language string
+	Width    int
+	Height   int
+	Image    *ImageMedia
+}
+
+func NewArtwork(role ArtworkRole, language string, width, height int, image *ImageMedia) *Artwork {
+	return &Artwork{Role: role, Language: language, Width: width, Height: height, Image: image}
+}
+
+func ArtworkRoles() []ArtworkRole {
+	return []ArtworkRole{POSTER, BACKDROP, LOGO, SQUARE_TILE}
+}
+
+func (r ArtworkRole) String() string {
+	switch r {
+	case POSTER:
+		return "POSTER"
+	case BACKDROP:
+		return "BACKDROP"
+	case LOGO:
+		return "LOGO"
+	case SQUARE_TILE:
+		return "SQUARE_TILE"
+	}
+	return "unknown"
+}
+
+func StringToArtworkRole(roleStr string) (ArtworkRole, error) {
+	for _, role := range ArtworkRoles() {
+		if role.String() == roleStr {
+			return role, nil
+		}
+	}
+	return POSTER, fmt.Errorf("unknown artwork role '%s'", roleStr)
+}
+
+// the closest common ratio within tolerance, otherwise the reduced dimensions
+func (a Artwork) AspectRatio() string {
+	if a.Width <= 0 || a.Height <= 0 {
+		return ""
+	}
+
+	ratio := float64(a.Width) / float64(a.Height)
+	for _, known := range knownAspectRatios {
+		expected := float64(known[0]) / float64(known[1])
+		if math.Abs(ratio-expected)/expected <= ASPECT_RATIO_TOLERANCE {
+			return fmt.Sprintf("%d:%d", known[0], known[1])
+		}
+	}
+
+	divisor := gcd(a.Width, a.Height)
+	return fmt.Sprintf("%d:%d", a.Width/divisor, a.Height/divisor)
+}
+
+func (a Artwork) IsVariantOf(other Artwork) bool {
+	return a.Role == other.Role && a.Language == other.Language && a.Width == other.Width
+}
+
+func (a Artwork) Validate(handler validator.ValidationHandler) {
+	if a.Language != "" && !IsLanguageTag(a.Language) {
+		handler.Add(fmt.Errorf("'language' must be a BCP 47 tag such as 'pt-BR' but got '%s'", a.Language))
+	}
+
+	rule := artworkRules[a.Role]
+	name := a.Role.String()
+
+	if a.Width < rule.minWidth || a.Height < rule.minHeight {
+		handler.Add(fmt.Errorf(
+			"'%s' artwork must be at least %dx%d but got %dx%d",
+			name, rule.minWidth, rule.minHeight, a.Width, a.Height,
+		))
+	}
+
+	if rule.aspectWidth == 0 || a.Height <= 0 {
+		return
+	}
+
+	expectedRatio := float64(rule.aspectWidth) / float64(rule.aspectHeight)
+	ratio := float64(a.Width) / float64(a.Height)
+	if math.Abs(ratio-expectedRatio)/expectedRatio > ASPECT_RATIO_TOLERANCE {
+		handler.Add(fmt.Errorf(
+			"'%s' artwork aspect ratio must be %d:%d but got %dx%d",
+			name, rule.aspectWidth, rule.aspectHeight, a.Width, a.Height,
+		))
+	}
+}
+
+func (v *Video) FindArtwork(id int64) (*Artwork, bool) {
+	index := slices.IndexFunc(v.Artworks, func(a Artwork) bool { return a.ID == id })
+	if index < 0 {
+		return nil, false
+	}
+	return &v.Artworks[index], true
+}
+
+func (v *Video) FindArtworkVariant(artwork Artwork) (*Artwork, bool) {
+	index := slices.IndexFunc(v.Artworks, artwork.IsVariantOf)
+	if index < 0 {
+		return nil, false
+	}
+	return &v.Artworks[index], true
+}
+
+// replaces the variant with the same role, language and width
+func (v *Video) UpdateArtwork(artwork Artwork) *Video {
+	if current, found := v.FindArtworkVariant(artwork); found {
+		*current = artwork
+	} else {
+		v.Artworks = append(v.Artworks, artwork)
+	}
+	v.UpdatedAt = time.Now().UTC()
+	return v
+}
+
+func (v *Video) RemoveArtwork(id int64) (*Artwork, bool) {
+	artwork, found := v.FindArtwork(id)
+	if !found {
+		return nil, false
+	}
+
+	removed := *artwork
+	v.Artworks = slices.DeleteFunc(v.Artworks, func(a Artwork) bool { return a.ID == id })
+	v.UpdatedAt = time.Now().UTC()
+	return &removed, true
+}
+
+// prefers the requested language, then its base language, then artwork without text.
+// among those, the smallest artwork covering the width wins, or the largest when none does
+func (v *Video) BestArtwork(role ArtworkRole, language string, width int) (*Artwork, bool) {
+	var best *Artwork
+	bestRank := -1
+
+	for i := range v.Artworks {
+		candidate := &v.Artworks[i]
+		if candidate.Role != role {
+			continue
+		}
+
+		rank := languageRank(candidate.Language, language)
+		if rank < 0 {
+			continue
+		}
+
+		if rank > bestRank || (rank == bestRank && fitsBetter(*candidate, *best, width)) {
+			best, bestRank = candidate, rank
+		}
+	}
+
+	return best, best != nil
+}
+
+func languageRank(candidate, requested string) int {
+	switch {
+	case requested != "" && candidate == requested:
+		return 3
+	case requested != "" && candidate != "" && baseLanguage(candidate) == baseLanguage(requested):
+		return 2
+	case candidate == "":
+		return 1
+	case requested == "":
+		return 0
+	}
+	return -1
+}
+
+func baseLanguage(tag string) string {
+	base, _, _ := strings.Cut(tag, "-")
+	return base
+}
+
+func fitsBetter(candidate, current Artwork, width int) bool {
+	candidateCovers := width > 0 && candidate.Width >= width
+	currentCovers := width > 0 && current.Width >= width
+
+	switch {
+	case candidateCovers && currentCovers:
+		return candidate.Width < current.Width
+	case candidateCovers != currentCovers:
+		return candidateCovers
+	}
+	return candidate.Width > current.Width
+}
+
+func gcd(a, b int) int {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return a
+}
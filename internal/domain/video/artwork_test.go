@@ -0,0 +1,110 @@
+package video
+
+import (
+	"testing"
+
+	"github.com.br/gibranct/admin_do_catalogo/pkg/notification"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStringToArtworkRole(t *testing.T) {
+	for _, role := range ArtworkRoles() {
+		result, err := StringToArtworkRole(role.String())
+		assert.Nil(t, err)
+		assert.Equal(t, role, result)
+	}
+
+	_, err := StringToArtworkRole("CHARACTER")
+	assert.EqualError(t, err, "unknown artwork role 'CHARACTER'")
+	assert.Equal(t, "unknown", ArtworkRole(99).String())
+}
+
+func TestArtworkAspectRatio(t *testing.T) {
+	assert.Equal(t, "2:3", NewArtwork(POSTER, "", 1000, 1500, nil).AspectRatio())
+	assert.Equal(t, "16:9", NewArtwork(BACKDROP, "", 1366, 768, nil).AspectRatio())
+	assert.Equal(t, "1:1", NewArtwork(SQUARE_TILE, "", 512, 512, nil).AspectRatio())
+	assert.Equal(t, "5:1", NewArtwork(LOGO, "", 1000, 200, nil).AspectRatio())
+	assert.Equal(t, "", NewArtwork(LOGO, "", 0, 0, nil).AspectRatio())
+}
+
+func TestValidateArtwork(t *testing.T) {
+	n := notification.CreateNotification()
+	NewArtwork(POSTER, "pt-BR", 1000, 1500, nil).Validate(n)
+	NewArtwork(LOGO, "", 800, 120, nil).Validate(n)
+	assert.False(t, n.HasErrors())
+
+	NewArtwork(POSTER, "pt_BR", 1920, 1080, nil).Validate(n)
+	NewArtwork(SQUARE_TILE, "", 200, 200, nil).Validate(n)
+	assert.Equal(t, []string{
+		"'language' must be a BCP 47 tag such as 'pt-BR' but got 'pt_BR'",
+		"'POSTER' artwork aspect ratio must be 2:3 but got 1920x1080",
+		"'SQUARE_TILE' artwork must be at least 400x400 but got 200x200",
+	}, errorMessages(n))
+}
+
+func TestUpdateAndRemoveArtwork(t *testing.T) {
+	aVideo := NewVideo("title", "desc", 2024, 120.0, true, L, nil, nil, nil)
+	aVideo.UpdateArtwork(Artwork{ID: 1, Role: POSTER, Language: "en", Width: 600, Height: 900})
+	aVideo.UpdateArtwork(Artwork{ID: 2, Role: POSTER, Language: "en", Width: 1200, Height: 1800})
+	aVideo.UpdateArtwork(Artwork{ID: 1, Role: POSTER, Language: "en", Width: 600, Height: 900, Image: &ImageMedia{Checksum: "new"}})
+
+	assert.Len(t, aVideo.Artworks, 2)
+	artwork, found := aVideo.FindArtwork(1)
+	assert.True(t, found)
+	assert.Equal(t, "new", artwork.Image.Checksum)
+
+	removed, found := aVideo.RemoveArtwork(2)
+	assert.True(t, found)
+	assert.Equal(t, 1200, removed.Width)
+	assert.Len(t, aVideo.Artworks, 1)
+
+	_, found = aVideo.RemoveArtwork(2)
+	assert.False(t, found)
+}
+
+func TestBestArtwork(t *testing.T) {
+	aVideo := NewVideo("title", "desc", 2024, 120.0, true, L, nil, nil, nil)
+	aVideo.Artworks = []Artwork{
+		{ID: 1, Role: POSTER, Language: "", Width: 2000, Height: 3000},
+		{ID: 2, Role: POSTER, Language: "pt-BR", Width: 600, Height: 900},
+		{ID: 3, Role: POSTER, Language: "pt-BR", Width: 1200, Height: 1800},
+		{ID: 4, Role: POSTER, Language: "pt-PT", Width: 1000, Height: 1500},
+		{ID: 5, Role: POSTER, Language: "en", Width: 1000, Height: 1500},
+		{ID: 6, Role: BACKDROP, Language: "", Width: 1920, Height: 1080},
+	}
+
+	cases := []struct {
+		role     ArtworkRole
+		language string
+		width    int
+		expected int64
+	}{
+		{POSTER, "pt-BR", 500, 2},
+		{POSTER, "pt-BR", 800, 3},
+		{POSTER, "pt-BR", 4000, 3},
+		{POSTER, "pt-BR", 0, 3},
+		{POSTER, "pt", 900, 4},
+		{POSTER, "es", 300, 1},
+		{POSTER, "", 300, 1},
+		{BACKDROP, "en", 1280, 6},
+	}
+
+	for _, c := range cases {
+		artwork, found := aVideo.BestArtwork(c.role, c.language, c.width)
+		assert.True(t, found)
+		assert.Equal(t, c.expected, artwork.ID, "%s %s %d", c.role, c.language, c.width)
+	}
+
+	_, found := aVideo.BestArtwork(LOGO, "en", 300)
+	assert.False(t, found)
+}
+
+func TestImageSize(t *testing.T) {
+	width, height, err := ImageSize(encodedPng(640, 480))
+	assert.Nil(t, err)
+	assert.Equal(t, 640, width)
+	assert.Equal(t, 480, height)
+
+	_, _, err = ImageSize([]byte("RIFF\x00\x00\x00\x00WEBPVP8 "))
+	assert.ErrorIs(t, err, ErrUnsupportedImage)
+}
@@ -0,0 +1,108 @@
+package video
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com.br/gibranct/admin_do_catalogo/pkg/validator"
+)
+
+type AudioTrackRole uint8
+
+const (
+	ORIGINAL AudioTrackRole = iota
+	DUB
+)
+
+type AudioTrack struct {
+	Language string
+	Role     AudioTrackRole
+	Media    *AudioVideoMedia
+}
+
+func NewAudioTrack(language string, role AudioTrackRole, media *AudioVideoMedia) *AudioTrack {
+	return &AudioTrack{Language: language, Role: role, Media: media}
+}
+
+func AudioTrackRoles() []AudioTrackRole {
+	return []AudioTrackRole{ORIGINAL, DUB}
+}
+
+func (r AudioTrackRole) String() string {
+	switch r {
+	case ORIGINAL:
+		return "ORIGINAL"
+	case DUB:
+		return "DUB"
+	}
+	return "unknown"
+}
+
+func StringToAudioTrackRole(roleStr string) (AudioTrackRole, error) {
+	for _, role := range AudioTrackRoles() {
+		if role.String() == roleStr {
+			return role, nil
+		}
+	}
+	return ORIGINAL, fmt.Errorf("unknown audio track role '%s'", roleStr)
+}
+
+func (t AudioTrack) IsCompleted() bool {
+	return t.Media != nil && t.Media.Status != nil && *t.Media.Status == COMPLETED
+}
+
+func (v *Video) FindAudioTrack(language string) (*AudioTrack, bool) {
+	index := slices.IndexFunc(v.AudioTracks, func(t AudioTrack) bool { return t.Language == language })
+	if index < 0 {
+		return nil, false
+	}
+	return &v.AudioTracks[index], true
+}
+
+func (v *Video) FindAudioTrackByMediaId(mediaId int64) (*AudioTrack, bool) {
+	index := slices.IndexFunc(v.AudioTracks, func(t AudioTrack) bool { return t.Media != nil && t.Media.ID == mediaId })
+	if index < 0 {
+		return nil, false
+	}
+	return &v.AudioTracks[index], true
+}
+
+// replaces the track in the same language, a video carries a single track per language
+func (v *Video) UpdateAudioTrack(track AudioTrack) *Video {
+	if current, found := v.FindAudioTrack(track.Language); found {
+		*current = track
+	} else {
+		v.AudioTracks = append(v.AudioTracks, track)
+	}
+	v.UpdatedAt = time.Now().UTC()
+	return v
+}
+
+func (v *Video) RemoveAudioTrack(language string) (*AudioTrack, bool) {
+	track, found := v.FindAudioTrack(language)
+	if !found {
+		return nil, false
+	}
+
+	removed := *track
+	v.AudioTracks = slices.DeleteFunc(v.AudioTracks, func(t AudioTrack) bool { return t.Language == language })
+	v.UpdatedAt = time.Now().UTC()
+	return &removed, true
+}
+
+func (v *Video) ValidateAudioTrack(track AudioTrack, handler validator.ValidationHandler) {
+	if !IsLanguageTag(track.Language) {
+		handler.Add(fmt.Errorf("'language' must be a BCP 47 tag such as 'pt-BR' but got '%s'", track.Language))
+	}
+
+	if track.Role != ORIGINAL {
+		return
+	}
+
+	for _, other := range v.AudioTracks {
+		if other.Role == ORIGINAL && other.Language != track.Language {
+			handler.Add(fmt.Errorf("'%s' is already the ORIGINAL audio track", other.Language))
+		}
+	}
+}
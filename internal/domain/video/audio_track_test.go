@@ -0,0 +1,77 @@
+package video
+
+import (
+	"testing"
+
+	"github.com.br/gibranct/admin_do_catalogo/pkg/notification"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStringToAudioTrackRole(t *testing.T) {
+	for _, role := range AudioTrackRoles() {
+		result, err := StringToAudioTrackRole(role.String())
+		assert.Nil(t, err)
+		assert.Equal(t, role, result)
+	}
+
+	_, err := StringToAudioTrackRole("COMMENTARY")
+	assert.EqualError(t, err, "unknown audio track role 'COMMENTARY'")
+	assert.Equal(t, "unknown", AudioTrackRole(99).String())
+}
+
+func TestUpdateAndRemoveAudioTracks(t *testing.T) {
+	aVideo := NewVideo("title", "desc", 2024, 120.0, true, L, nil, nil, nil)
+	first := NewAudioVideoMediaWith(1, nil, "sum1", "pt.mp4", "/pt.mp4", "")
+	second := NewAudioVideoMediaWith(2, nil, "sum2", "pt2.mp4", "/pt2.mp4", "")
+
+	aVideo.UpdateAudioTrack(*NewAudioTrack("pt-BR", ORIGINAL, first))
+	aVideo.UpdateAudioTrack(*NewAudioTrack("en", DUB, nil))
+	aVideo.UpdateAudioTrack(*NewAudioTrack("pt-BR", ORIGINAL, second))
+
+	assert.Len(t, aVideo.AudioTracks, 2)
+	track, found := aVideo.FindAudioTrackByMediaId(2)
+	assert.True(t, found)
+	assert.Equal(t, "pt-BR", track.Language)
+
+	removed, found := aVideo.RemoveAudioTrack("pt-BR")
+	assert.True(t, found)
+	assert.Equal(t, second, removed.Media)
+	assert.Len(t, aVideo.AudioTracks, 1)
+
+	_, found = aVideo.RemoveAudioTrack("es")
+	assert.False(t, found)
+}
+
+func TestValidateAudioTrack(t *testing.T) {
+	aVideo := NewVideo("title", "desc", 2024, 120.0, true, L, nil, nil, nil)
+	aVideo.UpdateAudioTrack(*NewAudioTrack("en", ORIGINAL, nil))
+
+	n := notification.CreateNotification()
+	aVideo.ValidateAudioTrack(*NewAudioTrack("pt-BR", DUB, nil), n)
+	aVideo.ValidateAudioTrack(*NewAudioTrack("en", ORIGINAL, nil), n)
+	assert.False(t, n.HasErrors())
+
+	aVideo.ValidateAudioTrack(*NewAudioTrack("pt_BR", ORIGINAL, nil), n)
+	assert.Equal(t, []string{
+		"'language' must be a BCP 47 tag such as 'pt-BR' but got 'pt_BR'",
+		"'en' is already the ORIGINAL audio track",
+	}, errorMessages(n))
+}
+
+func TestDefaultAudioTrackRule(t *testing.T) {
+	rule := DefaultAudioTrackRule("pt-BR")
+	aVideo := NewVideo("title", "desc", 2024, 120.0, true, L, nil, nil, nil)
+	status := PENDING
+	aVideo.UpdateAudioTrack(*NewAudioTrack("pt-BR", DUB, NewAudioVideoMediaWith(1, &status, "sum", "pt.mp4", "/pt.mp4", "")))
+
+	assert.Equal(t, "a COMPLETED 'pt-BR' audio track must be attached", rule.Description)
+	assert.False(t, rule.IsSatisfied(aVideo))
+
+	media, err := aVideo.AudioTracks[0].Media.Encoded(COMPLETED, "/encoded/pt", "")
+	assert.Nil(t, err)
+	aVideo.AudioTracks[0].Media = media
+	assert.True(t, rule.IsSatisfied(aVideo))
+
+	_, err = media.Encoded(PENDING, "", "")
+	assert.EqualError(t, err, "encoder cannot move a media to PENDING")
+}
@@ -1,5 +1,9 @@
 package video
 
+import (
+	"fmt"
+)
+
 type AudioVideoMedia struct {
 	ID              int64
 	Checksum        string
@@ -7,6 +11,10 @@ type AudioVideoMedia struct {
 	RawLocation     string
 	EncodedLocation string
 	Status          *MediaStatus
+	FailureReason   string
+	Attempts        int
+	Metadata        *MediaMetadata
+	Renditions      []Rendition
 }
 
 func NewAudioVideoMediaWith(
@@ -27,30 +35,70 @@ func NewAudioVideoMediaWith(
 	}
 }
 
-func (avm *AudioVideoMedia) processing() *AudioVideoMedia {
-	status := PROCESSING
-	return NewAudioVideoMediaWith(
-		avm.ID,
-		&status,
-		avm.Checksum,
-		avm.Name,
-		avm.RawLocation,
-		avm.EncodedLocation,
-	)
+func (avm *AudioVideoMedia) processing() (*AudioVideoMedia, error) {
+	return avm.transitionTo(PROCESSING, avm.EncodedLocation, "", avm.Renditions)
+}
+
+// the ladder is only known once the encoder completes
+func (avm *AudioVideoMedia) completed(encodedPath string, renditions []Rendition) (*AudioVideoMedia, error) {
+	return avm.transitionTo(COMPLETED, encodedPath, "", renditions)
+}
+
+func (avm *AudioVideoMedia) failed(reason string) (*AudioVideoMedia, error) {
+	return avm.transitionTo(FAILED, avm.EncodedLocation, reason, avm.Renditions)
+}
+
+func (avm *AudioVideoMedia) retry() (*AudioVideoMedia, error) {
+	return avm.transitionTo(PENDING, avm.EncodedLocation, "", avm.Renditions)
 }
 
-func (avm *AudioVideoMedia) completed(encodedPath string) *AudioVideoMedia {
-	status := COMPLETED
-	return NewAudioVideoMediaWith(
+// applies a status reported by the encoder
+func (avm *AudioVideoMedia) Encoded(next MediaStatus, encodedPath, reason string, renditions ...Rendition) (*AudioVideoMedia, error) {
+	switch next {
+	case PROCESSING:
+		return avm.processing()
+	case COMPLETED:
+		return avm.completed(encodedPath, renditions)
+	case FAILED:
+		return avm.failed(reason)
+	}
+	return nil, fmt.Errorf("%w to %s", ErrUnsupportedEncoderStatus, next)
+}
+
+func (avm *AudioVideoMedia) transitionTo(next MediaStatus, encodedPath, reason string, renditions []Rendition) (*AudioVideoMedia, error) {
+	current := avm.currentStatus()
+
+	if !current.CanTransitionTo(next) {
+		return nil, InvalidMediaStatusTransitionError{From: current, To: next}
+	}
+
+	media := NewAudioVideoMediaWith(
 		avm.ID,
-		&status,
+		&next,
 		avm.Checksum,
 		avm.Name,
 		avm.RawLocation,
 		encodedPath,
 	)
+	media.FailureReason = reason
+	media.Attempts = avm.Attempts
+	media.Metadata = avm.Metadata
+	media.Renditions = renditions
+
+	if current == PENDING {
+		media.Attempts++
+	}
+
+	return media, nil
+}
+
+func (avm *AudioVideoMedia) currentStatus() MediaStatus {
+	if avm.Status == nil {
+		return PENDING
+	}
+	return *avm.Status
 }
 
 func (avm *AudioVideoMedia) IsPendingEncode() bool {
-	return PENDING == *avm.Status
+	return PENDING == avm.currentStatus()
 }
@@ -0,0 +1,101 @@
+package video
+
+import (
+	"fmt"
+
+	"github.com.br/gibranct/admin_do_catalogo/pkg/validator"
+)
+
+type ContentDescriptor uint8
+
+const (
+	FEAR ContentDescriptor = iota
+	VIOLENCE
+	EXTREME_VIOLENCE
+	NUDITY
+	SEXUAL_CONTENT
+	EXPLICIT_SEX
+	LEGAL_DRUGS
+	ILLEGAL_DRUGS
+	STRONG_LANGUAGE
+	CRIMINAL_ACTS
+)
+
+// lowest ClassInd rating able to carry each descriptor
+var descriptorMinimumRatings = map[ContentDescriptor]Rating{
+	FEAR:             AGE_10,
+	VIOLENCE:         AGE_10,
+	EXTREME_VIOLENCE: AGE_16,
+	NUDITY:           AGE_12,
+	SEXUAL_CONTENT:   AGE_14,
+	EXPLICIT_SEX:     AGE_18,
+	LEGAL_DRUGS:      AGE_10,
+	ILLEGAL_DRUGS:    AGE_14,
+	STRONG_LANGUAGE:  AGE_12,
+	CRIMINAL_ACTS:    AGE_12,
+}
+
+func ContentDescriptors() []ContentDescriptor {
+	return []ContentDescriptor{
+		FEAR, VIOLENCE, EXTREME_VIOLENCE, NUDITY, SEXUAL_CONTENT,
+		EXPLICIT_SEX, LEGAL_DRUGS, ILLEGAL_DRUGS, STRONG_LANGUAGE, CRIMINAL_ACTS,
+	}
+}
+
+func (cd ContentDescriptor) String() string {
+	switch cd {
+	case FEAR:
+		return "FEAR"
+	case VIOLENCE:
+		return "VIOLENCE"
+	case EXTREME_VIOLENCE:
+		return "EXTREME_VIOLENCE"
+	case NUDITY:
+		return "NUDITY"
+	case SEXUAL_CONTENT:
+		return "SEXUAL_CONTENT"
+	case EXPLICIT_SEX:
+		return "EXPLICIT_SEX"
+	case LEGAL_DRUGS:
+		return "LEGAL_DRUGS"
+	case ILLEGAL_DRUGS:
+		return "ILLEGAL_DRUGS"
+	case STRONG_LANGUAGE:
+		return "STRONG_LANGUAGE"
+	case CRIMINAL_ACTS:
+		return "CRIMINAL_ACTS"
+	}
+	return "unknown"
+}
+
+func StringToContentDescriptor(descriptorStr string) (ContentDescriptor, error) {
+	for _, descriptor := range ContentDescriptors() {
+		if descriptor.String() == descriptorStr {
+			return descriptor, nil
+		}
+	}
+	return FEAR, fmt.Errorf("unknown content descriptor '%s'", descriptorStr)
+}
+
+func (cd ContentDescriptor) MinimumRating() Rating {
+	return descriptorMinimumRatings[cd]
+}
+
+func validateDescriptors(v Video, handler validator.ValidationHandler) {
+	seen := map[ContentDescriptor]bool{}
+
+	for _, descriptor := range v.Descriptors {
+		if seen[descriptor] {
+			handler.Add(fmt.Errorf("'descriptors' must not repeat %s", descriptor))
+		}
+		seen[descriptor] = true
+
+		// ER and Livre share the lowest rank, the remaining ratings are ordered by age
+		if v.Rating != UNKNOWN && v.Rating < descriptor.MinimumRating() {
+			handler.Add(fmt.Errorf(
+				"'%s' rating cannot carry the %s descriptor, it requires '%s' or higher",
+				v.Rating, descriptor, descriptor.MinimumRating(),
+			))
+		}
+	}
+}
@@ -0,0 +1,53 @@
+package video
+
+import (
+	"testing"
+
+	"github.com.br/gibranct/admin_do_catalogo/pkg/notification"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStringToContentDescriptor(t *testing.T) {
+	for _, descriptor := range ContentDescriptors() {
+		result, err := StringToContentDescriptor(descriptor.String())
+		assert.Nil(t, err)
+		assert.Equal(t, descriptor, result)
+	}
+
+	_, err := StringToContentDescriptor("GAMBLING")
+	assert.EqualError(t, err, "unknown content descriptor 'GAMBLING'")
+	assert.Equal(t, "unknown", ContentDescriptor(99).String())
+}
+
+func TestDescriptorsConsistentWithRating(t *testing.T) {
+	tests := []struct {
+		rating      Rating
+		descriptors []ContentDescriptor
+		messages    []string
+	}{
+		{L, nil, nil},
+		{AGE_18, ContentDescriptors(), nil},
+		{AGE_14, []ContentDescriptor{VIOLENCE, SEXUAL_CONTENT, STRONG_LANGUAGE}, nil},
+		{L, []ContentDescriptor{EXPLICIT_SEX}, []string{
+			"'Livre' rating cannot carry the EXPLICIT_SEX descriptor, it requires '18' or higher",
+		}},
+		{ER, []ContentDescriptor{FEAR}, []string{
+			"'ER' rating cannot carry the FEAR descriptor, it requires '10' or higher",
+		}},
+		{AGE_12, []ContentDescriptor{NUDITY, NUDITY}, []string{"'descriptors' must not repeat NUDITY"}},
+	}
+
+	for _, test := range tests {
+		aVideo := NewVideo("title", "desc", 2025, 120.0, true, test.rating, nil, nil, nil)
+		aVideo.UpdateDescriptors(test.descriptors)
+
+		n := notification.CreateNotification()
+		aVideo.Validate(n)
+
+		messages := []string(nil)
+		for _, err := range n.GetErrors() {
+			messages = append(messages, err.Error())
+		}
+		assert.Equal(t, test.messages, messages, "%s %v", test.rating, test.descriptors)
+	}
+}
@@ -0,0 +1,186 @@
+package video
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com.br/gibranct/admin_do_catalogo/pkg/validator"
+)
+
+var ErrExtraNotFound = errors.New("extra not found")
+
+type ExtraType uint8
+
+const (
+	EXTRA_TRAILER ExtraType = iota
+	EXTRA_TEASER
+	EXTRA_CLIP
+	EXTRA_BEHIND_THE_SCENES
+)
+
+type Extra struct {
+	ID       int64
+	Type     ExtraType
+	Title    string
+	Position int
+	Media    *AudioVideoMedia
+}
+
+func NewExtra(aType ExtraType, title string) *Extra {
+	return &Extra{Type: aType, Title: title}
+}
+
+func ExtraTypes() []ExtraType {
+	return []ExtraType{EXTRA_TRAILER, EXTRA_TEASER, EXTRA_CLIP, EXTRA_BEHIND_THE_SCENES}
+}
+
+func (t ExtraType) String() string {
+	switch t {
+	case EXTRA_TRAILER:
+		return "TRAILER"
+	case EXTRA_TEASER:
+		return "TEASER"
+	case EXTRA_CLIP:
+		return "CLIP"
+	case EXTRA_BEHIND_THE_SCENES:
+		return "BEHIND_THE_SCENES"
+	}
+	return "unknown"
+}
+
+func StringToExtraType(typeStr string) (ExtraType, error) {
+	for _, aType := range ExtraTypes() {
+		if aType.String() == typeStr {
+			return aType, nil
+		}
+	}
+	return EXTRA_TRAILER, fmt.Errorf("unknown extra type '%s'", typeStr)
+}
+
+func (v *Video) FindExtra(id int64) (*Extra, bool) {
+	index := slices.IndexFunc(v.Extras, func(e Extra) bool { return e.ID == id })
+	if index < 0 {
+		return nil, false
+	}
+	return &v.Extras[index], true
+}
+
+func (v *Video) FindExtraByMediaId(mediaId int64) (*Extra, bool) {
+	index := slices.IndexFunc(v.Extras, func(e Extra) bool { return e.Media != nil && e.Media.ID == mediaId })
+	if index < 0 {
+		return nil, false
+	}
+	return &v.Extras[index], true
+}
+
+// the primary trailer shares its media with the extra it was promoted from
+func (v *Video) IsPrimaryTrailer(extra Extra) bool {
+	return extra.Media != nil && v.Trailer != nil && v.Trailer.ID == extra.Media.ID
+}
+
+// new extras go to the end of the collection
+func (v *Video) AddExtra(extra Extra) *Video {
+	extra.Position = len(v.Extras) + 1
+	v.Extras = append(v.Extras, extra)
+	v.UpdatedAt = time.Now().UTC()
+	return v
+}
+
+func (v *Video) UpdateExtra(extra Extra) *Video {
+	if current, found := v.FindExtra(extra.ID); found {
+		extra.Position = current.Position
+		*current = extra
+		v.UpdatedAt = time.Now().UTC()
+	}
+	return v
+}
+
+func (v *Video) RemoveExtra(id int64) (*Extra, bool) {
+	extra, found := v.FindExtra(id)
+	if !found {
+		return nil, false
+	}
+
+	removed := *extra
+	if v.IsPrimaryTrailer(removed) {
+		v.Trailer = nil
+	}
+
+	v.Extras = slices.DeleteFunc(v.Extras, func(e Extra) bool { return e.ID == id })
+	v.renumberExtras()
+	v.UpdatedAt = time.Now().UTC()
+	return &removed, true
+}
+
+// ids must list every extra of the video exactly once, in the new order
+func (v *Video) ReorderExtras(ids []int64, handler validator.ValidationHandler) {
+	if len(ids) != len(v.Extras) {
+		handler.Add(fmt.Errorf("'extraIds' must list all %d extras of the video but got %d", len(v.Extras), len(ids)))
+		return
+	}
+
+	reordered := make([]Extra, 0, len(ids))
+	for _, id := range ids {
+		extra, found := v.FindExtra(id)
+		if !found {
+			handler.Add(fmt.Errorf("video %d has no extra %d", v.ID, id))
+			continue
+		}
+
+		if slices.ContainsFunc(reordered, func(e Extra) bool { return e.ID == id }) {
+			handler.Add(fmt.Errorf("extra %d is listed more than once", id))
+			continue
+		}
+
+		reordered = append(reordered, *extra)
+	}
+
+	if handler.HasErrors() {
+		return
+	}
+
+	v.Extras = reordered
+	v.renumberExtras()
+	v.UpdatedAt = time.Now().UTC()
+}
+
+func (v *Video) PromoteExtra(id int64) error {
+	extra, found := v.FindExtra(id)
+	if !found {
+		return fmt.Errorf("%w: video %d has no extra %d", ErrExtraNotFound, v.ID, id)
+	}
+
+	if extra.Type != EXTRA_TRAILER {
+		return fmt.Errorf("only %s extras can be the primary trailer but extra %d is a %s", EXTRA_TRAILER, id, extra.Type)
+	}
+
+	if extra.Media == nil {
+		return fmt.Errorf("extra %d has no media to be the primary trailer", id)
+	}
+
+	v.UpdateTrailerMedia(extra.Media)
+	return nil
+}
+
+func (v *Video) ValidateExtra(extra Extra, handler validator.ValidationHandler) {
+	if extra.Title == "" {
+		handler.Add(errors.New("'title' should not be null or empty"))
+	}
+
+	if len(extra.Title) > TITLE_MAX_LENGTH {
+		handler.Add(errors.New("'title' must be between 1 and 255 characters"))
+	}
+
+	current, found := v.FindExtra(extra.ID)
+	if found && v.IsPrimaryTrailer(*current) && extra.Type != EXTRA_TRAILER {
+		handler.Add(fmt.Errorf("extra %d is the primary trailer and must remain a %s", extra.ID, EXTRA_TRAILER))
+	}
+}
+
+func (v *Video) renumberExtras() {
+	for i := range v.Extras {
+		v.Extras[i].Position = i + 1
+	}
+}
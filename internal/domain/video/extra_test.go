@@ -0,0 +1,108 @@
+package video
+
+import (
+	"testing"
+
+	"github.com.br/gibranct/admin_do_catalogo/pkg/notification"
+	"github.com/stretchr/testify/assert"
+)
+
+func videoWithExtras() *Video {
+	aVideo := NewVideo("title", "desc", 2024, 120.0, true, L, nil, nil, nil)
+	aVideo.AddExtra(Extra{ID: 1, Type: EXTRA_TRAILER, Title: "Trailer", Media: NewAudioVideoMediaWith(10, nil, "sum1", "trailer.mp4", "/trailer.mp4", "")})
+	aVideo.AddExtra(Extra{ID: 2, Type: EXTRA_TEASER, Title: "Teaser"})
+	aVideo.AddExtra(Extra{ID: 3, Type: EXTRA_CLIP, Title: "Clip"})
+	return aVideo
+}
+
+func extraIds(extras []Extra) []int64 {
+	ids := []int64{}
+	for _, extra := range extras {
+		ids = append(ids, extra.ID)
+	}
+	return ids
+}
+
+func TestStringToExtraType(t *testing.T) {
+	for _, aType := range ExtraTypes() {
+		result, err := StringToExtraType(aType.String())
+		assert.Nil(t, err)
+		assert.Equal(t, aType, result)
+	}
+
+	_, err := StringToExtraType("BLOOPER")
+	assert.EqualError(t, err, "unknown extra type 'BLOOPER'")
+	assert.Equal(t, "unknown", ExtraType(99).String())
+}
+
+func TestAddAndRemoveExtras(t *testing.T) {
+	aVideo := videoWithExtras()
+	assert.Equal(t, []int{1, 2, 3}, []int{aVideo.Extras[0].Position, aVideo.Extras[1].Position, aVideo.Extras[2].Position})
+
+	aVideo.UpdateExtra(Extra{ID: 3, Type: EXTRA_BEHIND_THE_SCENES, Title: "Making of"})
+	extra, found := aVideo.FindExtra(3)
+	assert.True(t, found)
+	assert.Equal(t, "Making of", extra.Title)
+	assert.Equal(t, 3, extra.Position)
+
+	removed, found := aVideo.RemoveExtra(2)
+	assert.True(t, found)
+	assert.Equal(t, EXTRA_TEASER, removed.Type)
+	assert.Equal(t, []int64{1, 3}, extraIds(aVideo.Extras))
+	assert.Equal(t, 2, aVideo.Extras[1].Position)
+
+	_, found = aVideo.RemoveExtra(2)
+	assert.False(t, found)
+}
+
+func TestReorderExtras(t *testing.T) {
+	aVideo := videoWithExtras()
+
+	n := notification.CreateNotification()
+	aVideo.ReorderExtras([]int64{3, 1, 2}, n)
+	assert.False(t, n.HasErrors())
+	assert.Equal(t, []int64{3, 1, 2}, extraIds(aVideo.Extras))
+	assert.Equal(t, 1, aVideo.Extras[0].Position)
+	assert.Equal(t, 3, aVideo.Extras[2].Position)
+
+	n = notification.CreateNotification()
+	aVideo.ReorderExtras([]int64{1, 2}, n)
+	assert.Equal(t, []string{"'extraIds' must list all 3 extras of the video but got 2"}, errorMessages(n))
+
+	n = notification.CreateNotification()
+	aVideo.ReorderExtras([]int64{1, 1, 9}, n)
+	assert.Equal(t, []string{
+		"extra 1 is listed more than once",
+		"video 0 has no extra 9",
+	}, errorMessages(n))
+	assert.Equal(t, []int64{3, 1, 2}, extraIds(aVideo.Extras))
+}
+
+func TestPromoteExtra(t *testing.T) {
+	aVideo := videoWithExtras()
+
+	assert.EqualError(t, aVideo.PromoteExtra(2), "only TRAILER extras can be the primary trailer but extra 2 is a TEASER")
+	assert.ErrorIs(t, aVideo.PromoteExtra(9), ErrExtraNotFound)
+
+	assert.Nil(t, aVideo.PromoteExtra(1))
+	assert.Equal(t, int64(10), aVideo.Trailer.ID)
+	assert.True(t, aVideo.IsPrimaryTrailer(aVideo.Extras[0]))
+
+	n := notification.CreateNotification()
+	aVideo.ValidateExtra(Extra{ID: 1, Type: EXTRA_CLIP, Title: ""}, n)
+	assert.Equal(t, []string{
+		"'title' should not be null or empty",
+		"extra 1 is the primary trailer and must remain a TRAILER",
+	}, errorMessages(n))
+
+	aVideo.RemoveExtra(1)
+	assert.Nil(t, aVideo.Trailer)
+}
+
+func TestPromoteExtraWithoutMedia(t *testing.T) {
+	aVideo := NewVideo("title", "desc", 2024, 120.0, true, L, nil, nil, nil)
+	aVideo.AddExtra(Extra{ID: 1, Type: EXTRA_TRAILER, Title: "Trailer"})
+
+	assert.EqualError(t, aVideo.PromoteExtra(1), "extra 1 has no media to be the primary trailer")
+	assert.Nil(t, aVideo.Trailer)
+}
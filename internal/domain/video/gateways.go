@@ -1,10 +1,26 @@
 package video
 
+import (
+	"errors"
+	"io"
+	"time"
+
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain"
+)
+
+var ErrResourceNotFound = errors.New("resource not found")
+var ErrChecksumMismatch = errors.New("checksum mismatch")
+var ErrUnsupportedImage = errors.New("unsupported image format")
+var ErrUnsupportedMedia = errors.New("unsupported media format")
+
 type Resource struct {
 	Content     []byte
+	Stream      io.Reader
 	Checksum    string
 	ContentType string
 	Name        string
+	Size        int64
+	ModifiedAt  time.Time
 }
 
 type VideoResource struct {
@@ -12,15 +28,65 @@ type VideoResource struct {
 	Resource Resource
 }
 
+// stored content is only served under its location once committed, which happens after
+// the database references it; content whose database write failed is discarded instead
 type MediaResourceGateway interface {
 	StoreAudioVideo(videoId int64, resource VideoResource) (*AudioVideoMedia, error)
 	StoreImage(videoId int64, resource VideoResource) (*ImageMedia, error)
 	GetResource(videoId int64, aType VideoMediaType) (*Resource, error)
+	GetEncoded(location string) (*Resource, error)
+	StoreTextTrack(videoId int64, track TextTrack, resource Resource) (*TextTrack, error)
+	GetTextTrack(videoId int64, language string, kind TextTrackKind) (*Resource, error)
+	StoreAudioTrack(videoId int64, language string, resource Resource) (*AudioVideoMedia, error)
+	RemoveAudioTrack(videoId int64, language string) error
+	StoreExtra(videoId int64, extraId int64, resource Resource) (*AudioVideoMedia, error)
+	GetExtra(videoId int64, extraId int64) (*Resource, error)
+	RemoveExtra(videoId int64, extraId int64) error
+	StoreArtwork(videoId int64, artwork Artwork, resource Resource) (*ImageMedia, error)
+	GetArtwork(videoId int64, artwork Artwork) (*Resource, error)
+	RemoveArtwork(videoId int64, artwork Artwork) error
+	GetBlob(checksum string) (*Resource, error)
+	Commit(location, checksum string) error
+	Discard(checksum string) error
+	Exists(checksum string) (bool, error)
+	Release(checksum string) error
 	ClearResources(videoId int64) error
 }
 
+type ThumbnailGenerator interface {
+	GenerateHalf(thumbnail Resource) (*Resource, error)
+}
+
+type MediaMetadataExtractor interface {
+	Extract(content io.ReadSeeker) (*MediaMetadata, error)
+}
+
+type ManifestGenerator interface {
+	Generate(format ManifestFormat, media AudioVideoMedia) (*Resource, error)
+}
+
+type MediaReferenceCounter interface {
+	CountMediaReferences(checksum string) (int64, error)
+}
+
 type VideoGateway interface {
 	Create(aVideo Video) (*Video, error)
-	DeleteById(aVideo int64) error
+	Update(aVideo Video) (*Video, error)
+	DeleteById(videoId int64) error
+	UpdateMediaStatus(media AudioVideoMedia) error
+	UpdatePublication(aVideo Video, previous PublicationStatus) error
+	FindDueForPublication(now time.Time) ([]int64, error)
 	FindById(videoId int64) (*Video, error)
+	FindAll(query VideoSearchQuery) (*domain.Pagination[Video], error)
+	ExistsByIds(videoIds []int64) ([]int64, error)
+	SaveTextTrack(videoId int64, track TextTrack) error
+	FindTextTracks(videoId int64) ([]TextTrack, error)
+	SaveAudioTrack(videoId int64, track AudioTrack) (*AudioTrack, error)
+	DeleteAudioTrack(videoId int64, language string) error
+	SaveExtra(videoId int64, extra Extra) (*Extra, error)
+	DeleteExtra(videoId int64, extraId int64) error
+	ReorderExtras(videoId int64, extras []Extra) error
+	UpdatePrimaryTrailer(videoId int64, mediaId int64) error
+	SaveArtwork(videoId int64, artwork Artwork) (*Artwork, error)
+	DeleteArtwork(videoId int64, artworkId int64) error
 }
@@ -1,10 +1,17 @@
 package video
 
+import (
+	"path/filepath"
+	"strings"
+)
+
 type ImageMedia struct {
 	ID       int64
 	Checksum string
 	Name     string
 	Location string
+	// set when the image was derived by the service instead of uploaded
+	Generated bool
 }
 
 func NewImageMediaWithId(
@@ -27,3 +34,8 @@ func NewImageMediaWithoutId(
 		Location: location,
 	}
 }
+
+func HalfThumbnailName(name string) string {
+	ext := filepath.Ext(name)
+	return strings.TrimSuffix(name, ext) + "_half" + ext
+}
@@ -35,3 +35,9 @@ func TestCreteNewImageMediaWithoutId(t *testing.T) {
 	assert.Equal(t, name, imageMedia.Name)
 	assert.Equal(t, location, imageMedia.Location)
 }
+
+func TestHalfThumbnailName(t *testing.T) {
+	assert.Equal(t, "cover_half.jpg", HalfThumbnailName("cover.jpg"))
+	assert.Equal(t, "cover.v2_half.png", HalfThumbnailName("cover.v2.png"))
+	assert.Equal(t, "cover_half", HalfThumbnailName("cover"))
+}
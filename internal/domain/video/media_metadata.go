@@ -0,0 +1,37 @@
+package video
+
+import (
+	"fmt"
+	"math"
+
+	"github.com.br/gibranct/admin_do_catalogo/pkg/validator"
+)
+
+// video durations are entered in minutes
+const DURATION_TOLERANCE = 1.0
+
+type MediaMetadata struct {
+	Duration   float64
+	Width      int
+	Height     int
+	Codecs     []string
+	TrackCount int
+}
+
+func (m MediaMetadata) DurationInMinutes() float64 {
+	return m.Duration / 60
+}
+
+func (v *Video) ValidateDuration(handler validator.ValidationHandler) {
+	if v.Video == nil || v.Video.Metadata == nil {
+		return
+	}
+
+	actual := v.Video.Metadata.DurationInMinutes()
+	if math.Abs(actual-v.Duration) > DURATION_TOLERANCE {
+		handler.Add(fmt.Errorf(
+			"'duration' is %.2f minutes but the video file lasts %.2f minutes",
+			v.Duration, actual,
+		))
+	}
+}
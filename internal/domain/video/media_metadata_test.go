@@ -0,0 +1,40 @@
+package video
+
+import (
+	"testing"
+
+	"github.com.br/gibranct/admin_do_catalogo/pkg/notification"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateDuration(t *testing.T) {
+	aVideo := NewVideo("title", "desc", 2025, 120.0, true, L, nil, nil, nil)
+	status := PENDING
+	aVideo.UpdateVideoMedia(NewAudioVideoMediaWith(1, &status, "checksum", "movie.mp4", "/movie.mp4", ""))
+
+	n := notification.CreateNotification()
+	aVideo.ValidateDuration(n)
+	assert.False(t, n.HasErrors())
+
+	aVideo.Video.Metadata = &MediaMetadata{Duration: 7230}
+	aVideo.ValidateDuration(n)
+	assert.False(t, n.HasErrors())
+
+	aVideo.Video.Metadata = &MediaMetadata{Duration: 5400}
+	aVideo.ValidateDuration(n)
+	assert.Len(t, n.GetErrors(), 1)
+	assert.Equal(t, "'duration' is 120.00 minutes but the video file lasts 90.00 minutes", n.GetErrors()[0].Error())
+}
+
+func TestMediaMetadataSurvivesStatusChanges(t *testing.T) {
+	aVideo := NewVideo("title", "desc", 2025, 120.0, true, L, nil, nil, nil)
+	status := PENDING
+	aVideo.UpdateVideoMedia(NewAudioVideoMediaWith(1, &status, "checksum", "movie.mp4", "/movie.mp4", ""))
+	metadata := &MediaMetadata{Duration: 7200, Width: 1920, Height: 1080, Codecs: []string{"avc1"}, TrackCount: 1}
+	aVideo.Video.Metadata = metadata
+
+	assert.Nil(t, aVideo.Processing(VIDEO))
+	assert.Nil(t, aVideo.Completed(VIDEO, "/encoded"))
+
+	assert.Equal(t, metadata, aVideo.Video.Metadata)
+}
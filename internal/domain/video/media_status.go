@@ -1,13 +1,40 @@
 package video
 
+import (
+	"errors"
+	"fmt"
+	"slices"
+)
+
 type MediaStatus uint8
 
 const (
 	PENDING MediaStatus = iota
 	PROCESSING
 	COMPLETED
+	FAILED
 )
 
+var ErrUnknownMediaStatus = errors.New("unknown media status")
+
+// the encoder only reports progress, it cannot move a media back to PENDING
+var ErrUnsupportedEncoderStatus = errors.New("encoder cannot move a media")
+
+var allowedTransitions = map[MediaStatus][]MediaStatus{
+	PENDING:    {PROCESSING, COMPLETED, FAILED},
+	PROCESSING: {COMPLETED, FAILED},
+	FAILED:     {PENDING},
+}
+
+type InvalidMediaStatusTransitionError struct {
+	From MediaStatus
+	To   MediaStatus
+}
+
+func (e InvalidMediaStatusTransitionError) Error() string {
+	return fmt.Sprintf("media status cannot change from %s to %s", e.From, e.To)
+}
+
 func (ms MediaStatus) String() string {
 	switch ms {
 	case PENDING:
@@ -16,6 +43,27 @@ func (ms MediaStatus) String() string {
 		return "PROCESSING"
 	case COMPLETED:
 		return "COMPLETED"
+	case FAILED:
+		return "FAILED"
 	}
 	return "unknown"
 }
+
+func (ms MediaStatus) CanTransitionTo(next MediaStatus) bool {
+	return slices.Contains(allowedTransitions[ms], next)
+}
+
+func StringToMediaStatus(statusStr string) (MediaStatus, error) {
+	switch statusStr {
+	case "PENDING":
+		return PENDING, nil
+	case "PROCESSING":
+		return PROCESSING, nil
+	case "COMPLETED":
+		return COMPLETED, nil
+	case "FAILED":
+		return FAILED, nil
+	default:
+		return PENDING, ErrUnknownMediaStatus
+	}
+}
@@ -1,6 +1,7 @@
 package video
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -23,9 +24,77 @@ func TestMediaStatusString(t *testing.T) {
 			mediaStatus: COMPLETED,
 			expected:    "COMPLETED",
 		},
+		{
+			mediaStatus: FAILED,
+			expected:    "FAILED",
+		},
 	}
 
 	for _, test := range tests {
 		assert.Equal(t, test.expected, test.mediaStatus.String())
 	}
 }
+
+func TestStringToMediaStatus(t *testing.T) {
+	tests := []struct {
+		statusString   string
+		expectedResult MediaStatus
+		err            error
+	}{
+		{
+			statusString:   "PENDING",
+			expectedResult: PENDING,
+			err:            nil,
+		},
+		{
+			statusString:   "PROCESSING",
+			expectedResult: PROCESSING,
+			err:            nil,
+		},
+		{
+			statusString:   "COMPLETED",
+			expectedResult: COMPLETED,
+			err:            nil,
+		},
+		{
+			statusString:   "FAILED",
+			expectedResult: FAILED,
+			err:            nil,
+		},
+		{
+			statusString:   "dummy",
+			expectedResult: PENDING,
+			err:            errors.New("unknown media status"),
+		},
+	}
+
+	for _, test := range tests {
+		status, err := StringToMediaStatus(test.statusString)
+		assert.Equal(t, test.err, err)
+		assert.Equal(t, test.expectedResult, status)
+	}
+}
+
+func TestMediaStatusTransitions(t *testing.T) {
+	tests := []struct {
+		from    MediaStatus
+		to      MediaStatus
+		allowed bool
+	}{
+		{PENDING, PROCESSING, true},
+		{PENDING, COMPLETED, true},
+		{PENDING, FAILED, true},
+		{PROCESSING, COMPLETED, true},
+		{PROCESSING, FAILED, true},
+		{FAILED, PENDING, true},
+		{PENDING, PENDING, false},
+		{PROCESSING, PENDING, false},
+		{COMPLETED, PROCESSING, false},
+		{COMPLETED, FAILED, false},
+		{FAILED, COMPLETED, false},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.allowed, test.from.CanTransitionTo(test.to), "%s -> %s", test.from, test.to)
+	}
+}
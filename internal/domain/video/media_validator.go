@@ -0,0 +1,205 @@
+package video
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+	"math"
+	"slices"
+	"strings"
+
+	"github.com.br/gibranct/admin_do_catalogo/pkg/validator"
+)
+
+const ASPECT_RATIO_TOLERANCE = 0.01
+
+type imageRule struct {
+	minWidth     int
+	minHeight    int
+	aspectWidth  int
+	aspectHeight int
+}
+
+var imageRules = map[VideoMediaType]imageRule{
+	BANNER:         {minWidth: 1280, minHeight: 720, aspectWidth: 16, aspectHeight: 9},
+	THUMBNAIL:      {minWidth: 640, minHeight: 360, aspectWidth: 16, aspectHeight: 9},
+	THUMBNAIL_HALF: {minWidth: 320, minHeight: 180, aspectWidth: 16, aspectHeight: 9},
+	// artwork bounds depend on its role and are checked by the artwork itself
+	ARTWORK: {},
+}
+
+var decodableImages = []string{"image/gif", "image/jpeg", "image/png"}
+
+// QuickTime files predating ftyp start right away with one of these boxes
+var legacyQuickTimeBoxes = []string{"moov", "mdat", "wide", "free", "skip"}
+
+// containers sniffed as video that commonly carry audio only streams
+var audioContainers = []string{"video/mp4", "video/webm"}
+
+type MediaValidator struct {
+	mediaType   VideoMediaType
+	contentType string
+	head        []byte
+	vHandler    validator.ValidationHandler
+}
+
+func (mv MediaValidator) Validate() {
+	name := mv.mediaType.String()
+	expected := "image"
+	switch mv.mediaType {
+	case VIDEO, TRAILER:
+		expected = "video"
+	case AUDIO:
+		expected = "audio"
+	}
+
+	if declared := mediaFamily(mv.contentType); declared != "" && declared != expected && !mv.isAudioContainer(mv.contentType) {
+		mv.vHandler.Add(fmt.Errorf("'%s' content type %s is not allowed", name, mv.contentType))
+	}
+
+	if len(mv.head) == 0 {
+		mv.vHandler.Add(fmt.Errorf("'%s' should not be empty", name))
+		return
+	}
+
+	sniffed := sniffContentType(mv.head)
+	if sniffed == "" {
+		mv.vHandler.Add(fmt.Errorf("'%s' content is not a recognized %s format", name, expected))
+		return
+	}
+
+	if mv.isAudioContainer(sniffed) {
+		return
+	}
+
+	if !strings.HasPrefix(sniffed, expected+"/") {
+		mv.vHandler.Add(fmt.Errorf("'%s' must be %s content but got %s", name, expected, sniffed))
+		return
+	}
+
+	if rule, ok := imageRules[mv.mediaType]; ok {
+		mv.validateImage(name, sniffed, rule)
+	}
+}
+
+func (mv MediaValidator) validateImage(name, contentType string, rule imageRule) {
+	if !slices.Contains(decodableImages, contentType) {
+		mv.vHandler.Add(fmt.Errorf("'%s' image format %s is not supported", name, contentType))
+		return
+	}
+
+	config, _, err := image.DecodeConfig(bytes.NewReader(mv.head))
+	if err != nil {
+		mv.vHandler.Add(fmt.Errorf("'%s' image dimensions could not be read", name))
+		return
+	}
+
+	if config.Width < rule.minWidth || config.Height < rule.minHeight {
+		mv.vHandler.Add(fmt.Errorf(
+			"'%s' must be at least %dx%d but got %dx%d",
+			name, rule.minWidth, rule.minHeight, config.Width, config.Height,
+		))
+	}
+
+	if rule.aspectWidth == 0 {
+		return
+	}
+
+	expectedRatio := float64(rule.aspectWidth) / float64(rule.aspectHeight)
+	ratio := float64(config.Width) / float64(config.Height)
+	if math.Abs(ratio-expectedRatio)/expectedRatio > ASPECT_RATIO_TOLERANCE {
+		mv.vHandler.Add(fmt.Errorf(
+			"'%s' aspect ratio must be %d:%d but got %dx%d",
+			name, rule.aspectWidth, rule.aspectHeight, config.Width, config.Height,
+		))
+	}
+}
+
+// reads the dimensions from the image header without decoding its pixels
+func ImageSize(head []byte) (int, int, error) {
+	if !slices.Contains(decodableImages, sniffContentType(head)) {
+		return 0, 0, ErrUnsupportedImage
+	}
+
+	config, _, err := image.DecodeConfig(bytes.NewReader(head))
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return config.Width, config.Height, nil
+}
+
+func (mv MediaValidator) isAudioContainer(contentType string) bool {
+	base, _, _ := strings.Cut(contentType, ";")
+	return mv.mediaType == AUDIO && slices.Contains(audioContainers, strings.ToLower(strings.TrimSpace(base)))
+}
+
+func NewMediaValidator(
+	mediaType VideoMediaType,
+	contentType string,
+	head []byte,
+	handler validator.ValidationHandler,
+) *MediaValidator {
+	return &MediaValidator{
+		mediaType:   mediaType,
+		contentType: contentType,
+		head:        head,
+		vHandler:    handler,
+	}
+}
+
+func mediaFamily(contentType string) string {
+	base, _, _ := strings.Cut(contentType, ";")
+	family, _, _ := strings.Cut(strings.ToLower(strings.TrimSpace(base)), "/")
+
+	switch family {
+	case "image", "video", "audio", "text":
+		return family
+	}
+	return ""
+}
+
+func sniffContentType(head []byte) string {
+	switch {
+	case bytes.HasPrefix(head, []byte("\x89PNG\r\n\x1a\n")):
+		return "image/png"
+	case bytes.HasPrefix(head, []byte("\xff\xd8\xff")):
+		return "image/jpeg"
+	case bytes.HasPrefix(head, []byte("GIF87a")), bytes.HasPrefix(head, []byte("GIF89a")):
+		return "image/gif"
+	case isRiff(head, "WEBP"):
+		return "image/webp"
+	case isRiff(head, "WAVE"):
+		return "audio/wav"
+	case bytes.HasPrefix(head, []byte("ID3")), len(head) >= 2 && head[0] == 0xff && head[1]&0xe0 == 0xe0:
+		return "audio/mpeg"
+	case bytes.HasPrefix(head, []byte("OggS")):
+		return "audio/ogg"
+	case bytes.HasPrefix(head, []byte("fLaC")):
+		return "audio/flac"
+	case isRiff(head, "AVI "):
+		return "video/x-msvideo"
+	case len(head) >= 12 && string(head[4:8]) == "ftyp":
+		switch string(head[8:12]) {
+		case "qt  ":
+			return "video/quicktime"
+		case "M4A ", "M4B ":
+			return "audio/mp4"
+		}
+		return "video/mp4"
+	case len(head) >= 8 && slices.Contains(legacyQuickTimeBoxes, string(head[4:8])):
+		return "video/quicktime"
+	case bytes.HasPrefix(head, []byte("\x1a\x45\xdf\xa3")):
+		return "video/webm"
+	case len(head) > 376 && head[0] == 0x47 && head[188] == 0x47 && head[376] == 0x47:
+		return "video/mp2t"
+	}
+	return ""
+}
+
+func isRiff(head []byte, format string) bool {
+	return len(head) >= 12 && string(head[0:4]) == "RIFF" && string(head[8:12]) == format
+}
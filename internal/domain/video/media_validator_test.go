@@ -0,0 +1,123 @@
+package video
+
+import (
+	"bytes"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"testing"
+
+	"github.com.br/gibranct/admin_do_catalogo/pkg/notification"
+	"github.com/stretchr/testify/assert"
+)
+
+var mp4Head = []byte("\x00\x00\x00\x18ftypmp42\x00\x00\x00\x00mp42isom")
+
+func encodedPng(width, height int) []byte {
+	var buf bytes.Buffer
+	png.Encode(&buf, image.NewGray(image.Rect(0, 0, width, height)))
+	return buf.Bytes()
+}
+
+func encodedJpeg(width, height int) []byte {
+	var buf bytes.Buffer
+	jpeg.Encode(&buf, image.NewGray(image.Rect(0, 0, width, height)), nil)
+	return buf.Bytes()
+}
+
+func validateMedia(mediaType VideoMediaType, contentType string, head []byte) []string {
+	n := notification.CreateNotification()
+	NewMediaValidator(mediaType, contentType, head, n).Validate()
+
+	messages := []string{}
+	for _, err := range n.GetErrors() {
+		messages = append(messages, err.Error())
+	}
+	return messages
+}
+
+func TestValidMedia(t *testing.T) {
+	tests := []struct {
+		mediaType   VideoMediaType
+		contentType string
+		head        []byte
+	}{
+		{VIDEO, "video/mp4", mp4Head},
+		{TRAILER, "", []byte("\x1a\x45\xdf\xa3webm")},
+		{VIDEO, "video/quicktime", []byte("\x00\x00\x00\x08wide\x00\x00\x02\x08mdat")},
+		{BANNER, "image/png", encodedPng(1920, 1080)},
+		{THUMBNAIL, "application/octet-stream", encodedJpeg(640, 360)},
+		{THUMBNAIL_HALF, "image/png; charset=binary", encodedPng(320, 180)},
+		{AUDIO, "audio/mpeg", []byte("ID3\x04\x00\x00\x00\x00\x00\x00")},
+		{AUDIO, "video/mp4", mp4Head},
+		{AUDIO, "", []byte("\x00\x00\x00\x18ftypM4A \x00\x00\x00\x00")},
+		{AUDIO, "audio/wav", []byte("RIFF\x24\x00\x00\x00WAVEfmt ")},
+	}
+
+	for _, test := range tests {
+		assert.Empty(t, validateMedia(test.mediaType, test.contentType, test.head), test.mediaType.String())
+	}
+}
+
+func TestMediaContentMismatch(t *testing.T) {
+	assert.Equal(t,
+		[]string{"'Video' must be video content but got image/png"},
+		validateMedia(VIDEO, "", encodedPng(1920, 1080)),
+	)
+	assert.Equal(t,
+		[]string{"'Banner' must be image content but got video/mp4"},
+		validateMedia(BANNER, "", mp4Head),
+	)
+	assert.Equal(t,
+		[]string{"'Audio' must be audio content but got image/png"},
+		validateMedia(AUDIO, "", encodedPng(1920, 1080)),
+	)
+	assert.Equal(t,
+		[]string{"'Trailer' must be video content but got audio/mp4"},
+		validateMedia(TRAILER, "", []byte("\x00\x00\x00\x18ftypM4A \x00\x00\x00\x00")),
+	)
+}
+
+func TestMediaDeclaredContentTypeMismatch(t *testing.T) {
+	assert.Equal(t,
+		[]string{"'Video' content type image/png is not allowed"},
+		validateMedia(VIDEO, "image/png", mp4Head),
+	)
+}
+
+func TestUnrecognizedAndEmptyMedia(t *testing.T) {
+	assert.Equal(t,
+		[]string{"'Video' content is not a recognized video format"},
+		validateMedia(VIDEO, "", []byte("plain text")),
+	)
+	assert.Equal(t,
+		[]string{"'Thumbnail' should not be empty"},
+		validateMedia(THUMBNAIL, "", nil),
+	)
+}
+
+func TestImageMediaRules(t *testing.T) {
+	assert.Equal(t,
+		[]string{"'Banner' must be at least 1280x720 but got 640x360"},
+		validateMedia(BANNER, "", encodedPng(640, 360)),
+	)
+	assert.Equal(t,
+		[]string{"'Thumbnail' aspect ratio must be 16:9 but got 800x600"},
+		validateMedia(THUMBNAIL, "", encodedPng(800, 600)),
+	)
+	assert.Equal(t,
+		[]string{
+			"'Thumbnail_half' must be at least 320x180 but got 100x100",
+			"'Thumbnail_half' aspect ratio must be 16:9 but got 100x100",
+		},
+		validateMedia(THUMBNAIL_HALF, "", encodedPng(100, 100)),
+	)
+	assert.Equal(t,
+		[]string{"'Banner' image format image/webp is not supported"},
+		validateMedia(BANNER, "", []byte("RIFF\x00\x00\x00\x00WEBPVP8 ")),
+	)
+	assert.Equal(t,
+		[]string{"'Banner' image dimensions could not be read"},
+		validateMedia(BANNER, "", []byte("\x89PNG\r\n\x1a\n")),
+	)
+}
@@ -0,0 +1,74 @@
+package video
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+)
+
+type PublicationStatus uint8
+
+const (
+	DRAFT PublicationStatus = iota
+	IN_REVIEW
+	SCHEDULED
+	PUBLISHED
+	UNPUBLISHED
+)
+
+var allowedPublicationTransitions = map[PublicationStatus][]PublicationStatus{
+	DRAFT:       {IN_REVIEW},
+	IN_REVIEW:   {DRAFT, SCHEDULED},
+	SCHEDULED:   {IN_REVIEW, PUBLISHED, UNPUBLISHED},
+	PUBLISHED:   {UNPUBLISHED},
+	UNPUBLISHED: {IN_REVIEW},
+}
+
+var ErrPublicationNotDue = errors.New("video publication is not due yet")
+var ErrPublicationConflict = errors.New("video publication status was changed by another request")
+
+type InvalidPublicationTransitionError struct {
+	From PublicationStatus
+	To   PublicationStatus
+}
+
+func (e InvalidPublicationTransitionError) Error() string {
+	return fmt.Sprintf("publication status cannot change from %s to %s", e.From, e.To)
+}
+
+func (ps PublicationStatus) String() string {
+	switch ps {
+	case DRAFT:
+		return "DRAFT"
+	case IN_REVIEW:
+		return "IN_REVIEW"
+	case SCHEDULED:
+		return "SCHEDULED"
+	case PUBLISHED:
+		return "PUBLISHED"
+	case UNPUBLISHED:
+		return "UNPUBLISHED"
+	}
+	return "unknown"
+}
+
+func (ps PublicationStatus) CanTransitionTo(next PublicationStatus) bool {
+	return slices.Contains(allowedPublicationTransitions[ps], next)
+}
+
+func StringToPublicationStatus(statusStr string) (PublicationStatus, error) {
+	switch statusStr {
+	case "DRAFT":
+		return DRAFT, nil
+	case "IN_REVIEW":
+		return IN_REVIEW, nil
+	case "SCHEDULED":
+		return SCHEDULED, nil
+	case "PUBLISHED":
+		return PUBLISHED, nil
+	case "UNPUBLISHED":
+		return UNPUBLISHED, nil
+	default:
+		return DRAFT, errors.New("unknown publication status")
+	}
+}
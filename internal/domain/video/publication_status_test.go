@@ -0,0 +1,123 @@
+package video
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStringToPublicationStatus(t *testing.T) {
+	for _, status := range []PublicationStatus{DRAFT, IN_REVIEW, SCHEDULED, PUBLISHED, UNPUBLISHED} {
+		result, err := StringToPublicationStatus(status.String())
+		assert.Nil(t, err)
+		assert.Equal(t, status, result)
+	}
+
+	_, err := StringToPublicationStatus("ARCHIVED")
+	assert.EqualError(t, err, "unknown publication status")
+	assert.Equal(t, "unknown", PublicationStatus(99).String())
+}
+
+func TestPublicationStatusTransitions(t *testing.T) {
+	tests := []struct {
+		from    PublicationStatus
+		to      PublicationStatus
+		allowed bool
+	}{
+		{DRAFT, IN_REVIEW, true},
+		{IN_REVIEW, DRAFT, true},
+		{IN_REVIEW, SCHEDULED, true},
+		{SCHEDULED, IN_REVIEW, true},
+		{SCHEDULED, PUBLISHED, true},
+		{SCHEDULED, UNPUBLISHED, true},
+		{PUBLISHED, UNPUBLISHED, true},
+		{UNPUBLISHED, IN_REVIEW, true},
+		{DRAFT, PUBLISHED, false},
+		{DRAFT, SCHEDULED, false},
+		{IN_REVIEW, PUBLISHED, false},
+		{PUBLISHED, DRAFT, false},
+		{UNPUBLISHED, PUBLISHED, false},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.allowed, test.from.CanTransitionTo(test.to), "%s -> %s", test.from, test.to)
+	}
+}
+
+func TestNewVideoStartsAsDraft(t *testing.T) {
+	aVideo := NewVideo("title", "desc", 2025, 54.4, true, L, nil, nil, nil)
+
+	assert.Equal(t, DRAFT, aVideo.PublicationStatus)
+	assert.False(t, aVideo.Published)
+	assert.Nil(t, aVideo.PublishAt)
+}
+
+func TestScheduledPublication(t *testing.T) {
+	now := time.Now().UTC()
+	publishAt := now.Add(time.Hour)
+	aVideo := NewVideo("title", "desc", 2025, 54.4, true, L, nil, nil, nil)
+
+	assert.Nil(t, aVideo.Submit())
+	assert.Equal(t, IN_REVIEW, aVideo.PublicationStatus)
+
+	assert.Nil(t, aVideo.Approve(publishAt, now))
+	assert.Equal(t, SCHEDULED, aVideo.PublicationStatus)
+	assert.Equal(t, publishAt, *aVideo.PublishAt)
+	assert.False(t, aVideo.Published)
+	assert.False(t, aVideo.IsDueForPublication(now))
+
+	assert.ErrorIs(t, aVideo.Publish(now), ErrPublicationNotDue)
+	assert.Equal(t, SCHEDULED, aVideo.PublicationStatus)
+
+	assert.Nil(t, aVideo.Publish(publishAt))
+	assert.Equal(t, PUBLISHED, aVideo.PublicationStatus)
+	assert.True(t, aVideo.Published)
+}
+
+func TestScheduledVideoSentBackToReview(t *testing.T) {
+	now := time.Now().UTC()
+	aVideo := NewVideo("title", "desc", 2025, 54.4, true, L, nil, nil, nil)
+	aVideo.Submit()
+	aVideo.Approve(now.Add(time.Hour), now)
+
+	assert.Nil(t, aVideo.Submit())
+	assert.Equal(t, IN_REVIEW, aVideo.PublicationStatus)
+	assert.Nil(t, aVideo.PublishAt)
+	assert.False(t, aVideo.IsDueForPublication(now.Add(2*time.Hour)))
+}
+
+func TestImmediatePublication(t *testing.T) {
+	now := time.Now().UTC()
+	aVideo := NewVideo("title", "desc", 2025, 54.4, true, L, nil, nil, nil)
+
+	assert.Nil(t, aVideo.Submit())
+	assert.Nil(t, aVideo.Approve(now, now))
+
+	assert.Equal(t, PUBLISHED, aVideo.PublicationStatus)
+	assert.True(t, aVideo.Published)
+
+	assert.Nil(t, aVideo.Unpublish())
+	assert.Equal(t, UNPUBLISHED, aVideo.PublicationStatus)
+	assert.False(t, aVideo.Published)
+	assert.Nil(t, aVideo.PublishAt)
+
+	assert.Nil(t, aVideo.Submit())
+	assert.Equal(t, IN_REVIEW, aVideo.PublicationStatus)
+}
+
+func TestRejectAndInvalidPublicationTransition(t *testing.T) {
+	aVideo := NewVideo("title", "desc", 2025, 54.4, true, L, nil, nil, nil)
+
+	err := aVideo.Approve(time.Now(), time.Now())
+
+	var transitionErr InvalidPublicationTransitionError
+	assert.ErrorAs(t, err, &transitionErr)
+	assert.Equal(t, "publication status cannot change from DRAFT to SCHEDULED", err.Error())
+	assert.Nil(t, aVideo.PublishAt)
+
+	assert.Nil(t, aVideo.Submit())
+	assert.Nil(t, aVideo.Reject())
+	assert.Equal(t, DRAFT, aVideo.PublicationStatus)
+	assert.ErrorAs(t, aVideo.Unpublish(), &transitionErr)
+}
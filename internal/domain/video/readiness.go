@@ -0,0 +1,104 @@
+package video
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com.br/gibranct/admin_do_catalogo/pkg/validator"
+)
+
+var ErrUnknownReadinessRule = errors.New("unknown readiness rule")
+
+type ReadinessRule struct {
+	Name        string
+	Description string
+	IsSatisfied func(v *Video) bool
+}
+
+var readinessRules = []ReadinessRule{
+	{
+		Name:        "video_completed",
+		Description: "'Video' media must be COMPLETED",
+		IsSatisfied: func(v *Video) bool {
+			return v.Video != nil && v.Video.Status != nil && *v.Video.Status == COMPLETED
+		},
+	},
+	{
+		Name:        "banner",
+		Description: "'Banner' media must be present",
+		IsSatisfied: func(v *Video) bool { return v.Banner != nil },
+	},
+	{
+		Name:        "thumbnail",
+		Description: "'Thumbnail' media must be present",
+		IsSatisfied: func(v *Video) bool { return v.ThumbNail != nil },
+	},
+	{
+		Name:        "categories",
+		Description: "at least one category must be attached",
+		IsSatisfied: func(v *Video) bool { return len(v.CategoryIds) > 0 },
+	},
+	{
+		Name:        "genres",
+		Description: "at least one genre must be attached",
+		IsSatisfied: func(v *Video) bool { return len(v.GenreIds) > 0 },
+	},
+	{
+		Name:        "rating",
+		Description: "'rating' must be known",
+		IsSatisfied: func(v *Video) bool { return v.Rating != UNKNOWN },
+	},
+}
+
+// opt-in since the catalog default language is deployment configuration
+func DefaultAudioTrackRule(language string) ReadinessRule {
+	return ReadinessRule{
+		Name:        "default_audio_track",
+		Description: fmt.Sprintf("a COMPLETED '%s' audio track must be attached", language),
+		IsSatisfied: func(v *Video) bool {
+			track, found := v.FindAudioTrack(language)
+			return found && track.IsCompleted()
+		},
+	}
+}
+
+func DefaultReadinessRules() []ReadinessRule {
+	return append([]ReadinessRule{}, readinessRules...)
+}
+
+// an empty list of names enables every rule
+func ReadinessRulesByName(names []string) ([]ReadinessRule, error) {
+	if len(names) == 0 {
+		return DefaultReadinessRules(), nil
+	}
+
+	rules := []ReadinessRule{}
+
+	for _, name := range names {
+		name = strings.TrimSpace(name)
+		found := false
+
+		for _, rule := range readinessRules {
+			if rule.Name == name {
+				rules = append(rules, rule)
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownReadinessRule, name)
+		}
+	}
+
+	return rules, nil
+}
+
+func (v *Video) ValidateReadiness(rules []ReadinessRule, handler validator.ValidationHandler) {
+	for _, rule := range rules {
+		if !rule.IsSatisfied(v) {
+			handler.Add(errors.New(rule.Description))
+		}
+	}
+}
@@ -0,0 +1,54 @@
+package video
+
+import (
+	"testing"
+
+	"github.com.br/gibranct/admin_do_catalogo/pkg/notification"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateReadiness(t *testing.T) {
+	aVideo := NewVideo("title", "desc", 2025, 120.0, true, UNKNOWN, nil, nil, nil)
+	status := PROCESSING
+	aVideo.UpdateVideoMedia(NewAudioVideoMediaWith(1, &status, "checksum", "movie.mp4", "/movie.mp4", ""))
+
+	n := notification.CreateNotification()
+	aVideo.ValidateReadiness(DefaultReadinessRules(), n)
+
+	messages := []string{}
+	for _, err := range n.GetErrors() {
+		messages = append(messages, err.Error())
+	}
+	assert.Equal(t, []string{
+		"'Video' media must be COMPLETED",
+		"'Banner' media must be present",
+		"'Thumbnail' media must be present",
+		"at least one category must be attached",
+		"at least one genre must be attached",
+		"'rating' must be known",
+	}, messages)
+
+	aVideo.Completed(VIDEO, "/encoded")
+	aVideo.UpdateBannerMedia(NewImageMediaWithId(2, "banner", "banner.png", "/banner.png"))
+	aVideo.UpdateThumbnailMedia(NewImageMediaWithId(3, "thumb", "thumb.png", "/thumb.png"))
+	aVideo.Update("title", "desc", 2025, 120.0, true, L, []int64{1}, []int64{2}, nil)
+
+	n = notification.CreateNotification()
+	aVideo.ValidateReadiness(DefaultReadinessRules(), n)
+	assert.False(t, n.HasErrors())
+}
+
+func TestReadinessRulesByName(t *testing.T) {
+	rules, err := ReadinessRulesByName(nil)
+	assert.Nil(t, err)
+	assert.Len(t, rules, 6)
+
+	rules, err = ReadinessRulesByName([]string{"banner", " rating"})
+	assert.Nil(t, err)
+	assert.Equal(t, "banner", rules[0].Name)
+	assert.Equal(t, "rating", rules[1].Name)
+
+	_, err = ReadinessRulesByName([]string{"trailer"})
+	assert.ErrorIs(t, err, ErrUnknownReadinessRule)
+	assert.EqualError(t, err, "unknown readiness rule: trailer")
+}
@@ -0,0 +1,85 @@
+package video
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com.br/gibranct/admin_do_catalogo/pkg/validator"
+)
+
+type ManifestFormat uint8
+
+const (
+	HLS ManifestFormat = iota
+	DASH
+)
+
+// one step of the adaptive bitrate ladder produced by the encoder
+type Rendition struct {
+	Bandwidth       int64
+	Width           int
+	Height          int
+	Codecs          []string
+	Location        string
+	SegmentDuration float64
+}
+
+func NewRendition(bandwidth int64, width, height int, codecs []string, location string, segmentDuration float64) *Rendition {
+	return &Rendition{
+		Bandwidth:       bandwidth,
+		Width:           width,
+		Height:          height,
+		Codecs:          codecs,
+		Location:        location,
+		SegmentDuration: segmentDuration,
+	}
+}
+
+func (r Rendition) Resolution() string {
+	return fmt.Sprintf("%dx%d", r.Width, r.Height)
+}
+
+func ValidateRenditions(renditions []Rendition, handler validator.ValidationHandler) {
+	for i, r := range renditions {
+		if r.Bandwidth <= 0 {
+			handler.Add(fmt.Errorf("rendition %d: 'bandwidth' must be greater than 0", i))
+		}
+
+		if r.Width <= 0 || r.Height <= 0 {
+			handler.Add(fmt.Errorf("rendition %d: resolution must be greater than 0 but got %s", i, r.Resolution()))
+		}
+
+		if len(r.Codecs) == 0 || slices.Contains(r.Codecs, "") {
+			handler.Add(fmt.Errorf("rendition %d: 'codecs' should not be empty", i))
+		}
+
+		if strings.TrimSpace(r.Location) == "" {
+			handler.Add(fmt.Errorf("rendition %d: 'location' should not be null or empty", i))
+		}
+
+		if r.SegmentDuration <= 0 {
+			handler.Add(fmt.Errorf("rendition %d: 'segmentDuration' must be greater than 0", i))
+		}
+	}
+}
+
+// lowest bandwidth first, as players expect the ladder in manifests
+func (avm *AudioVideoMedia) Ladder() []Rendition {
+	ladder := slices.Clone(avm.Renditions)
+	slices.SortStableFunc(ladder, func(a, b Rendition) int {
+		return cmp.Compare(a.Bandwidth, b.Bandwidth)
+	})
+	return ladder
+}
+
+func (f ManifestFormat) String() string {
+	switch f {
+	case HLS:
+		return "HLS"
+	case DASH:
+		return "DASH"
+	}
+	return "unknown"
+}
@@ -0,0 +1,40 @@
+package video
+
+import (
+	"testing"
+
+	"github.com.br/gibranct/admin_do_catalogo/pkg/notification"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateRenditions(t *testing.T) {
+	n := notification.CreateNotification()
+	ValidateRenditions([]Rendition{
+		*NewRendition(5_000_000, 1920, 1080, []string{"avc1.640028", "mp4a.40.2"}, "https://cdn/1080p", 6),
+	}, n)
+	assert.False(t, n.HasErrors())
+
+	ValidateRenditions([]Rendition{
+		*NewRendition(5_000_000, 1920, 1080, []string{"avc1.640028"}, "https://cdn/1080p", 6),
+		*NewRendition(0, 0, 720, []string{}, " ", 0),
+	}, n)
+	assert.Equal(t, []string{
+		"rendition 1: 'bandwidth' must be greater than 0",
+		"rendition 1: resolution must be greater than 0 but got 0x720",
+		"rendition 1: 'codecs' should not be empty",
+		"rendition 1: 'location' should not be null or empty",
+		"rendition 1: 'segmentDuration' must be greater than 0",
+	}, errorMessages(n))
+}
+
+func TestCompletedRecordsTheLadder(t *testing.T) {
+	aVideo := NewVideo("title", "desc", 2024, 120.0, true, L, nil, nil, nil)
+	aVideo.UpdateVideoMedia(NewAudioVideoMediaWith(10, nil, "sum", "video.mp4", "/video.mp4", ""))
+	high := *NewRendition(5_000_000, 1920, 1080, []string{"avc1.640028"}, "/encoded/1080p", 6)
+	low := *NewRendition(800_000, 640, 360, []string{"avc1.42c01e"}, "/encoded/360p", 6)
+
+	assert.Nil(t, aVideo.Processing(VIDEO))
+	assert.Nil(t, aVideo.Completed(VIDEO, "/encoded", high, low))
+	assert.Equal(t, []Rendition{low, high}, aVideo.Video.Ladder())
+	assert.Equal(t, []Rendition{high, low}, aVideo.Video.Renditions)
+}
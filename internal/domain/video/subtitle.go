@@ -0,0 +1,201 @@
+package video
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com.br/gibranct/admin_do_catalogo/pkg/validator"
+)
+
+// broken files tend to fail on every cue, the first ones are enough to fix them
+const maxCueErrors = 10
+
+var timestampRegex = regexp.MustCompile(`^(?:(\d{1,}):)?([0-5]\d):([0-5]\d)[.,](\d{3})$`)
+
+// SRT formatting WebVTT does not understand: font tags and SSA override blocks
+var unsupportedMarkupRegex = regexp.MustCompile(`(?i)</?font[^>]*>|\{\\[^}]*\}`)
+
+var blankLineRegex = regexp.MustCompile(`\n[ \t]*\n`)
+
+type Cue struct {
+	Start    time.Duration
+	End      time.Duration
+	Settings string
+	Text     string
+}
+
+type subtitleParser struct {
+	vHandler validator.ValidationHandler
+	errors   int
+}
+
+// reads SRT or WebVTT content, reporting malformed or inconsistent cue timings to the handler
+func ParseSubtitle(content []byte, handler validator.ValidationHandler) []Cue {
+	content = bytes.TrimPrefix(content, []byte("\xef\xbb\xbf"))
+	text := strings.ReplaceAll(strings.ReplaceAll(string(content), "\r\n", "\n"), "\r", "\n")
+
+	blocks := splitBlocks(text)
+	webVTT := len(blocks) > 0 && isWebVTTHeader(blocks[0])
+	if webVTT {
+		blocks = blocks[1:]
+	}
+
+	parser := &subtitleParser{vHandler: handler}
+	cues := []Cue{}
+	number := 0
+
+	for _, block := range blocks {
+		lines := strings.Split(block, "\n")
+
+		if webVTT && isWebVTTMetadata(lines[0]) {
+			continue
+		}
+
+		// both formats allow an identifier line before the timings, SRT numbers every cue
+		if !strings.Contains(lines[0], "-->") && len(lines) > 1 {
+			lines = lines[1:]
+		}
+
+		number++
+		cue, ok := parser.parseCue(number, lines)
+		if !ok {
+			continue
+		}
+
+		if len(cues) > 0 && cue.Start < cues[len(cues)-1].Start {
+			parser.add(fmt.Errorf(
+				"cue %d starts at %s before the previous cue at %s",
+				number, formatTimestamp(cue.Start), formatTimestamp(cues[len(cues)-1].Start),
+			))
+		}
+
+		cues = append(cues, cue)
+	}
+
+	if len(cues) == 0 && parser.errors == 0 {
+		handler.Add(errors.New("'Subtitle' should contain at least one cue"))
+	}
+
+	return cues
+}
+
+func (p *subtitleParser) parseCue(number int, lines []string) (Cue, bool) {
+	startStr, rest, found := strings.Cut(lines[0], "-->")
+	if !found {
+		p.add(fmt.Errorf("cue %d has no timing line", number))
+		return Cue{}, false
+	}
+
+	fields := strings.Fields(rest)
+	if len(fields) == 0 {
+		p.add(fmt.Errorf("cue %d has an invalid timing line '%s'", number, lines[0]))
+		return Cue{}, false
+	}
+
+	start, startErr := parseTimestamp(strings.TrimSpace(startStr))
+	end, endErr := parseTimestamp(fields[0])
+	if startErr != nil || endErr != nil {
+		p.add(fmt.Errorf("cue %d has an invalid timing line '%s'", number, lines[0]))
+		return Cue{}, false
+	}
+
+	if end <= start {
+		p.add(fmt.Errorf("cue %d ends at %s before it starts at %s", number, formatTimestamp(end), formatTimestamp(start)))
+		return Cue{}, false
+	}
+
+	return Cue{
+		Start:    start,
+		End:      end,
+		Settings: strings.Join(fields[1:], " "),
+		Text:     strings.Join(lines[1:], "\n"),
+	}, true
+}
+
+func (p *subtitleParser) add(err error) {
+	if p.errors < maxCueErrors {
+		p.vHandler.Add(err)
+	}
+	p.errors++
+}
+
+// writes the cues back as a WebVTT document, dropping markup only SRT players understand
+func RenderWebVTT(cues []Cue) []byte {
+	var b bytes.Buffer
+	b.WriteString("WEBVTT\n")
+
+	for _, cue := range cues {
+		b.WriteString("\n")
+		b.WriteString(formatTimestamp(cue.Start) + " --> " + formatTimestamp(cue.End))
+		if cue.Settings != "" {
+			b.WriteString(" " + cue.Settings)
+		}
+		b.WriteString("\n")
+
+		text := unsupportedMarkupRegex.ReplaceAllString(cue.Text, "")
+		text = strings.ReplaceAll(text, "-->", "--&gt;")
+		if text != "" {
+			b.WriteString(text + "\n")
+		}
+	}
+
+	return b.Bytes()
+}
+
+func splitBlocks(text string) []string {
+	blocks := []string{}
+
+	for _, block := range blankLineRegex.Split(text, -1) {
+		if block = strings.Trim(block, "\n"); strings.TrimSpace(block) != "" {
+			blocks = append(blocks, block)
+		}
+	}
+
+	return blocks
+}
+
+func isWebVTTHeader(block string) bool {
+	return block == "WEBVTT" || strings.HasPrefix(block, "WEBVTT ") ||
+		strings.HasPrefix(block, "WEBVTT\t") || strings.HasPrefix(block, "WEBVTT\n")
+}
+
+func isWebVTTMetadata(line string) bool {
+	for _, keyword := range []string{"NOTE", "STYLE", "REGION"} {
+		if line == keyword || strings.HasPrefix(line, keyword+" ") || strings.HasPrefix(line, keyword+"\t") {
+			return true
+		}
+	}
+	return false
+}
+
+func parseTimestamp(value string) (time.Duration, error) {
+	matches := timestampRegex.FindStringSubmatch(value)
+	if matches == nil {
+		return 0, fmt.Errorf("invalid timestamp '%s'", value)
+	}
+
+	hours := 0
+	if matches[1] != "" {
+		hours, _ = strconv.Atoi(matches[1])
+	}
+	minutes, _ := strconv.Atoi(matches[2])
+	seconds, _ := strconv.Atoi(matches[3])
+	millis, _ := strconv.Atoi(matches[4])
+
+	return time.Duration(hours)*time.Hour +
+		time.Duration(minutes)*time.Minute +
+		time.Duration(seconds)*time.Second +
+		time.Duration(millis)*time.Millisecond, nil
+}
+
+func formatTimestamp(d time.Duration) string {
+	return fmt.Sprintf(
+		"%02d:%02d:%02d.%03d",
+		int(d/time.Hour), int(d/time.Minute)%60, int(d/time.Second)%60, int(d/time.Millisecond)%1000,
+	)
+}
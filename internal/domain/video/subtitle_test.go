@@ -0,0 +1,95 @@
+package video
+
+import (
+	"testing"
+	"time"
+
+	"github.com.br/gibranct/admin_do_catalogo/pkg/notification"
+	"github.com/stretchr/testify/assert"
+)
+
+func errorMessages(n *notification.Notification) []string {
+	messages := []string{}
+	for _, err := range n.GetErrors() {
+		messages = append(messages, err.Error())
+	}
+	return messages
+}
+
+func TestParseSrtSubtitle(t *testing.T) {
+	content := "\xef\xbb\xbf1\r\n00:00:01,000 --> 00:00:04,500\r\n<font color=\"red\">Hello</font>\r\nworld\r\n\r\n" +
+		"2\r\n00:00:05,250 --> 00:00:07,000\r\n{\\an8}<i>Top</i>\r\n"
+	n := notification.CreateNotification()
+
+	cues := ParseSubtitle([]byte(content), n)
+
+	assert.False(t, n.HasErrors())
+	assert.Len(t, cues, 2)
+	assert.Equal(t, time.Second, cues[0].Start)
+	assert.Equal(t, 4500*time.Millisecond, cues[0].End)
+	assert.Equal(t, "<font color=\"red\">Hello</font>\nworld", cues[0].Text)
+
+	expected := "WEBVTT\n\n" +
+		"00:00:01.000 --> 00:00:04.500\nHello\nworld\n\n" +
+		"00:00:05.250 --> 00:00:07.000\n<i>Top</i>\n"
+	assert.Equal(t, expected, string(RenderWebVTT(cues)))
+}
+
+func TestParseWebVTTSubtitle(t *testing.T) {
+	content := "WEBVTT - sample\n\nNOTE translated by hand\n\nSTYLE\n::cue { color: yellow }\n\n" +
+		"intro\n00:01.000 --> 00:02.000 align:start line:10%\nHi\n\n" +
+		"01:00:00.000 --> 01:00:03.123\nBye\n"
+	n := notification.CreateNotification()
+
+	cues := ParseSubtitle([]byte(content), n)
+
+	assert.False(t, n.HasErrors())
+	assert.Len(t, cues, 2)
+	assert.Equal(t, "align:start line:10%", cues[0].Settings)
+	assert.Equal(t, time.Hour+3123*time.Millisecond, cues[1].End)
+
+	expected := "WEBVTT\n\n" +
+		"00:00:01.000 --> 00:00:02.000 align:start line:10%\nHi\n\n" +
+		"01:00:00.000 --> 01:00:03.123\nBye\n"
+	assert.Equal(t, expected, string(RenderWebVTT(cues)))
+}
+
+func TestParseSubtitleWithInvalidTimings(t *testing.T) {
+	content := "1\n00:00:05,000 --> 00:00:02,000\nbackwards\n\n" +
+		"2\n00:00:0x,000 --> 00:00:03,000\nmalformed\n\n" +
+		"3\nno timings here\n\n" +
+		"4\n00:00:10,000 --> 00:00:12,000\nfine\n\n" +
+		"5\n00:00:08,000 --> 00:00:09,000\nout of order\n"
+	n := notification.CreateNotification()
+
+	cues := ParseSubtitle([]byte(content), n)
+
+	assert.Len(t, cues, 2)
+	assert.Equal(t, []string{
+		"cue 1 ends at 00:00:02.000 before it starts at 00:00:05.000",
+		"cue 2 has an invalid timing line '00:00:0x,000 --> 00:00:03,000'",
+		"cue 3 has no timing line",
+		"cue 5 starts at 00:00:08.000 before the previous cue at 00:00:10.000",
+	}, errorMessages(n))
+}
+
+func TestParseSubtitleWithoutCues(t *testing.T) {
+	n := notification.CreateNotification()
+
+	cues := ParseSubtitle([]byte("WEBVTT\n\nNOTE nothing to see\n"), n)
+
+	assert.Empty(t, cues)
+	assert.Equal(t, []string{"'Subtitle' should contain at least one cue"}, errorMessages(n))
+}
+
+func TestParseSubtitleLimitsReportedErrors(t *testing.T) {
+	content := ""
+	for i := 0; i < 20; i++ {
+		content += "00:00:05.000 --> 00:00:01.000\nbroken\n\n"
+	}
+	n := notification.CreateNotification()
+
+	ParseSubtitle([]byte(content), n)
+
+	assert.Len(t, n.GetErrors(), maxCueErrors)
+}
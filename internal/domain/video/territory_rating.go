@@ -0,0 +1,184 @@
+package video
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+
+	"github.com.br/gibranct/admin_do_catalogo/pkg/validator"
+)
+
+type RatingSystem uint8
+
+const (
+	CLASSIND RatingSystem = iota
+	MPAA
+	PEGI
+	BBFC
+)
+
+var territoryRegex = regexp.MustCompile(`^[A-Z]{2}$`)
+
+type ratingValue struct {
+	value      string
+	minimumAge int
+}
+
+type ratingSystemSpec struct {
+	territories []string
+	values      []ratingValue
+}
+
+// minimum ages are the mapping table used to approximate equivalents across systems
+var ratingSystems = map[RatingSystem]ratingSystemSpec{
+	CLASSIND: {
+		territories: []string{"BR"},
+		values: []ratingValue{
+			{"Livre", 0}, {"ER", 0}, {"10", 10}, {"12", 12}, {"14", 14}, {"16", 16}, {"18", 18},
+		},
+	},
+	MPAA: {
+		territories: []string{"US"},
+		values: []ratingValue{
+			{"G", 0}, {"PG", 10}, {"PG-13", 13}, {"R", 17}, {"NC-17", 18},
+		},
+	},
+	PEGI: {
+		territories: []string{
+			"AT", "BE", "BG", "CH", "CY", "CZ", "DK", "EE", "ES", "FI", "FR", "GR", "HR", "HU", "IE",
+			"IL", "IS", "IT", "LT", "LU", "LV", "MT", "NL", "NO", "PL", "PT", "RO", "SE", "SI", "SK",
+		},
+		values: []ratingValue{
+			{"3", 3}, {"7", 7}, {"12", 12}, {"16", 16}, {"18", 18},
+		},
+	},
+	BBFC: {
+		territories: []string{"GB"},
+		values: []ratingValue{
+			{"U", 0}, {"PG", 8}, {"12A", 12}, {"12", 12}, {"15", 15}, {"18", 18}, {"R18", 18},
+		},
+	},
+}
+
+type TerritoryRating struct {
+	System    RatingSystem
+	Territory string
+	Value     string
+}
+
+func NewTerritoryRating(system RatingSystem, territory string, value string) TerritoryRating {
+	return TerritoryRating{System: system, Territory: territory, Value: value}
+}
+
+func (rs RatingSystem) String() string {
+	switch rs {
+	case CLASSIND:
+		return "ClassInd"
+	case MPAA:
+		return "MPAA"
+	case PEGI:
+		return "PEGI"
+	case BBFC:
+		return "BBFC"
+	}
+	return "unknown"
+}
+
+func StringToRatingSystem(systemStr string) (RatingSystem, error) {
+	switch systemStr {
+	case "ClassInd":
+		return CLASSIND, nil
+	case "MPAA":
+		return MPAA, nil
+	case "PEGI":
+		return PEGI, nil
+	case "BBFC":
+		return BBFC, nil
+	default:
+		return CLASSIND, fmt.Errorf("unknown rating system '%s'", systemStr)
+	}
+}
+
+func RatingSystems() []RatingSystem {
+	return []RatingSystem{CLASSIND, MPAA, PEGI, BBFC}
+}
+
+func (rs RatingSystem) Values() []string {
+	values := []string{}
+	for _, v := range ratingSystems[rs].values {
+		values = append(values, v.value)
+	}
+	return values
+}
+
+func (rs RatingSystem) Territories() []string {
+	return slices.Clone(ratingSystems[rs].territories)
+}
+
+func (rs RatingSystem) find(value string) (ratingValue, bool) {
+	index := slices.IndexFunc(ratingSystems[rs].values, func(v ratingValue) bool { return v.value == value })
+	if index < 0 {
+		return ratingValue{}, false
+	}
+	return ratingSystems[rs].values[index], true
+}
+
+func (r TerritoryRating) Validate(handler validator.ValidationHandler) {
+	if !territoryRegex.MatchString(r.Territory) {
+		handler.Add(fmt.Errorf("'territory' must be an ISO 3166 alpha-2 code but got '%s'", r.Territory))
+	} else if !slices.Contains(ratingSystems[r.System].territories, r.Territory) {
+		handler.Add(fmt.Errorf("%s ratings are not issued in territory %s", r.System, r.Territory))
+	}
+
+	if _, ok := r.System.find(r.Value); !ok {
+		handler.Add(fmt.Errorf("'%s' is not a valid %s rating", r.Value, r.System))
+	}
+}
+
+func (r TerritoryRating) MinimumAge() int {
+	value, _ := r.System.find(r.Value)
+	return value.minimumAge
+}
+
+// picks the value with the closest minimum age, preferring the stricter one on ties
+func (r TerritoryRating) EquivalentIn(system RatingSystem) string {
+	age := r.MinimumAge()
+	best := ratingSystems[system].values[0]
+
+	for _, candidate := range ratingSystems[system].values[1:] {
+		distance := abs(candidate.minimumAge - age)
+		bestDistance := abs(best.minimumAge - age)
+
+		if distance < bestDistance || (distance == bestDistance && candidate.minimumAge > best.minimumAge) {
+			best = candidate
+		}
+	}
+
+	return best.value
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
+
+func validateRatings(v Video, handler validator.ValidationHandler) {
+	seen := map[string]bool{}
+
+	for _, rating := range v.Ratings {
+		rating.Validate(handler)
+
+		key := rating.System.String() + "/" + rating.Territory
+		if seen[key] {
+			handler.Add(fmt.Errorf("'ratings' must contain a single %s rating for territory %s", rating.System, rating.Territory))
+		}
+		seen[key] = true
+
+		if rating.System == CLASSIND && rating.Value != v.Rating.String() {
+			handler.Add(errors.New("'ratings' ClassInd rating must match 'rating'"))
+		}
+	}
+}
@@ -0,0 +1,88 @@
+package video
+
+import (
+	"testing"
+
+	"github.com.br/gibranct/admin_do_catalogo/pkg/notification"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStringToRatingSystem(t *testing.T) {
+	for _, system := range RatingSystems() {
+		result, err := StringToRatingSystem(system.String())
+		assert.Nil(t, err)
+		assert.Equal(t, system, result)
+	}
+
+	_, err := StringToRatingSystem("ESRB")
+	assert.EqualError(t, err, "unknown rating system 'ESRB'")
+}
+
+func TestTerritoryRatingValidation(t *testing.T) {
+	tests := []struct {
+		rating   TerritoryRating
+		messages []string
+	}{
+		{NewTerritoryRating(MPAA, "US", "PG-13"), nil},
+		{NewTerritoryRating(PEGI, "FR", "16"), nil},
+		{NewTerritoryRating(BBFC, "GB", "12A"), nil},
+		{NewTerritoryRating(MPAA, "US", "15"), []string{"'15' is not a valid MPAA rating"}},
+		{NewTerritoryRating(BBFC, "US", "15"), []string{"BBFC ratings are not issued in territory US"}},
+		{NewTerritoryRating(PEGI, "fra", "3"), []string{"'territory' must be an ISO 3166 alpha-2 code but got 'fra'"}},
+	}
+
+	for _, test := range tests {
+		n := notification.CreateNotification()
+		test.rating.Validate(n)
+
+		messages := []string(nil)
+		for _, err := range n.GetErrors() {
+			messages = append(messages, err.Error())
+		}
+		assert.Equal(t, test.messages, messages, "%+v", test.rating)
+	}
+}
+
+func TestEquivalentRatings(t *testing.T) {
+	tests := []struct {
+		rating   TerritoryRating
+		system   RatingSystem
+		expected string
+	}{
+		{NewTerritoryRating(CLASSIND, "BR", "14"), MPAA, "PG-13"},
+		{NewTerritoryRating(CLASSIND, "BR", "16"), MPAA, "R"},
+		{NewTerritoryRating(CLASSIND, "BR", "16"), BBFC, "15"},
+		{NewTerritoryRating(MPAA, "US", "G"), CLASSIND, "Livre"},
+		{NewTerritoryRating(MPAA, "US", "G"), PEGI, "3"},
+		{NewTerritoryRating(PEGI, "FR", "12"), BBFC, "12A"},
+		{NewTerritoryRating(BBFC, "GB", "R18"), MPAA, "NC-17"},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.expected, test.rating.EquivalentIn(test.system), "%+v -> %s", test.rating, test.system)
+	}
+}
+
+func TestVideoRatingsValidation(t *testing.T) {
+	aVideo := NewVideo("title", "desc", 2025, 120.0, true, AGE_14, nil, nil, nil)
+	aVideo.UpdateRatings([]TerritoryRating{
+		NewTerritoryRating(CLASSIND, "BR", "14"),
+		NewTerritoryRating(MPAA, "US", "PG-13"),
+	})
+
+	n := notification.CreateNotification()
+	aVideo.Validate(n)
+	assert.False(t, n.HasErrors())
+
+	aVideo.UpdateRatings([]TerritoryRating{
+		NewTerritoryRating(CLASSIND, "BR", "16"),
+		NewTerritoryRating(MPAA, "US", "PG-13"),
+		NewTerritoryRating(MPAA, "US", "R"),
+	})
+
+	n = notification.CreateNotification()
+	aVideo.Validate(n)
+	assert.Len(t, n.GetErrors(), 2)
+	assert.EqualError(t, n.GetErrors()[0], "'ratings' ClassInd rating must match 'rating'")
+	assert.EqualError(t, n.GetErrors()[1], "'ratings' must contain a single MPAA rating for territory US")
+}
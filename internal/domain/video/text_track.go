@@ -0,0 +1,81 @@
+package video
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com.br/gibranct/admin_do_catalogo/pkg/validator"
+)
+
+type TextTrackKind uint8
+
+const (
+	SUBTITLES TextTrackKind = iota
+	CAPTIONS
+	FORCED
+)
+
+// language, optional script and optional region subtags, e.g. "en", "pt-BR", "zh-Hant-TW", "es-419"
+var languageTagRegex = regexp.MustCompile(`^[a-z]{2,3}(-[A-Z][a-z]{3})?(-([A-Z]{2}|[0-9]{3}))?$`)
+
+type TextTrack struct {
+	Language string
+	Kind     TextTrackKind
+	Checksum string
+	Name     string
+	Location string
+	Cues     int
+}
+
+func NewTextTrack(language string, kind TextTrackKind) *TextTrack {
+	return &TextTrack{Language: language, Kind: kind}
+}
+
+func TextTrackKinds() []TextTrackKind {
+	return []TextTrackKind{SUBTITLES, CAPTIONS, FORCED}
+}
+
+func (k TextTrackKind) String() string {
+	switch k {
+	case SUBTITLES:
+		return "SUBTITLES"
+	case CAPTIONS:
+		return "CAPTIONS"
+	case FORCED:
+		return "FORCED"
+	}
+	return "unknown"
+}
+
+func StringToTextTrackKind(kindStr string) (TextTrackKind, error) {
+	for _, kind := range TextTrackKinds() {
+		if kind.String() == kindStr {
+			return kind, nil
+		}
+	}
+	return SUBTITLES, fmt.Errorf("unknown text track kind '%s'", kindStr)
+}
+
+func (t TextTrack) Validate(handler validator.ValidationHandler) {
+	if !IsLanguageTag(t.Language) {
+		handler.Add(fmt.Errorf("'language' must be a BCP 47 tag such as 'pt-BR' but got '%s'", t.Language))
+	}
+}
+
+func IsLanguageTag(tag string) bool {
+	return languageTagRegex.MatchString(tag)
+}
+
+func (t TextTrack) Matches(language string, kind TextTrackKind) bool {
+	return t.Language == language && t.Kind == kind
+}
+
+func WebVTTName(name string, language string, kind TextTrackKind) string {
+	base := filepath.Base(name)
+	if base == "." || base == string(filepath.Separator) {
+		return fmt.Sprintf("%s.%s.vtt", language, strings.ToLower(kind.String()))
+	}
+	return strings.TrimSuffix(base, filepath.Ext(base)) + ".vtt"
+}
@@ -0,0 +1,42 @@
+package video
+
+import (
+	"testing"
+
+	"github.com.br/gibranct/admin_do_catalogo/pkg/notification"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStringToTextTrackKind(t *testing.T) {
+	for _, kind := range TextTrackKinds() {
+		result, err := StringToTextTrackKind(kind.String())
+		assert.Nil(t, err)
+		assert.Equal(t, kind, result)
+	}
+
+	_, err := StringToTextTrackKind("descriptions")
+	assert.EqualError(t, err, "unknown text track kind 'descriptions'")
+	assert.Equal(t, "unknown", TextTrackKind(99).String())
+}
+
+func TestTextTrackLanguage(t *testing.T) {
+	for _, language := range []string{"en", "pt-BR", "zh-Hant-TW", "es-419", "yue"} {
+		n := notification.CreateNotification()
+		NewTextTrack(language, SUBTITLES).Validate(n)
+		assert.False(t, n.HasErrors(), language)
+	}
+
+	for _, language := range []string{"", "EN", "pt_BR", "pt-br", "english"} {
+		n := notification.CreateNotification()
+		NewTextTrack(language, CAPTIONS).Validate(n)
+		assert.Equal(t, []string{
+			"'language' must be a BCP 47 tag such as 'pt-BR' but got '" + language + "'",
+		}, errorMessages(n), language)
+	}
+}
+
+func TestWebVTTName(t *testing.T) {
+	assert.Equal(t, "movie.en.vtt", WebVTTName("movie.en.srt", "en", SUBTITLES))
+	assert.Equal(t, "movie.vtt", WebVTTName("/tmp/movie.vtt", "en", SUBTITLES))
+	assert.Equal(t, "pt-BR.forced.vtt", WebVTTName("", "pt-BR", FORCED))
+}
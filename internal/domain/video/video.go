@@ -1,30 +1,41 @@
 package video
 
 import (
+	"errors"
+	"fmt"
 	"time"
 
 	"github.com.br/gibranct/admin_do_catalogo/pkg/validator"
 )
 
+var ErrVideoNotFound = errors.New("video not found")
+
 type Video struct {
-	ID            int64
-	Title         string
-	Description   string
-	LaunchedAt    int
-	Duration      float64
-	Rating        Rating
-	Opened        bool
-	Published     bool
-	CreatedAt     time.Time
-	UpdatedAt     time.Time
-	Banner        *ImageMedia
-	ThumbNail     *ImageMedia
-	ThumbNailHalf *ImageMedia
-	Video         *AudioVideoMedia
-	Trailer       *AudioVideoMedia
-	GenreIds      []int64
-	CategoryIds   []int64
-	CastMemberIds []int64
+	ID                int64
+	Title             string
+	Description       string
+	LaunchedAt        int
+	Duration          float64
+	Rating            Rating
+	Ratings           []TerritoryRating
+	Descriptors       []ContentDescriptor
+	Opened            bool
+	Published         bool
+	PublicationStatus PublicationStatus
+	PublishAt         *time.Time
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+	Banner            *ImageMedia
+	ThumbNail         *ImageMedia
+	ThumbNailHalf     *ImageMedia
+	Artworks          []Artwork
+	Video             *AudioVideoMedia
+	Trailer           *AudioVideoMedia
+	AudioTracks       []AudioTrack
+	Extras            []Extra
+	GenreIds          []int64
+	CategoryIds       []int64
+	CastMemberIds     []int64
 }
 
 func (v *Video) Validate(handler validator.ValidationHandler) {
@@ -37,7 +48,6 @@ func NewVideo(
 	launchedAt int,
 	duration float64,
 	opened bool,
-	published bool,
 	rating Rating,
 	categoryIds []int64,
 	genreIds []int64,
@@ -45,21 +55,45 @@ func NewVideo(
 ) *Video {
 	now := time.Now().UTC()
 	return &Video{
-		Title:         title,
-		Description:   description,
-		LaunchedAt:    launchedAt,
-		Duration:      duration,
-		Opened:        opened,
-		Published:     published,
-		Rating:        rating,
-		CategoryIds:   categoryIds,
-		GenreIds:      genreIds,
-		CastMemberIds: castMemberIds,
-		CreatedAt:     now,
-		UpdatedAt:     now,
+		Title:             title,
+		Description:       description,
+		LaunchedAt:        launchedAt,
+		Duration:          duration,
+		Opened:            opened,
+		PublicationStatus: DRAFT,
+		Rating:            rating,
+		CategoryIds:       categoryIds,
+		GenreIds:          genreIds,
+		CastMemberIds:     castMemberIds,
+		CreatedAt:         now,
+		UpdatedAt:         now,
 	}
 }
 
+func (v *Video) Update(
+	title string,
+	description string,
+	launchedAt int,
+	duration float64,
+	opened bool,
+	rating Rating,
+	categoryIds []int64,
+	genreIds []int64,
+	castMemberIds []int64,
+) *Video {
+	v.Title = title
+	v.Description = description
+	v.LaunchedAt = launchedAt
+	v.Duration = duration
+	v.Opened = opened
+	v.Rating = rating
+	v.CategoryIds = categoryIds
+	v.GenreIds = genreIds
+	v.CastMemberIds = castMemberIds
+	v.UpdatedAt = time.Now().UTC()
+	return v
+}
+
 func (v *Video) UpdateBannerMedia(banner *ImageMedia) *Video {
 	v.Banner = banner
 	v.UpdatedAt = time.Now().UTC()
@@ -78,6 +112,10 @@ func (v *Video) UpdateThumbnailHalfMedia(thumbnailHalf *ImageMedia) *Video {
 	return v
 }
 
+func (v *Video) HasGeneratedThumbnailHalf() bool {
+	return v.ThumbNailHalf == nil || v.ThumbNailHalf.Generated
+}
+
 func (v *Video) UpdateTrailerMedia(trailer *AudioVideoMedia) *Video {
 	v.Trailer = trailer
 	v.UpdatedAt = time.Now().UTC()
@@ -90,20 +128,141 @@ func (v *Video) UpdateVideoMedia(video *AudioVideoMedia) *Video {
 	return v
 }
 
-func (v *Video) Processing(aType VideoMediaType) *Video {
-	if VIDEO == aType && v.Video != nil {
-		v.UpdateVideoMedia(v.Video.processing())
-	} else if TRAILER == aType && v.Trailer != nil {
-		v.UpdateTrailerMedia(v.Trailer.processing())
-	}
+func (v *Video) UpdateRatings(ratings []TerritoryRating) *Video {
+	v.Ratings = ratings
+	v.UpdatedAt = time.Now().UTC()
 	return v
 }
 
-func (v *Video) Completed(aType VideoMediaType, encodedPath string) *Video {
-	if VIDEO == aType {
-		v.UpdateVideoMedia(v.Video.completed(encodedPath))
-	} else if TRAILER == aType {
-		v.UpdateTrailerMedia(v.Trailer.completed(encodedPath))
-	}
+func (v *Video) UpdateDescriptors(descriptors []ContentDescriptor) *Video {
+	v.Descriptors = descriptors
+	v.UpdatedAt = time.Now().UTC()
 	return v
 }
+
+func (v *Video) Submit() error {
+	if err := v.changePublicationStatus(IN_REVIEW); err != nil {
+		return err
+	}
+
+	// a scheduled video sent back to review has to be approved again
+	v.PublishAt = nil
+	return nil
+}
+
+func (v *Video) Reject() error {
+	return v.changePublicationStatus(DRAFT)
+}
+
+func (v *Video) Approve(publishAt, now time.Time) error {
+	if err := v.changePublicationStatus(SCHEDULED); err != nil {
+		return err
+	}
+
+	publishAt = publishAt.UTC()
+	v.PublishAt = &publishAt
+
+	if v.IsDueForPublication(now) {
+		return v.Publish(now)
+	}
+
+	return nil
+}
+
+func (v *Video) Publish(now time.Time) error {
+	if v.PublicationStatus == SCHEDULED && !v.IsDueForPublication(now) {
+		return ErrPublicationNotDue
+	}
+
+	return v.changePublicationStatus(PUBLISHED)
+}
+
+func (v *Video) Unpublish() error {
+	if err := v.changePublicationStatus(UNPUBLISHED); err != nil {
+		return err
+	}
+
+	v.PublishAt = nil
+	return nil
+}
+
+func (v *Video) IsDueForPublication(now time.Time) bool {
+	return v.PublicationStatus == SCHEDULED && v.PublishAt != nil && !v.PublishAt.After(now)
+}
+
+func (v *Video) changePublicationStatus(next PublicationStatus) error {
+	if !v.PublicationStatus.CanTransitionTo(next) {
+		return InvalidPublicationTransitionError{From: v.PublicationStatus, To: next}
+	}
+
+	v.PublicationStatus = next
+	v.Published = next == PUBLISHED
+	v.UpdatedAt = time.Now().UTC()
+	return nil
+}
+
+func (v *Video) Processing(aType VideoMediaType) error {
+	return v.changeMediaStatus(aType, func(media *AudioVideoMedia) (*AudioVideoMedia, error) {
+		return media.processing()
+	})
+}
+
+func (v *Video) Completed(aType VideoMediaType, encodedPath string, renditions ...Rendition) error {
+	return v.changeMediaStatus(aType, func(media *AudioVideoMedia) (*AudioVideoMedia, error) {
+		return media.completed(encodedPath, renditions)
+	})
+}
+
+func (v *Video) Failed(aType VideoMediaType, reason string) error {
+	return v.changeMediaStatus(aType, func(media *AudioVideoMedia) (*AudioVideoMedia, error) {
+		return media.failed(reason)
+	})
+}
+
+func (v *Video) RetryMedia(aType VideoMediaType) error {
+	return v.changeMediaStatus(aType, func(media *AudioVideoMedia) (*AudioVideoMedia, error) {
+		return media.retry()
+	})
+}
+
+func (v *Video) AudioVideoMedia(aType VideoMediaType) (*AudioVideoMedia, error) {
+	var media *AudioVideoMedia
+
+	switch aType {
+	case VIDEO:
+		media = v.Video
+	case TRAILER:
+		media = v.Trailer
+	default:
+		return nil, fmt.Errorf("%s is not an audio/video media type", aType)
+	}
+
+	if media == nil {
+		return nil, fmt.Errorf("%w: video %d has no %s media", ErrResourceNotFound, v.ID, aType)
+	}
+
+	return media, nil
+}
+
+func (v *Video) changeMediaStatus(
+	aType VideoMediaType,
+	transition func(media *AudioVideoMedia) (*AudioVideoMedia, error),
+) error {
+	media, err := v.AudioVideoMedia(aType)
+	if err != nil {
+		return err
+	}
+
+	changed, err := transition(media)
+	if err != nil {
+		return err
+	}
+
+	if aType == VIDEO {
+		v.UpdateVideoMedia(changed)
+	} else {
+		v.UpdateTrailerMedia(changed)
+	}
+
+	return nil
+}
@@ -12,6 +12,9 @@ const (
 	BANNER
 	THUMBNAIL
 	THUMBNAIL_HALF
+	SUBTITLE
+	AUDIO
+	ARTWORK
 )
 
 func (v VideoMediaType) String() string {
@@ -26,7 +29,12 @@ func (v VideoMediaType) String() string {
 		return "Thumbnail"
 	case THUMBNAIL_HALF:
 		return "Thumbnail_half"
-
+	case SUBTITLE:
+		return "Subtitle"
+	case AUDIO:
+		return "Audio"
+	case ARTWORK:
+		return "Artwork"
 	}
 	return "unknown"
 }
@@ -43,6 +51,12 @@ func GetVideoType(value string) (VideoMediaType, error) {
 		return THUMBNAIL, nil
 	case "Thumbnail_half":
 		return THUMBNAIL_HALF, nil
+	case "Subtitle":
+		return SUBTITLE, nil
+	case "Audio":
+		return AUDIO, nil
+	case "Artwork":
+		return ARTWORK, nil
 	}
 
 	return 0, fmt.Errorf("unknown video type: %s", value)
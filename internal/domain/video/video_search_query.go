@@ -0,0 +1,42 @@
+package video
+
+import (
+	"errors"
+
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain"
+)
+
+var sortableColumns = []string{"title", "year_launched", "duration", "created_at"}
+
+type VideoSearchQuery struct {
+	domain.SearchQuery
+	CategoryIds         []int64
+	GenreIds            []int64
+	CastMemberIds       []int64
+	Descriptors         []ContentDescriptor
+	ExcludedDescriptors []ContentDescriptor
+	Rating              *Rating
+	Published           *bool
+	Opened              *bool
+	YearLaunchedFrom    *int
+	YearLaunchedTo      *int
+}
+
+func (vsq VideoSearchQuery) SortColumn() string {
+	if vsq.Sort == "" {
+		return "title"
+	}
+	return vsq.Sort
+}
+
+func (vsq *VideoSearchQuery) Validate() error {
+	if err := vsq.SearchQuery.ValidateSortableBy(sortableColumns); err != nil {
+		return err
+	}
+
+	if vsq.YearLaunchedFrom != nil && vsq.YearLaunchedTo != nil && *vsq.YearLaunchedFrom > *vsq.YearLaunchedTo {
+		return errors.New("'yearLaunchedFrom' should not be greater than 'yearLaunchedTo'")
+	}
+
+	return nil
+}
@@ -0,0 +1,58 @@
+package video
+
+import (
+	"errors"
+	"testing"
+
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVideoSearchQuerySortColumn(t *testing.T) {
+	query := VideoSearchQuery{}
+	assert.Equal(t, "title", query.SortColumn())
+
+	query.Sort = "year_launched"
+	assert.Equal(t, "year_launched", query.SortColumn())
+}
+
+func TestVideoSearchQueryValidate(t *testing.T) {
+	from := 2020
+	to := 2010
+
+	tests := []struct {
+		query VideoSearchQuery
+		err   error
+	}{
+		{
+			query: VideoSearchQuery{
+				SearchQuery: domain.SearchQuery{Page: 1, PerPage: 10, Sort: "title", Direction: "desc"},
+			},
+			err: nil,
+		},
+		{
+			query: VideoSearchQuery{
+				SearchQuery: domain.SearchQuery{Page: 0, PerPage: 10},
+			},
+			err: errors.New("invalid page"),
+		},
+		{
+			query: VideoSearchQuery{
+				SearchQuery: domain.SearchQuery{Page: 1, PerPage: 10, Sort: "name"},
+			},
+			err: errors.New("can only sort by 'title', 'year_launched', 'duration' and 'created_at'"),
+		},
+		{
+			query: VideoSearchQuery{
+				SearchQuery:      domain.SearchQuery{Page: 1, PerPage: 10},
+				YearLaunchedFrom: &from,
+				YearLaunchedTo:   &to,
+			},
+			err: errors.New("'yearLaunchedFrom' should not be greater than 'yearLaunchedTo'"),
+		},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.err, test.query.Validate())
+	}
+}
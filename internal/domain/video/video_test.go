@@ -13,11 +13,10 @@ func TestUpdateBannerMedia(t *testing.T) {
 	launchedAt := 2025
 	duration := 54.4
 	opened := true
-	published := true
 	ids := []int64{12, 57}
 
 	video := NewVideo(
-		title, description, launchedAt, duration, opened, published, L, ids, ids, ids,
+		title, description, launchedAt, duration, opened, L, ids, ids, ids,
 	)
 	assert.Nil(t, video.Banner)
 	imageMedia := NewImageMediaWithId(
@@ -43,11 +42,10 @@ func TestUpdateThumbnailMedia(t *testing.T) {
 	launchedAt := 2025
 	duration := 54.4
 	opened := true
-	published := true
 	ids := []int64{12, 57}
 
 	video := NewVideo(
-		title, description, launchedAt, duration, opened, published, L, ids, ids, ids,
+		title, description, launchedAt, duration, opened, L, ids, ids, ids,
 	)
 	assert.Nil(t, video.ThumbNail)
 	imageMedia := NewImageMediaWithId(
@@ -73,11 +71,10 @@ func TestUpdateThumbnailHalfMedia(t *testing.T) {
 	launchedAt := 2025
 	duration := 54.4
 	opened := true
-	published := true
 	ids := []int64{12, 57}
 
 	video := NewVideo(
-		title, description, launchedAt, duration, opened, published, L, ids, ids, ids,
+		title, description, launchedAt, duration, opened, L, ids, ids, ids,
 	)
 	assert.Nil(t, video.ThumbNailHalf)
 	imageMedia := NewImageMediaWithId(
@@ -103,11 +100,10 @@ func TestUpdateTrailerMedia(t *testing.T) {
 	launchedAt := 2025
 	duration := 54.4
 	opened := true
-	published := true
 	ids := []int64{12, 57}
 
 	video := NewVideo(
-		title, description, launchedAt, duration, opened, published, L, ids, ids, ids,
+		title, description, launchedAt, duration, opened, L, ids, ids, ids,
 	)
 	assert.Nil(t, video.Trailer)
 	status := PENDING
@@ -136,11 +132,10 @@ func TestUpdateVideoMedia(t *testing.T) {
 	launchedAt := 2025
 	duration := 54.4
 	opened := true
-	published := true
 	ids := []int64{12, 57}
 
 	video := NewVideo(
-		title, description, launchedAt, duration, opened, published, L, ids, ids, ids,
+		title, description, launchedAt, duration, opened, L, ids, ids, ids,
 	)
 	assert.Nil(t, video.Video)
 	status := PENDING
@@ -169,11 +164,10 @@ func TestProcessingVideo(t *testing.T) {
 	launchedAt := 2025
 	duration := 54.4
 	opened := true
-	published := true
 	ids := []int64{12, 57}
 
 	video := NewVideo(
-		title, description, launchedAt, duration, opened, published, L, ids, ids, ids,
+		title, description, launchedAt, duration, opened, L, ids, ids, ids,
 	)
 	updatedTime := video.UpdatedAt
 	aType := VIDEO
@@ -203,12 +197,11 @@ func TestCompletedTrailer(t *testing.T) {
 	launchedAt := 2025
 	duration := 54.4
 	opened := true
-	published := true
 	ids := []int64{12, 57}
 	expectedEncodedPath := "/new-path/encoded"
 
 	video := NewVideo(
-		title, description, launchedAt, duration, opened, published, L, ids, ids, ids,
+		title, description, launchedAt, duration, opened, L, ids, ids, ids,
 	)
 	updatedTime := video.UpdatedAt
 	aType := TRAILER
@@ -238,11 +231,10 @@ func TestProcessingTrailer(t *testing.T) {
 	launchedAt := 2025
 	duration := 54.4
 	opened := true
-	published := true
 	ids := []int64{12, 57}
 
 	video := NewVideo(
-		title, description, launchedAt, duration, opened, published, L, ids, ids, ids,
+		title, description, launchedAt, duration, opened, L, ids, ids, ids,
 	)
 	updatedTime := video.UpdatedAt
 	aType := TRAILER
@@ -272,12 +264,11 @@ func TestCompletedVideo(t *testing.T) {
 	launchedAt := 2025
 	duration := 54.4
 	opened := true
-	published := true
 	ids := []int64{12, 57}
 	expectedEncodedPath := "/new-path/encoded"
 
 	video := NewVideo(
-		title, description, launchedAt, duration, opened, published, L, ids, ids, ids,
+		title, description, launchedAt, duration, opened, L, ids, ids, ids,
 	)
 	updatedTime := video.UpdatedAt
 	aType := VIDEO
@@ -300,3 +291,89 @@ func TestCompletedVideo(t *testing.T) {
 	assert.Equal(t, expectedEncodedPath, video.Video.EncodedLocation)
 	assert.True(t, updatedTime.Before(video.UpdatedAt))
 }
+
+func TestUpdateVideo(t *testing.T) {
+	ids := []int64{12, 57}
+	video := NewVideo(
+		"title", "desc", 2025, 54.4, true, L, ids, ids, ids,
+	)
+	updatedTime := video.UpdatedAt
+	newIds := []int64{57, 99}
+
+	time.Sleep(1 * time.Millisecond)
+
+	video.Update("new title", "new desc", 2020, 90.5, false, AGE_14, newIds, []int64{}, ids)
+
+	assert.Equal(t, "new title", video.Title)
+	assert.Equal(t, "new desc", video.Description)
+	assert.Equal(t, 2020, video.LaunchedAt)
+	assert.Equal(t, 90.5, video.Duration)
+	assert.False(t, video.Opened)
+	assert.False(t, video.Published)
+	assert.Equal(t, AGE_14, video.Rating)
+	assert.Equal(t, newIds, video.CategoryIds)
+	assert.Empty(t, video.GenreIds)
+	assert.Equal(t, ids, video.CastMemberIds)
+	assert.True(t, updatedTime.Before(video.UpdatedAt))
+}
+
+func TestFailedAndRetryVideoMedia(t *testing.T) {
+	video := NewVideo("title", "desc", 2025, 54.4, true, L, nil, nil, nil)
+	status := PENDING
+	video.Video = NewAudioVideoMediaWith(565, &status, "checksum", "name", "/raw/x.file", "")
+
+	assert.Nil(t, video.Processing(VIDEO))
+	assert.Equal(t, 1, video.Video.Attempts)
+
+	assert.Nil(t, video.Failed(VIDEO, "unsupported codec"))
+	assert.Equal(t, FAILED, *video.Video.Status)
+	assert.Equal(t, "unsupported codec", video.Video.FailureReason)
+
+	assert.Nil(t, video.RetryMedia(VIDEO))
+	assert.Equal(t, PENDING, *video.Video.Status)
+	assert.Equal(t, "", video.Video.FailureReason)
+	assert.Equal(t, 1, video.Video.Attempts)
+
+	assert.Nil(t, video.Completed(VIDEO, "/encoded"))
+	assert.Equal(t, COMPLETED, *video.Video.Status)
+	assert.Equal(t, 2, video.Video.Attempts)
+}
+
+func TestInvalidMediaStatusTransition(t *testing.T) {
+	video := NewVideo("title", "desc", 2025, 54.4, true, L, nil, nil, nil)
+	status := COMPLETED
+	media := NewAudioVideoMediaWith(565, &status, "checksum", "name", "/raw/x.file", "/encoded")
+	video.Trailer = media
+
+	err := video.Processing(TRAILER)
+
+	var transitionErr InvalidMediaStatusTransitionError
+	assert.ErrorAs(t, err, &transitionErr)
+	assert.Equal(t, COMPLETED, transitionErr.From)
+	assert.Equal(t, PROCESSING, transitionErr.To)
+	assert.Equal(t, "media status cannot change from COMPLETED to PROCESSING", err.Error())
+	assert.Same(t, media, video.Trailer)
+}
+
+func TestMediaStatusChangeWithoutMedia(t *testing.T) {
+	video := NewVideo("title", "desc", 2025, 54.4, true, L, nil, nil, nil)
+
+	assert.ErrorIs(t, video.Completed(VIDEO, "/encoded"), ErrResourceNotFound)
+	assert.ErrorIs(t, video.Processing(TRAILER), ErrResourceNotFound)
+	assert.EqualError(t, video.Failed(BANNER, "reason"), "Banner is not an audio/video media type")
+}
+
+func TestHasGeneratedThumbnailHalf(t *testing.T) {
+	aVideo := NewVideo("title", "desc", 2024, 120.0, true, L, nil, nil, nil)
+	assert.True(t, aVideo.HasGeneratedThumbnailHalf())
+
+	aVideo.UpdateThumbnailMedia(NewImageMediaWithId(1, "abc", "cover.jpg", "/cover.jpg"))
+	generated := NewImageMediaWithId(2, "def", "cover_half.jpg", "/cover_half.jpg")
+	generated.Generated = true
+	aVideo.UpdateThumbnailHalfMedia(generated)
+	assert.True(t, aVideo.HasGeneratedThumbnailHalf())
+
+	// an uploaded half is kept even when it is named like a generated one
+	aVideo.UpdateThumbnailHalfMedia(NewImageMediaWithId(2, "ghi", "cover_half.jpg", "/cover_half.jpg"))
+	assert.False(t, aVideo.HasGeneratedThumbnailHalf())
+}
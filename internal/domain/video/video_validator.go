@@ -31,6 +31,9 @@ func (vv VideoValidator) Validate() {
 	if len(description) > DESCRIPTION_MAX_LENGTH {
 		vv.vHandler.Add(errors.New("'description' must be between 1 and 4000 characters"))
 	}
+
+	validateRatings(vv.video, vv.vHandler)
+	validateDescriptors(vv.video, vv.vHandler)
 }
 
 func NewVideoValidator(v Video, handler validator.ValidationHandler) *VideoValidator {
@@ -0,0 +1,72 @@
+package infra_encoding
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/encoding"
+	infra_messaging "github.com.br/gibranct/admin_do_catalogo/internal/infra/messaging"
+)
+
+const publishTimeout = 5 * time.Second
+
+// resourceId is echoed back by the encoder in its results
+type jobMessage struct {
+	VideoId     int64           `json:"videoId"`
+	ResourceId  string          `json:"resourceId"`
+	MediaType   string          `json:"mediaType"`
+	Checksum    string          `json:"checksum"`
+	RawLocation string          `json:"rawLocation"`
+	Profile     *profileMessage `json:"profile"`
+}
+
+type profileMessage struct {
+	ID         int64              `json:"id"`
+	Name       string             `json:"name"`
+	Renditions []renditionMessage `json:"renditions"`
+}
+
+type renditionMessage struct {
+	Width     int    `json:"width"`
+	Height    int    `json:"height"`
+	Bitrate   int64  `json:"bitrate"`
+	Codec     string `json:"codec"`
+	Container string `json:"container"`
+}
+
+type JobPublisher struct {
+	Publisher infra_messaging.Publisher
+}
+
+func NewJobPublisher(publisher infra_messaging.Publisher) *JobPublisher {
+	return &JobPublisher{Publisher: publisher}
+}
+
+func (jp JobPublisher) Publish(job encoding.Job) error {
+	message := jobMessage{
+		VideoId:     job.VideoId,
+		ResourceId:  strconv.FormatInt(job.Media.ID, 10),
+		MediaType:   job.Type.String(),
+		Checksum:    job.Media.Checksum,
+		RawLocation: job.Media.RawLocation,
+	}
+
+	if p := job.Profile; p != nil {
+		message.Profile = &profileMessage{ID: p.ID, Name: p.Name, Renditions: []renditionMessage{}}
+		for _, r := range p.Renditions {
+			message.Profile.Renditions = append(message.Profile.Renditions, renditionMessage(r))
+		}
+	}
+
+	body, err := json.Marshal(message)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), publishTimeout)
+	defer cancel()
+
+	return jp.Publisher.Publish(ctx, body)
+}
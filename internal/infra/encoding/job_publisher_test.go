@@ -0,0 +1,34 @@
+package infra_encoding_test
+
+import (
+	"testing"
+
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/encoding"
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/video"
+	infra_encoding "github.com.br/gibranct/admin_do_catalogo/internal/infra/encoding"
+	infra_messaging "github.com.br/gibranct/admin_do_catalogo/internal/infra/messaging"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPublishJob(t *testing.T) {
+	messages := make(chan []byte, 2)
+	sut := infra_encoding.NewJobPublisher(infra_messaging.NewChannelPublisher(messages))
+	status := video.PENDING
+	media := video.NewAudioVideoMediaWith(15, &status, "sum", "movie.mp4", "/videos/1/movie.mp4", "")
+	profile := aProfile()
+	profile.ID = 3
+	profile.Renditions = profile.Renditions[1:]
+
+	assert.Nil(t, sut.Publish(*encoding.NewJob(1, video.VIDEO, *media, profile)))
+	assert.Nil(t, sut.Publish(*encoding.NewJob(1, video.VIDEO, *media, nil)))
+
+	assert.JSONEq(t, `{
+		"videoId": 1, "resourceId": "15", "mediaType": "Video", "checksum": "sum", "rawLocation": "/videos/1/movie.mp4",
+		"profile": {"id": 3, "name": "default", "renditions": [
+			{"width": 640, "height": 360, "bitrate": 800000, "codec": "h264", "container": "fmp4"}
+		]}
+	}`, string(<-messages))
+	assert.JSONEq(t, `{
+		"videoId": 1, "resourceId": "15", "mediaType": "Video", "checksum": "sum", "rawLocation": "/videos/1/movie.mp4", "profile": null
+	}`, string(<-messages))
+}
@@ -0,0 +1,384 @@
+package infra_encoding
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain"
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/encoding"
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/video"
+)
+
+type ProfileGateway struct {
+	Db *sql.DB
+}
+
+func NewProfileGateway(db *sql.DB) *ProfileGateway {
+	return &ProfileGateway{Db: db}
+}
+
+func (pg ProfileGateway) Create(aProfile *encoding.Profile) error {
+	tx, err := pg.Db.Begin()
+
+	if err != nil {
+		return fmt.Errorf("unable to create transaction: %s", err.Error())
+	}
+
+	defer tx.Rollback()
+
+	query := `
+		INSERT INTO encoding_profiles (name, created_at, updated_at)
+		VALUES ($1, $2, $3)
+		RETURNING id
+	`
+
+	err = tx.QueryRow(query, aProfile.Name, aProfile.CreatedAt, aProfile.UpdatedAt).Scan(&aProfile.ID)
+	if err != nil {
+		return err
+	}
+
+	if err = saveRenditions(tx, "encoding_profiles_renditions", "profile_id", aProfile.ID, aProfile.Renditions); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (pg ProfileGateway) FindById(profileId int64) (*encoding.Profile, error) {
+	query := `
+		SELECT id, name, created_at, updated_at
+		FROM encoding_profiles
+		WHERE id = $1
+	`
+
+	var aProfile encoding.Profile
+
+	err := pg.Db.QueryRow(query, profileId).Scan(
+		&aProfile.ID,
+		&aProfile.Name,
+		&aProfile.CreatedAt,
+		&aProfile.UpdatedAt,
+	)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, encoding.ErrProfileNotFound
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err = loadRenditions(pg.Db, &aProfile); err != nil {
+		return nil, err
+	}
+
+	return &aProfile, nil
+}
+
+func (pg ProfileGateway) Update(aProfile encoding.Profile) error {
+	tx, err := pg.Db.Begin()
+
+	if err != nil {
+		return fmt.Errorf("unable to create transaction: %s", err.Error())
+	}
+
+	defer tx.Rollback()
+
+	result, err := tx.Exec(
+		"UPDATE encoding_profiles SET name = $1, updated_at = $2 WHERE id = $3",
+		aProfile.Name, aProfile.UpdatedAt, aProfile.ID,
+	)
+	if err != nil {
+		return err
+	}
+
+	if affected, err := result.RowsAffected(); err != nil {
+		return err
+	} else if affected == 0 {
+		return encoding.ErrProfileNotFound
+	}
+
+	if _, err = tx.Exec("DELETE FROM encoding_profiles_renditions WHERE profile_id = $1", aProfile.ID); err != nil {
+		return err
+	}
+
+	if err = saveRenditions(tx, "encoding_profiles_renditions", "profile_id", aProfile.ID, aProfile.Renditions); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (pg ProfileGateway) DeleteById(profileId int64) error {
+	_, err := pg.Db.Exec("DELETE FROM encoding_profiles WHERE id = $1", profileId)
+
+	return err
+}
+
+func (pg ProfileGateway) FindAll(query domain.SearchQuery) (*domain.Pagination[encoding.Profile], error) {
+	sql := fmt.Sprintf(`
+		SELECT COUNT(*) OVER(), p.id, p.name, p.created_at, p.updated_at
+		FROM encoding_profiles p
+		WHERE p.name ILIKE $1
+		ORDER BY p.%s %s, p.id
+		LIMIT $2 OFFSET $3`,
+		query.SortColumn(), query.SortDirection())
+
+	rows, err := pg.Db.Query(sql, "%"+query.Term+"%", query.Limit(), query.Offset())
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+	items := []*encoding.Profile{}
+	totalRecords := 0
+
+	for rows.Next() {
+		var p encoding.Profile
+		err := rows.Scan(
+			&totalRecords,
+			&p.ID,
+			&p.Name,
+			&p.CreatedAt,
+			&p.UpdatedAt,
+		)
+
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, &p)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if err = loadRenditions(pg.Db, items...); err != nil {
+		return nil, err
+	}
+
+	lastPage := math.Ceil(float64(totalRecords) / float64(query.PerPage))
+	return &domain.Pagination[encoding.Profile]{
+		Items:       items,
+		PerPage:     query.PerPage,
+		CurrentPage: query.Page,
+		Total:       totalRecords,
+		IsLast:      lastPage == float64(query.Page),
+	}, nil
+}
+
+func (pg ProfileGateway) AssignToVideo(videoId int64, profileId *int64) error {
+	return assign(pg.Db, "videos_encoding_profiles", "video_id", videoId, profileId)
+}
+
+func (pg ProfileGateway) AssignToCategory(categoryId int64, profileId *int64) error {
+	return assign(pg.Db, "categories_encoding_profiles", "category_id", categoryId, profileId)
+}
+
+func (pg ProfileGateway) FindAssignments(videoId int64) (*encoding.Assignments, error) {
+	assignments := encoding.Assignments{CategoryDefaults: []encoding.CategoryDefault{}}
+
+	err := pg.Db.QueryRow("SELECT profile_id FROM videos_encoding_profiles WHERE video_id = $1", videoId).
+		Scan(&assignments.VideoProfileId)
+
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, err
+	}
+
+	query := `
+		SELECT cp.category_id, cp.profile_id
+		FROM categories_encoding_profiles cp
+		JOIN videos_categories vc ON vc.category_id = cp.category_id
+		WHERE vc.video_id = $1
+		ORDER BY cp.category_id
+	`
+
+	rows, err := pg.Db.Query(query, videoId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var d encoding.CategoryDefault
+		if err = rows.Scan(&d.CategoryId, &d.ProfileId); err != nil {
+			return nil, err
+		}
+		assignments.CategoryDefaults = append(assignments.CategoryDefaults, d)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return &assignments, nil
+}
+
+// the profile a job was sent with, a nil profile leaves the media to the encoder defaults
+func (pg ProfileGateway) SaveMediaProfile(mediaId int64, aProfile *encoding.Profile) error {
+	tx, err := pg.Db.Begin()
+
+	if err != nil {
+		return fmt.Errorf("unable to create transaction: %s", err.Error())
+	}
+
+	defer tx.Rollback()
+
+	var profileId sql.NullInt64
+	var name sql.NullString
+	if aProfile != nil {
+		profileId = sql.NullInt64{Int64: aProfile.ID, Valid: true}
+		name = sql.NullString{String: aProfile.Name, Valid: true}
+	}
+
+	result, err := tx.Exec(
+		"UPDATE videos_video_media SET encoding_profile_id = $1, encoding_profile_name = $2 WHERE id = $3",
+		profileId, name, mediaId,
+	)
+	if err != nil {
+		return err
+	}
+
+	if affected, err := result.RowsAffected(); err != nil {
+		return err
+	} else if affected == 0 {
+		return video.ErrResourceNotFound
+	}
+
+	if _, err = tx.Exec("DELETE FROM videos_media_encoding_renditions WHERE video_media_id = $1", mediaId); err != nil {
+		return err
+	}
+
+	if aProfile != nil {
+		err = saveRenditions(tx, "videos_media_encoding_renditions", "video_media_id", mediaId, aProfile.Renditions)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// a media sent without a profile has none
+func (pg ProfileGateway) FindMediaProfile(mediaId int64) (*encoding.Profile, error) {
+	var profileId sql.NullInt64
+	var name sql.NullString
+
+	err := pg.Db.QueryRow(
+		"SELECT encoding_profile_id, encoding_profile_name FROM videos_video_media WHERE id = $1", mediaId,
+	).Scan(&profileId, &name)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, video.ErrResourceNotFound
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !profileId.Valid {
+		return nil, nil
+	}
+
+	aProfile := encoding.Profile{ID: profileId.Int64, Name: name.String, Renditions: []encoding.ProfileRendition{}}
+
+	rows, err := pg.Db.Query(`
+		SELECT width, height, bitrate, codec, container
+		FROM videos_media_encoding_renditions
+		WHERE video_media_id = $1 ORDER BY position
+	`, mediaId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var r encoding.ProfileRendition
+		if err = rows.Scan(&r.Width, &r.Height, &r.Bitrate, &r.Codec, &r.Container); err != nil {
+			return nil, err
+		}
+		aProfile.Renditions = append(aProfile.Renditions, r)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return &aProfile, nil
+}
+
+// a nil profile removes the assignment
+func assign(db *sql.DB, table, ownerColumn string, ownerId int64, profileId *int64) error {
+	if profileId == nil {
+		_, err := db.Exec(fmt.Sprintf("DELETE FROM %s WHERE %s = $1", table, ownerColumn), ownerId)
+		return err
+	}
+
+	query := fmt.Sprintf(`
+		INSERT INTO %s (%s, profile_id) VALUES ($1, $2)
+		ON CONFLICT (%s) DO UPDATE SET profile_id = EXCLUDED.profile_id
+	`, table, ownerColumn, ownerColumn)
+
+	_, err := db.Exec(query, ownerId, *profileId)
+	return err
+}
+
+func saveRenditions(tx *sql.Tx, table, ownerColumn string, ownerId int64, renditions []encoding.ProfileRendition) error {
+	query := fmt.Sprintf(`
+		INSERT INTO %s (%s, position, width, height, bitrate, codec, container)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`, table, ownerColumn)
+
+	for i, r := range renditions {
+		if _, err := tx.Exec(query, ownerId, i, r.Width, r.Height, r.Bitrate, r.Codec, r.Container); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func loadRenditions(db *sql.DB, profiles ...*encoding.Profile) error {
+	byId := map[int64]*encoding.Profile{}
+	ids := []string{}
+	for _, p := range profiles {
+		p.Renditions = []encoding.ProfileRendition{}
+		byId[p.ID] = p
+		ids = append(ids, strconv.FormatInt(p.ID, 10))
+	}
+
+	if len(ids) == 0 {
+		return nil
+	}
+
+	query := fmt.Sprintf(`
+		SELECT profile_id, width, height, bitrate, codec, container
+		FROM encoding_profiles_renditions
+		WHERE profile_id IN (%s) ORDER BY profile_id, position
+	`, strings.Join(ids, ","))
+
+	rows, err := db.Query(query)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var profileId int64
+		var r encoding.ProfileRendition
+
+		if err = rows.Scan(&profileId, &r.Width, &r.Height, &r.Bitrate, &r.Codec, &r.Container); err != nil {
+			return err
+		}
+
+		byId[profileId].Renditions = append(byId[profileId].Renditions, r)
+	}
+
+	return rows.Err()
+}
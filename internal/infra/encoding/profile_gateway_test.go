@@ -0,0 +1,307 @@
+package infra_encoding_test
+
+import (
+	"log"
+	"testing"
+
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain"
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/encoding"
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/video"
+	infra_encoding "github.com.br/gibranct/admin_do_catalogo/internal/infra/encoding"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func aProfile() *encoding.Profile {
+	return encoding.NewProfile("default", []encoding.ProfileRendition{
+		*encoding.NewProfileRendition(1920, 1080, 5_000_000, "h264", "fmp4"),
+		*encoding.NewProfileRendition(640, 360, 800_000, "h264", "fmp4"),
+	})
+}
+
+func TestCreateProfile(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		log.Fatalf("Failed to create DB connection: %s", err)
+	}
+	defer db.Close()
+
+	pg := infra_encoding.NewProfileGateway(db)
+	profile := aProfile()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("INSERT INTO encoding_profiles").
+		WithArgs(profile.Name, profile.CreatedAt, profile.UpdatedAt).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(3))
+	mock.ExpectExec("INSERT INTO encoding_profiles_renditions").
+		WithArgs(int64(3), 0, 1920, 1080, int64(5_000_000), "h264", "fmp4").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("INSERT INTO encoding_profiles_renditions").
+		WithArgs(int64(3), 1, 640, 360, int64(800_000), "h264", "fmp4").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	err = pg.Create(profile)
+
+	assert.Nil(t, err)
+	assert.Equal(t, int64(3), profile.ID)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestFindProfileById(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		log.Fatalf("Failed to create DB connection: %s", err)
+	}
+	defer db.Close()
+
+	pg := infra_encoding.NewProfileGateway(db)
+	profile := aProfile()
+
+	mock.ExpectQuery("SELECT id, name, created_at, updated_at FROM encoding_profiles").WithArgs(int64(3)).
+		WillReturnRows(sqlmock.NewRows(make([]string, 4)).
+			AddRow(3, profile.Name, profile.CreatedAt, profile.UpdatedAt))
+	mock.ExpectQuery(`FROM encoding_profiles_renditions\s+WHERE profile_id IN \(3\) ORDER BY profile_id, position`).
+		WillReturnRows(sqlmock.NewRows(make([]string, 6)).
+			AddRow(3, 1920, 1080, 5_000_000, "h264", "fmp4").
+			AddRow(3, 640, 360, 800_000, "h264", "fmp4"))
+
+	found, err := pg.FindById(3)
+
+	assert.Nil(t, err)
+	assert.Equal(t, profile.Name, found.Name)
+	assert.Equal(t, profile.Renditions, found.Renditions)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestFindProfileByIdWhenItIsNotFound(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		log.Fatalf("Failed to create DB connection: %s", err)
+	}
+	defer db.Close()
+
+	pg := infra_encoding.NewProfileGateway(db)
+
+	mock.ExpectQuery("FROM encoding_profiles").WithArgs(int64(3)).
+		WillReturnRows(sqlmock.NewRows(make([]string, 4)))
+
+	_, err = pg.FindById(3)
+
+	assert.Equal(t, encoding.ErrProfileNotFound, err)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdateProfile(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		log.Fatalf("Failed to create DB connection: %s", err)
+	}
+	defer db.Close()
+
+	pg := infra_encoding.NewProfileGateway(db)
+	profile := aProfile()
+	profile.ID = 3
+	profile.Renditions = profile.Renditions[1:]
+
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE encoding_profiles SET").
+		WithArgs(profile.Name, profile.UpdatedAt, int64(3)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("DELETE FROM encoding_profiles_renditions").WithArgs(int64(3)).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec("INSERT INTO encoding_profiles_renditions").
+		WithArgs(int64(3), 0, 640, 360, int64(800_000), "h264", "fmp4").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	err = pg.Update(*profile)
+
+	assert.Nil(t, err)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdateProfileWhenItIsNotFound(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		log.Fatalf("Failed to create DB connection: %s", err)
+	}
+	defer db.Close()
+
+	pg := infra_encoding.NewProfileGateway(db)
+	profile := aProfile()
+	profile.ID = 3
+
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE encoding_profiles SET").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectRollback()
+
+	err = pg.Update(*profile)
+
+	assert.Equal(t, encoding.ErrProfileNotFound, err)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestFindAllProfiles(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		log.Fatalf("Failed to create DB connection: %s", err)
+	}
+	defer db.Close()
+
+	pg := infra_encoding.NewProfileGateway(db)
+	profile := aProfile()
+	query := domain.SearchQuery{Page: 1, PerPage: 10, Term: "def", Sort: "name"}
+
+	mock.ExpectQuery(`ORDER BY p.name ASC, p.id\s+LIMIT \$2 OFFSET \$3`).WithArgs("%def%", 10, 0).
+		WillReturnRows(sqlmock.NewRows(make([]string, 5)).
+			AddRow(1, 3, profile.Name, profile.CreatedAt, profile.UpdatedAt))
+	mock.ExpectQuery(`WHERE profile_id IN \(3\)`).
+		WillReturnRows(sqlmock.NewRows(make([]string, 6)).
+			AddRow(3, 640, 360, 800_000, "h264", "fmp4"))
+
+	page, err := pg.FindAll(query)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 1, page.Total)
+	assert.True(t, page.IsLast)
+	assert.Equal(t, int64(3), page.Items[0].ID)
+	assert.Len(t, page.Items[0].Renditions, 1)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestAssignProfile(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		log.Fatalf("Failed to create DB connection: %s", err)
+	}
+	defer db.Close()
+
+	pg := infra_encoding.NewProfileGateway(db)
+	profileId := int64(3)
+
+	mock.ExpectExec(`INSERT INTO videos_encoding_profiles \(video_id, profile_id\) VALUES \(\$1, \$2\)\s+ON CONFLICT \(video_id\) DO UPDATE`).
+		WithArgs(int64(7), int64(3)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("DELETE FROM categories_encoding_profiles WHERE category_id = ").
+		WithArgs(int64(2)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	assert.Nil(t, pg.AssignToVideo(7, &profileId))
+	assert.Nil(t, pg.AssignToCategory(2, nil))
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestFindAssignments(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		log.Fatalf("Failed to create DB connection: %s", err)
+	}
+	defer db.Close()
+
+	pg := infra_encoding.NewProfileGateway(db)
+
+	mock.ExpectQuery("SELECT profile_id FROM videos_encoding_profiles").WithArgs(int64(7)).
+		WillReturnRows(sqlmock.NewRows([]string{"profile_id"}))
+	mock.ExpectQuery("FROM categories_encoding_profiles cp").WithArgs(int64(7)).
+		WillReturnRows(sqlmock.NewRows(make([]string, 2)).AddRow(2, 3).AddRow(5, 4))
+
+	assignments, err := pg.FindAssignments(7)
+
+	assert.Nil(t, err)
+	assert.Nil(t, assignments.VideoProfileId)
+	assert.Equal(t, []encoding.CategoryDefault{{CategoryId: 2, ProfileId: 3}, {CategoryId: 5, ProfileId: 4}}, assignments.CategoryDefaults)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestSaveMediaProfile(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		log.Fatalf("Failed to create DB connection: %s", err)
+	}
+	defer db.Close()
+
+	pg := infra_encoding.NewProfileGateway(db)
+	profile := aProfile()
+	profile.ID = 3
+
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE videos_video_media SET encoding_profile_id").WithArgs(int64(3), "default", int64(15)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("DELETE FROM videos_media_encoding_renditions").WithArgs(int64(15)).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec("INSERT INTO videos_media_encoding_renditions").
+		WithArgs(int64(15), 0, 1920, 1080, int64(5_000_000), "h264", "fmp4").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("INSERT INTO videos_media_encoding_renditions").
+		WithArgs(int64(15), 1, 640, 360, int64(800_000), "h264", "fmp4").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	err = pg.SaveMediaProfile(15, profile)
+
+	assert.Nil(t, err)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestSaveMediaProfileWhenMediaIsNotFound(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		log.Fatalf("Failed to create DB connection: %s", err)
+	}
+	defer db.Close()
+
+	pg := infra_encoding.NewProfileGateway(db)
+
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE videos_video_media SET encoding_profile_id").WithArgs(nil, nil, int64(15)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectRollback()
+
+	err = pg.SaveMediaProfile(15, nil)
+
+	assert.ErrorIs(t, err, video.ErrResourceNotFound)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestFindMediaProfile(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		log.Fatalf("Failed to create DB connection: %s", err)
+	}
+	defer db.Close()
+
+	pg := infra_encoding.NewProfileGateway(db)
+
+	mock.ExpectQuery("SELECT encoding_profile_id, encoding_profile_name FROM videos_video_media").WithArgs(int64(15)).
+		WillReturnRows(sqlmock.NewRows(make([]string, 2)).AddRow(3, "default"))
+	mock.ExpectQuery("FROM videos_media_encoding_renditions").WithArgs(int64(15)).
+		WillReturnRows(sqlmock.NewRows(make([]string, 5)).AddRow(640, 360, 800_000, "h264", "fmp4"))
+
+	found, err := pg.FindMediaProfile(15)
+
+	assert.Nil(t, err)
+	assert.Equal(t, int64(3), found.ID)
+	assert.Equal(t, "default", found.Name)
+	assert.Equal(t, []encoding.ProfileRendition{*encoding.NewProfileRendition(640, 360, 800_000, "h264", "fmp4")}, found.Renditions)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestFindMediaProfileWhenMediaWasSentWithoutProfile(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		log.Fatalf("Failed to create DB connection: %s", err)
+	}
+	defer db.Close()
+
+	pg := infra_encoding.NewProfileGateway(db)
+
+	mock.ExpectQuery("SELECT encoding_profile_id, encoding_profile_name FROM videos_video_media").WithArgs(int64(15)).
+		WillReturnRows(sqlmock.NewRows(make([]string, 2)).AddRow(nil, nil))
+
+	found, err := pg.FindMediaProfile(15)
+
+	assert.Nil(t, err)
+	assert.Nil(t, found)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
@@ -6,6 +6,7 @@ import (
 	infra_castmember "github.com.br/gibranct/admin_do_catalogo/internal/infra/castmember"
 	infra_category "github.com.br/gibranct/admin_do_catalogo/internal/infra/category"
 	infra_genre "github.com.br/gibranct/admin_do_catalogo/internal/infra/genre"
+	infra_series "github.com.br/gibranct/admin_do_catalogo/internal/infra/series"
 	infra_video "github.com.br/gibranct/admin_do_catalogo/internal/infra/video"
 )
 
@@ -14,6 +15,8 @@ type Gateways struct {
 	CastMember infra_castmember.CastMemberGateway
 	Genre      infra_genre.GenreGateway
 	Video      infra_video.VideoGateway
+	Series     infra_series.SeriesGateway
+	Season     infra_series.SeasonGateway
 }
 
 func NewGateways(db *sql.DB) Gateways {
@@ -22,5 +25,7 @@ func NewGateways(db *sql.DB) Gateways {
 		CastMember: *infra_castmember.NewCastMemberGateway(db),
 		Genre:      *infra_genre.NewGenreGateway(db),
 		Video:      *infra_video.NewVideoGateway(db),
+		Series:     *infra_series.NewSeriesGateway(db),
+		Season:     *infra_series.NewSeasonGateway(db),
 	}
 }
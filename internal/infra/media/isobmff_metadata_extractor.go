@@ -0,0 +1,267 @@
+package infra_media
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"slices"
+
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/video"
+)
+
+// guards against reading absurd moov sizes from corrupted headers into memory
+const maxMoovSize = 64 << 20
+
+// QuickTime files predating ftyp start right away with one of these boxes
+var legacyLeadingBoxes = []string{"moov", "mdat", "wide", "free", "skip"}
+
+type ISOBMFFMetadataExtractor struct{}
+
+func NewISOBMFFMetadataExtractor() *ISOBMFFMetadataExtractor {
+	return &ISOBMFFMetadataExtractor{}
+}
+
+type box struct {
+	kind    string
+	payload []byte
+}
+
+func (e ISOBMFFMetadataExtractor) Extract(content io.ReadSeeker) (*video.MediaMetadata, error) {
+	moov, err := findMoov(content)
+	if err != nil {
+		return nil, err
+	}
+
+	children, err := parseBoxes(moov)
+	if err != nil {
+		return nil, err
+	}
+
+	metadata := &video.MediaMetadata{Codecs: []string{}}
+	foundHeader := false
+
+	for _, child := range children {
+		switch child.kind {
+		case "mvhd":
+			if metadata.Duration, err = parseMovieHeader(child.payload); err != nil {
+				return nil, err
+			}
+			foundHeader = true
+		case "trak":
+			if err = parseTrack(child.payload, metadata); err != nil {
+				return nil, err
+			}
+			metadata.TrackCount++
+		}
+	}
+
+	if !foundHeader {
+		return nil, fmt.Errorf("%w: missing mvhd box", video.ErrUnsupportedMedia)
+	}
+
+	return metadata, nil
+}
+
+func findMoov(content io.ReadSeeker) ([]byte, error) {
+	if _, err := content.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+
+	first := true
+	header := make([]byte, 16)
+
+	for {
+		if _, err := io.ReadFull(content, header[:8]); err != nil {
+			if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+				return nil, fmt.Errorf("%w: missing moov box", video.ErrUnsupportedMedia)
+			}
+			return nil, err
+		}
+
+		size := uint64(binary.BigEndian.Uint32(header[:4]))
+		kind := string(header[4:8])
+		headerSize := uint64(8)
+
+		if first && kind != "ftyp" && !slices.Contains(legacyLeadingBoxes, kind) {
+			return nil, fmt.Errorf("%w: missing ftyp box", video.ErrUnsupportedMedia)
+		}
+		first = false
+
+		switch size {
+		case 0:
+			if kind != "moov" {
+				return nil, fmt.Errorf("%w: missing moov box", video.ErrUnsupportedMedia)
+			}
+			payload, err := io.ReadAll(io.LimitReader(content, maxMoovSize+1))
+			if err != nil {
+				return nil, err
+			}
+			if len(payload) > maxMoovSize {
+				return nil, fmt.Errorf("%w: moov box is too large", video.ErrUnsupportedMedia)
+			}
+			return payload, nil
+		case 1:
+			if _, err := io.ReadFull(content, header[8:16]); err != nil {
+				return nil, fmt.Errorf("%w: truncated %s box", video.ErrUnsupportedMedia, kind)
+			}
+			size = binary.BigEndian.Uint64(header[8:16])
+			headerSize = 16
+		}
+
+		if size < headerSize {
+			return nil, fmt.Errorf("%w: invalid %s box size", video.ErrUnsupportedMedia, kind)
+		}
+
+		payloadSize := size - headerSize
+
+		if kind == "moov" {
+			if payloadSize > maxMoovSize {
+				return nil, fmt.Errorf("%w: moov box is too large", video.ErrUnsupportedMedia)
+			}
+			payload := make([]byte, payloadSize)
+			if _, err := io.ReadFull(content, payload); err != nil {
+				return nil, fmt.Errorf("%w: truncated moov box", video.ErrUnsupportedMedia)
+			}
+			return payload, nil
+		}
+
+		if payloadSize > math.MaxInt64 {
+			return nil, fmt.Errorf("%w: invalid %s box size", video.ErrUnsupportedMedia, kind)
+		}
+
+		if _, err := content.Seek(int64(payloadSize), io.SeekCurrent); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func parseBoxes(data []byte) ([]box, error) {
+	boxes := []box{}
+
+	for len(data) > 0 {
+		if len(data) < 8 {
+			return nil, fmt.Errorf("%w: truncated box header", video.ErrUnsupportedMedia)
+		}
+
+		size := uint64(binary.BigEndian.Uint32(data[:4]))
+		kind := string(data[4:8])
+		headerSize := uint64(8)
+
+		switch size {
+		case 0:
+			size = uint64(len(data))
+		case 1:
+			if len(data) < 16 {
+				return nil, fmt.Errorf("%w: truncated %s box", video.ErrUnsupportedMedia, kind)
+			}
+			size = binary.BigEndian.Uint64(data[8:16])
+			headerSize = 16
+		}
+
+		if size < headerSize || size > uint64(len(data)) {
+			return nil, fmt.Errorf("%w: invalid %s box size", video.ErrUnsupportedMedia, kind)
+		}
+
+		boxes = append(boxes, box{kind: kind, payload: data[headerSize:size]})
+		data = data[size:]
+	}
+
+	return boxes, nil
+}
+
+func findBox(data []byte, path ...string) ([]byte, error) {
+	for _, kind := range path {
+		children, err := parseBoxes(data)
+		if err != nil {
+			return nil, err
+		}
+
+		index := slices.IndexFunc(children, func(b box) bool { return b.kind == kind })
+		if index < 0 {
+			return nil, nil
+		}
+
+		data = children[index].payload
+	}
+
+	return data, nil
+}
+
+func parseMovieHeader(payload []byte) (float64, error) {
+	var timescale, duration uint64
+
+	switch {
+	case len(payload) >= 32 && payload[0] == 1:
+		timescale = uint64(binary.BigEndian.Uint32(payload[20:24]))
+		duration = binary.BigEndian.Uint64(payload[24:32])
+		if duration == 1<<64-1 {
+			duration = 0
+		}
+	case len(payload) >= 20 && payload[0] == 0:
+		timescale = uint64(binary.BigEndian.Uint32(payload[12:16]))
+		duration = uint64(binary.BigEndian.Uint32(payload[16:20]))
+		if duration == 1<<32-1 {
+			duration = 0
+		}
+	default:
+		return 0, fmt.Errorf("%w: invalid mvhd box", video.ErrUnsupportedMedia)
+	}
+
+	if timescale == 0 {
+		return 0, fmt.Errorf("%w: mvhd timescale is zero", video.ErrUnsupportedMedia)
+	}
+
+	return float64(duration) / float64(timescale), nil
+}
+
+func parseTrack(payload []byte, metadata *video.MediaMetadata) error {
+	handler, err := findBox(payload, "mdia", "hdlr")
+	if err != nil {
+		return err
+	}
+
+	if len(handler) >= 12 && string(handler[8:12]) == "vide" && metadata.Width == 0 {
+		header, err := findBox(payload, "tkhd")
+		if err != nil {
+			return err
+		}
+
+		if metadata.Width, metadata.Height, err = parseTrackDimensions(header); err != nil {
+			return err
+		}
+	}
+
+	sampleDescription, err := findBox(payload, "mdia", "minf", "stbl", "stsd")
+	if err != nil {
+		return err
+	}
+
+	// version/flags and entry count precede the first sample entry
+	if len(sampleDescription) >= 16 {
+		codec := string(sampleDescription[12:16])
+		if !slices.Contains(metadata.Codecs, codec) {
+			metadata.Codecs = append(metadata.Codecs, codec)
+		}
+	}
+
+	return nil
+}
+
+func parseTrackDimensions(header []byte) (int, int, error) {
+	// width and height are the last two 16.16 fixed point fields of tkhd
+	offset := 76
+	if len(header) > 0 && header[0] == 1 {
+		offset = 88
+	}
+
+	if len(header) < offset+8 {
+		return 0, 0, fmt.Errorf("%w: invalid tkhd box", video.ErrUnsupportedMedia)
+	}
+
+	width := binary.BigEndian.Uint32(header[offset:offset+4]) >> 16
+	height := binary.BigEndian.Uint32(header[offset+4:offset+8]) >> 16
+
+	return int(width), int(height), nil
+}
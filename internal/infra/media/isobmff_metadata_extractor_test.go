@@ -0,0 +1,111 @@
+package infra_media_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"testing"
+
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/video"
+	infra_media "github.com.br/gibranct/admin_do_catalogo/internal/infra/media"
+	"github.com/stretchr/testify/assert"
+)
+
+func mp4Box(kind string, payloads ...[]byte) []byte {
+	content := bytes.Join(payloads, nil)
+	header := make([]byte, 8)
+	binary.BigEndian.PutUint32(header, uint32(len(content)+8))
+	copy(header[4:], kind)
+	return append(header, content...)
+}
+
+func uint32s(values ...uint32) []byte {
+	buf := make([]byte, 4*len(values))
+	for i, value := range values {
+		binary.BigEndian.PutUint32(buf[4*i:], value)
+	}
+	return buf
+}
+
+func movieHeader(timescale, duration uint32) []byte {
+	return mp4Box("mvhd", uint32s(0, 0, 0, timescale, duration), make([]byte, 80))
+}
+
+func track(handler, codec string, width, height uint32) []byte {
+	tkhd := mp4Box("tkhd", make([]byte, 76), uint32s(width<<16, height<<16))
+	hdlr := mp4Box("hdlr", uint32s(0, 0), []byte(handler), make([]byte, 12))
+	stsd := mp4Box("stsd", uint32s(0, 1), mp4Box(codec, make([]byte, 8)))
+	minf := mp4Box("minf", mp4Box("stbl", stsd))
+	return mp4Box("trak", tkhd, mp4Box("mdia", hdlr, minf))
+}
+
+func TestExtractMetadata(t *testing.T) {
+	content := bytes.Join([][]byte{
+		mp4Box("ftyp", []byte("isom"), uint32s(0)),
+		mp4Box("mdat", make([]byte, 1024)),
+		mp4Box("moov",
+			movieHeader(1000, 5_400_000),
+			track("vide", "avc1", 1920, 1080),
+			track("soun", "mp4a", 0, 0),
+			track("soun", "mp4a", 0, 0),
+		),
+	}, nil)
+	sut := infra_media.NewISOBMFFMetadataExtractor()
+
+	metadata, err := sut.Extract(bytes.NewReader(content))
+
+	assert.Nil(t, err)
+	assert.Equal(t, &video.MediaMetadata{
+		Duration:   5400,
+		Width:      1920,
+		Height:     1080,
+		Codecs:     []string{"avc1", "mp4a"},
+		TrackCount: 3,
+	}, metadata)
+}
+
+func TestExtractMetadataFromQuickTimeWithoutFtyp(t *testing.T) {
+	content := bytes.Join([][]byte{
+		mp4Box("wide"),
+		mp4Box("mdat", make([]byte, 512)),
+		mp4Box("free", make([]byte, 16)),
+		mp4Box("moov",
+			movieHeader(600, 36_000),
+			track("vide", "jpeg", 640, 480),
+			track("soun", "twos", 0, 0),
+		),
+	}, nil)
+	sut := infra_media.NewISOBMFFMetadataExtractor()
+
+	metadata, err := sut.Extract(bytes.NewReader(content))
+
+	assert.Nil(t, err)
+	assert.Equal(t, &video.MediaMetadata{
+		Duration:   60,
+		Width:      640,
+		Height:     480,
+		Codecs:     []string{"jpeg", "twos"},
+		TrackCount: 2,
+	}, metadata)
+}
+
+func TestExtractMetadataFromUnsupportedContent(t *testing.T) {
+	tests := []struct {
+		name    string
+		content []byte
+	}{
+		{"webm", []byte("\x1a\x45\xdf\xa3webm content")},
+		{"missing moov", mp4Box("ftyp", []byte("isom"))},
+		{"missing mvhd", bytes.Join([][]byte{mp4Box("ftyp", []byte("isom")), mp4Box("moov")}, nil)},
+		{"truncated moov", append(mp4Box("ftyp", []byte("isom")), mp4Box("moov", movieHeader(1000, 1))[:20]...)},
+		{"invalid box size", bytes.Join([][]byte{mp4Box("ftyp", []byte("isom")), mp4Box("moov", uint32s(4), []byte("mvhd"))}, nil)},
+	}
+	sut := infra_media.NewISOBMFFMetadataExtractor()
+
+	for _, test := range tests {
+		metadata, err := sut.Extract(bytes.NewReader(test.content))
+
+		assert.Nil(t, metadata, test.name)
+		assert.True(t, errors.Is(err, video.ErrUnsupportedMedia), test.name)
+	}
+}
@@ -0,0 +1,586 @@
+package infra_media
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"mime"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/video"
+)
+
+var checksumPattern = regexp.MustCompile(`^[0-9a-f]{64}$`)
+
+// stored content is kept as a blob named by its checksum and only linked to its
+// location once committed, so what is served always matches what the database references
+type LocalMediaResourceGateway struct {
+	RootDir    string
+	EncodedDir string
+	References video.MediaReferenceCounter
+	pins       *blobPins
+}
+
+// a blob is pinned from being stored until it is committed or discarded, so a release
+// that finds no reference to it in the meantime keeps it; storing a blob and releasing
+// one are serialized
+type blobPins struct {
+	mu     sync.Mutex
+	counts map[string]int
+}
+
+func NewLocalMediaResourceGateway(rootDir, encodedDir string, references video.MediaReferenceCounter) *LocalMediaResourceGateway {
+	return &LocalMediaResourceGateway{
+		RootDir:    rootDir,
+		EncodedDir: encodedDir,
+		References: references,
+		pins:       &blobPins{counts: map[string]int{}},
+	}
+}
+
+func (g LocalMediaResourceGateway) StoreAudioVideo(videoId int64, resource video.VideoResource) (*video.AudioVideoMedia, error) {
+	if resource.Type != video.VIDEO && resource.Type != video.TRAILER {
+		return nil, fmt.Errorf("%s is not an audio/video media type", resource.Type)
+	}
+
+	location, checksum, err := g.store(g.mediaDir(videoId, resource.Type), resource.Resource)
+	if err != nil {
+		return nil, err
+	}
+
+	status := video.PENDING
+	return video.NewAudioVideoMediaWith(
+		0,
+		&status,
+		checksum,
+		resource.Resource.Name,
+		location,
+		"",
+	), nil
+}
+
+func (g LocalMediaResourceGateway) StoreImage(videoId int64, resource video.VideoResource) (*video.ImageMedia, error) {
+	if resource.Type != video.BANNER && resource.Type != video.THUMBNAIL && resource.Type != video.THUMBNAIL_HALF {
+		return nil, fmt.Errorf("%s is not an image media type", resource.Type)
+	}
+
+	location, checksum, err := g.store(g.mediaDir(videoId, resource.Type), resource.Resource)
+	if err != nil {
+		return nil, err
+	}
+
+	return video.NewImageMediaWithoutId(checksum, resource.Resource.Name, location), nil
+}
+
+func (g LocalMediaResourceGateway) GetResource(videoId int64, aType video.VideoMediaType) (*video.Resource, error) {
+	return g.open(g.mediaDir(videoId, aType))
+}
+
+func (g LocalMediaResourceGateway) StoreTextTrack(videoId int64, track video.TextTrack, resource video.Resource) (*video.TextTrack, error) {
+	location, checksum, err := g.store(g.textTrackDir(videoId, track.Language, track.Kind), resource)
+	if err != nil {
+		return nil, err
+	}
+
+	track.Checksum = checksum
+	track.Name = resource.Name
+	track.Location = location
+	return &track, nil
+}
+
+func (g LocalMediaResourceGateway) GetTextTrack(videoId int64, language string, kind video.TextTrackKind) (*video.Resource, error) {
+	resource, err := g.open(g.textTrackDir(videoId, language, kind))
+	if err != nil {
+		return nil, err
+	}
+
+	// not every mime table knows the .vtt extension
+	resource.ContentType = "text/vtt; charset=utf-8"
+	return resource, nil
+}
+
+func (g LocalMediaResourceGateway) StoreAudioTrack(videoId int64, language string, resource video.Resource) (*video.AudioVideoMedia, error) {
+	location, checksum, err := g.store(g.audioTrackDir(videoId, language), resource)
+	if err != nil {
+		return nil, err
+	}
+
+	status := video.PENDING
+	return video.NewAudioVideoMediaWith(0, &status, checksum, resource.Name, location, ""), nil
+}
+
+// the blob is kept, it is released once no media references it anymore
+func (g LocalMediaResourceGateway) RemoveAudioTrack(videoId int64, language string) error {
+	return os.RemoveAll(g.audioTrackDir(videoId, language))
+}
+
+func (g LocalMediaResourceGateway) StoreExtra(videoId int64, extraId int64, resource video.Resource) (*video.AudioVideoMedia, error) {
+	location, checksum, err := g.store(g.extraDir(videoId, extraId), resource)
+	if err != nil {
+		return nil, err
+	}
+
+	status := video.PENDING
+	return video.NewAudioVideoMediaWith(0, &status, checksum, resource.Name, location, ""), nil
+}
+
+func (g LocalMediaResourceGateway) GetExtra(videoId int64, extraId int64) (*video.Resource, error) {
+	return g.open(g.extraDir(videoId, extraId))
+}
+
+func (g LocalMediaResourceGateway) RemoveExtra(videoId int64, extraId int64) error {
+	return os.RemoveAll(g.extraDir(videoId, extraId))
+}
+
+func (g LocalMediaResourceGateway) StoreArtwork(videoId int64, artwork video.Artwork, resource video.Resource) (*video.ImageMedia, error) {
+	location, checksum, err := g.store(g.artworkDir(videoId, artwork), resource)
+	if err != nil {
+		return nil, err
+	}
+
+	return video.NewImageMediaWithoutId(checksum, resource.Name, location), nil
+}
+
+func (g LocalMediaResourceGateway) GetArtwork(videoId int64, artwork video.Artwork) (*video.Resource, error) {
+	return g.open(g.artworkDir(videoId, artwork))
+}
+
+func (g LocalMediaResourceGateway) RemoveArtwork(videoId int64, artwork video.Artwork) error {
+	return os.RemoveAll(g.artworkDir(videoId, artwork))
+}
+
+// the encoded output is written by the encoder, the location it reported is only
+// served when it resolves to a file under the encoded root
+func (g LocalMediaResourceGateway) GetEncoded(location string) (*video.Resource, error) {
+	resolved, err := g.encodedPath(location)
+	if err != nil {
+		return nil, err
+	}
+
+	return openFile(resolved)
+}
+
+func (g LocalMediaResourceGateway) encodedPath(location string) (string, error) {
+	root, err := filepath.Abs(g.EncodedDir)
+	if err != nil {
+		return "", err
+	}
+
+	if root, err = filepath.EvalSymlinks(root); err != nil {
+		return "", notFoundIfMissing(err)
+	}
+
+	// a relative location is taken from the encoded root
+	if !filepath.IsAbs(location) {
+		location = filepath.Join(root, location)
+	}
+
+	resolved, err := filepath.EvalSymlinks(filepath.Clean(location))
+	if err != nil {
+		return "", notFoundIfMissing(err)
+	}
+
+	relative, err := filepath.Rel(root, resolved)
+	if err != nil || relative == ".." || strings.HasPrefix(relative, ".."+string(filepath.Separator)) {
+		return "", video.ErrResourceNotFound
+	}
+
+	return resolved, nil
+}
+
+func notFoundIfMissing(err error) error {
+	if errors.Is(err, fs.ErrNotExist) {
+		return video.ErrResourceNotFound
+	}
+
+	return err
+}
+
+func (g LocalMediaResourceGateway) open(dir string) (*video.Resource, error) {
+	location, err := findLocation(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	return openFile(location)
+}
+
+func openFile(location string) (*video.Resource, error) {
+	file, err := os.Open(location)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, video.ErrResourceNotFound
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+
+	if info.IsDir() {
+		file.Close()
+		return nil, video.ErrResourceNotFound
+	}
+
+	name := filepath.Base(location)
+
+	contentType, err := contentTypeOf(name, file)
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+
+	return &video.Resource{
+		Stream:      file,
+		ContentType: contentType,
+		Name:        name,
+		Size:        info.Size(),
+		ModifiedAt:  info.ModTime(),
+	}, nil
+}
+
+func (g LocalMediaResourceGateway) Exists(checksum string) (bool, error) {
+	checksum = strings.ToLower(checksum)
+	if !checksumPattern.MatchString(checksum) {
+		return false, nil
+	}
+
+	_, err := os.Stat(g.blobPath(checksum))
+	if errors.Is(err, fs.ErrNotExist) {
+		return false, nil
+	}
+
+	return err == nil, err
+}
+
+func (g LocalMediaResourceGateway) GetBlob(checksum string) (*video.Resource, error) {
+	checksum = strings.ToLower(checksum)
+	if !checksumPattern.MatchString(checksum) {
+		return nil, video.ErrResourceNotFound
+	}
+
+	return openFile(g.blobPath(checksum))
+}
+
+func (g LocalMediaResourceGateway) Commit(location, checksum string) error {
+	checksum = strings.ToLower(checksum)
+	defer g.unpin(checksum)
+
+	dir, name := filepath.Dir(location), filepath.Base(location)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+
+	target, err := filepath.Rel(dir, g.blobPath(checksum))
+	if err != nil {
+		return err
+	}
+
+	link := filepath.Join(dir, fmt.Sprintf(".link-%d", time.Now().UnixNano()))
+	if err = os.Symlink(target, link); err != nil {
+		return err
+	}
+	defer os.Remove(link)
+
+	if err = os.Rename(link, location); err != nil {
+		return err
+	}
+
+	return removeStoredFiles(dir, name)
+}
+
+func (g LocalMediaResourceGateway) Discard(checksum string) error {
+	checksum = strings.ToLower(checksum)
+	g.unpin(checksum)
+
+	return g.Release(checksum)
+}
+
+func (g LocalMediaResourceGateway) Release(checksum string) error {
+	checksum = strings.ToLower(checksum)
+	if !checksumPattern.MatchString(checksum) {
+		return nil
+	}
+
+	g.pins.mu.Lock()
+	defer g.pins.mu.Unlock()
+
+	if g.pins.counts[checksum] > 0 {
+		return nil
+	}
+
+	references, err := g.References.CountMediaReferences(checksum)
+	if err != nil {
+		return err
+	}
+
+	if references > 0 {
+		return nil
+	}
+
+	err = os.Remove(g.blobPath(checksum))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+
+	return err
+}
+
+func (g LocalMediaResourceGateway) ClearResources(videoId int64) error {
+	checksums := []string{}
+
+	err := filepath.WalkDir(g.videoDir(videoId), func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if entry.Type()&fs.ModeSymlink != 0 {
+			target, err := os.Readlink(path)
+			if err != nil {
+				return err
+			}
+			checksums = append(checksums, filepath.Base(target))
+		}
+
+		return nil
+	})
+
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+
+	if err = os.RemoveAll(g.videoDir(videoId)); err != nil {
+		return err
+	}
+
+	for _, checksum := range checksums {
+		if err = g.Release(checksum); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (g LocalMediaResourceGateway) store(dir string, resource video.Resource) (string, string, error) {
+	name := filepath.Base(resource.Name)
+	if name == "." || name == string(filepath.Separator) {
+		return "", "", errors.New("resource name should not be empty")
+	}
+
+	checksum, err := g.storeBlob(name, resource)
+	if err != nil {
+		return "", "", err
+	}
+
+	return filepath.Join(dir, name), checksum, nil
+}
+
+func (g LocalMediaResourceGateway) storeBlob(name string, resource video.Resource) (string, error) {
+	expected := strings.ToLower(resource.Checksum)
+
+	// content already stored can be referenced by its checksum alone, any
+	// bytes supplied with it are still verified below
+	if resource.Stream == nil && resource.Content == nil {
+		exists, err := g.pinExisting(expected)
+		if err != nil {
+			return "", err
+		}
+
+		if exists {
+			return expected, nil
+		}
+	}
+
+	tmpDir := filepath.Join(g.RootDir, "blobs", ".tmp")
+	if err := os.MkdirAll(tmpDir, 0o755); err != nil {
+		return "", err
+	}
+
+	tmp, err := os.CreateTemp(tmpDir, "upload-*")
+	if err != nil {
+		return "", err
+	}
+	defer os.Remove(tmp.Name())
+
+	content := resource.Stream
+	if content == nil {
+		content = bytes.NewReader(resource.Content)
+	}
+
+	hash := sha256.New()
+	if _, err = io.Copy(tmp, io.TeeReader(content, hash)); err != nil {
+		tmp.Close()
+		return "", err
+	}
+
+	if err = tmp.Sync(); err != nil {
+		tmp.Close()
+		return "", err
+	}
+
+	if err = tmp.Close(); err != nil {
+		return "", err
+	}
+
+	checksum := hex.EncodeToString(hash.Sum(nil))
+	if expected != "" && expected != checksum {
+		return "", fmt.Errorf("%w for %s: expected %s but got %s", video.ErrChecksumMismatch, name, resource.Checksum, checksum)
+	}
+
+	g.pins.mu.Lock()
+	defer g.pins.mu.Unlock()
+
+	blob := g.blobPath(checksum)
+	if err = os.MkdirAll(filepath.Dir(blob), 0o755); err != nil {
+		return "", err
+	}
+
+	if err = os.Rename(tmp.Name(), blob); err != nil {
+		return "", err
+	}
+
+	g.pins.counts[checksum]++
+
+	return checksum, nil
+}
+
+func (g LocalMediaResourceGateway) pinExisting(checksum string) (bool, error) {
+	g.pins.mu.Lock()
+	defer g.pins.mu.Unlock()
+
+	exists, err := g.Exists(checksum)
+	if err != nil || !exists {
+		return false, err
+	}
+
+	now := time.Now()
+	if err = os.Chtimes(g.blobPath(checksum), now, now); err != nil {
+		return false, err
+	}
+
+	g.pins.counts[checksum]++
+
+	return true, nil
+}
+
+func (g LocalMediaResourceGateway) unpin(checksum string) {
+	g.pins.mu.Lock()
+	defer g.pins.mu.Unlock()
+
+	if g.pins.counts[checksum]--; g.pins.counts[checksum] <= 0 {
+		delete(g.pins.counts, checksum)
+	}
+}
+
+func findLocation(dir string) (string, error) {
+	entries, err := os.ReadDir(dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return "", video.ErrResourceNotFound
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	for _, entry := range entries {
+		if !entry.IsDir() && !strings.HasPrefix(entry.Name(), ".") {
+			return filepath.Join(dir, entry.Name()), nil
+		}
+	}
+
+	return "", video.ErrResourceNotFound
+}
+
+func (g LocalMediaResourceGateway) videoDir(videoId int64) string {
+	return filepath.Join(g.RootDir, "videos", strconv.FormatInt(videoId, 10))
+}
+
+func (g LocalMediaResourceGateway) blobPath(checksum string) string {
+	return filepath.Join(g.RootDir, "blobs", checksum[:2], checksum)
+}
+
+func (g LocalMediaResourceGateway) mediaDir(videoId int64, aType video.VideoMediaType) string {
+	return filepath.Join(g.videoDir(videoId), strings.ToLower(aType.String()))
+}
+
+func (g LocalMediaResourceGateway) audioTrackDir(videoId int64, language string) string {
+	return filepath.Join(g.mediaDir(videoId, video.AUDIO), language)
+}
+
+func (g LocalMediaResourceGateway) extraDir(videoId int64, extraId int64) string {
+	return filepath.Join(g.videoDir(videoId), "extras", strconv.FormatInt(extraId, 10))
+}
+
+// every variant gets its own directory, artwork without text lives under "neutral"
+func (g LocalMediaResourceGateway) artworkDir(videoId int64, artwork video.Artwork) string {
+	language := artwork.Language
+	if language == "" {
+		language = "neutral"
+	}
+
+	return filepath.Join(
+		g.videoDir(videoId),
+		"artwork",
+		strings.ToLower(artwork.Role.String()),
+		language,
+		strconv.Itoa(artwork.Width),
+	)
+}
+
+// every language and kind gets its own directory so storing a track never replaces another
+func (g LocalMediaResourceGateway) textTrackDir(videoId int64, language string, kind video.TextTrackKind) string {
+	return filepath.Join(g.mediaDir(videoId, video.SUBTITLE), language, strings.ToLower(kind.String()))
+}
+
+// every stored file but keep is removed
+func removeStoredFiles(dir, keep string) error {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		if entry.IsDir() || entry.Name() == keep || strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+		if err = os.Remove(filepath.Join(dir, entry.Name())); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func contentTypeOf(name string, file io.ReadSeeker) (string, error) {
+	if contentType := mime.TypeByExtension(filepath.Ext(name)); contentType != "" {
+		return contentType, nil
+	}
+
+	head := make([]byte, 512)
+	n, err := io.ReadFull(file, head)
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return "", err
+	}
+
+	if _, err = file.Seek(0, io.SeekStart); err != nil {
+		return "", err
+	}
+
+	return http.DetectContentType(head[:n]), nil
+}
@@ -0,0 +1,548 @@
+package infra_media_test
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/video"
+	infra_media "github.com.br/gibranct/admin_do_catalogo/internal/infra/media"
+	"github.com/stretchr/testify/assert"
+)
+
+type referenceCounter map[string]int64
+
+func (r referenceCounter) CountMediaReferences(checksum string) (int64, error) {
+	return r[checksum], nil
+}
+
+func dummyResource(aType video.VideoMediaType, name string, content []byte) video.VideoResource {
+	sum := sha256.Sum256(content)
+	return video.VideoResource{
+		Type: aType,
+		Resource: video.Resource{
+			Content:     content,
+			Checksum:    hex.EncodeToString(sum[:]),
+			ContentType: "application/octet-stream",
+			Name:        name,
+		},
+	}
+}
+
+func TestStoreAudioVideo(t *testing.T) {
+	root := t.TempDir()
+	sut := infra_media.NewLocalMediaResourceGateway(root, "", referenceCounter{})
+	resource := dummyResource(video.VIDEO, "movie.mp4", []byte("video content"))
+
+	media, err := sut.StoreAudioVideo(10, resource)
+
+	assert.Nil(t, err)
+	assert.Equal(t, filepath.Join(root, "videos", "10", "video", "movie.mp4"), media.RawLocation)
+	assert.Equal(t, resource.Resource.Checksum, media.Checksum)
+	assert.Equal(t, "movie.mp4", media.Name)
+	assert.Equal(t, "", media.EncodedLocation)
+	assert.Equal(t, video.PENDING, *media.Status)
+	assert.NoFileExists(t, media.RawLocation)
+
+	err = sut.Commit(media.RawLocation, media.Checksum)
+
+	assert.Nil(t, err)
+	content, err := os.ReadFile(media.RawLocation)
+	assert.Nil(t, err)
+	assert.Equal(t, resource.Resource.Content, content)
+}
+
+func TestStoreAudioVideoReplacesPreviousFile(t *testing.T) {
+	root := t.TempDir()
+	sut := infra_media.NewLocalMediaResourceGateway(root, "", referenceCounter{})
+
+	first, _ := sut.StoreAudioVideo(10, dummyResource(video.TRAILER, "first.mp4", []byte("first")))
+	sut.Commit(first.RawLocation, first.Checksum)
+	second, _ := sut.StoreAudioVideo(10, dummyResource(video.TRAILER, "second.mp4", []byte("second")))
+
+	assert.FileExists(t, first.RawLocation)
+
+	err := sut.Commit(second.RawLocation, second.Checksum)
+
+	assert.Nil(t, err)
+	assert.NoFileExists(t, first.RawLocation)
+	assert.FileExists(t, second.RawLocation)
+	entries, _ := os.ReadDir(filepath.Dir(second.RawLocation))
+	assert.Len(t, entries, 1)
+}
+
+func TestStoreAudioVideoReplacesFileWithTheSameName(t *testing.T) {
+	root := t.TempDir()
+	sut := infra_media.NewLocalMediaResourceGateway(root, "", referenceCounter{})
+
+	first, _ := sut.StoreAudioVideo(10, dummyResource(video.TRAILER, "trailer.mp4", []byte("first")))
+	sut.Commit(first.RawLocation, first.Checksum)
+	second, _ := sut.StoreAudioVideo(10, dummyResource(video.TRAILER, "trailer.mp4", []byte("second")))
+
+	content, _ := os.ReadFile(second.RawLocation)
+	assert.Equal(t, []byte("first"), content)
+
+	err := sut.Commit(second.RawLocation, second.Checksum)
+
+	assert.Nil(t, err)
+	content, _ = os.ReadFile(second.RawLocation)
+	assert.Equal(t, []byte("second"), content)
+	entries, _ := os.ReadDir(filepath.Dir(second.RawLocation))
+	assert.Len(t, entries, 1)
+}
+
+func TestStoreAudioVideoWithWrongType(t *testing.T) {
+	sut := infra_media.NewLocalMediaResourceGateway(t.TempDir(), "", referenceCounter{})
+
+	media, err := sut.StoreAudioVideo(10, dummyResource(video.BANNER, "banner.png", []byte("image")))
+
+	assert.Nil(t, media)
+	assert.Equal(t, "Banner is not an audio/video media type", err.Error())
+}
+
+func TestStoreWithChecksumMismatch(t *testing.T) {
+	root := t.TempDir()
+	sut := infra_media.NewLocalMediaResourceGateway(root, "", referenceCounter{})
+	resource := dummyResource(video.VIDEO, "movie.mp4", []byte("video content"))
+	resource.Resource.Checksum = "invalid"
+
+	media, err := sut.StoreAudioVideo(10, resource)
+
+	assert.Nil(t, media)
+	assert.ErrorIs(t, err, video.ErrChecksumMismatch)
+	assert.ErrorContains(t, err, "checksum mismatch for movie.mp4")
+	entries, _ := os.ReadDir(filepath.Join(root, "videos", "10", "video"))
+	assert.Empty(t, entries)
+}
+
+func TestStoreAudioVideoFromStream(t *testing.T) {
+	root := t.TempDir()
+	sut := infra_media.NewLocalMediaResourceGateway(root, "", referenceCounter{})
+	content := bytes.Repeat([]byte("chunk"), 1_000_000)
+	resource := dummyResource(video.VIDEO, "movie.mp4", content)
+	resource.Resource.Content = nil
+	resource.Resource.Stream = bytes.NewReader(content)
+
+	media, err := sut.StoreAudioVideo(10, resource)
+
+	assert.Nil(t, err)
+	assert.Equal(t, resource.Resource.Checksum, media.Checksum)
+	sut.Commit(media.RawLocation, media.Checksum)
+	stored, _ := os.ReadFile(media.RawLocation)
+	assert.Equal(t, content, stored)
+}
+
+func TestStoreImage(t *testing.T) {
+	root := t.TempDir()
+	sut := infra_media.NewLocalMediaResourceGateway(root, "", referenceCounter{})
+	resource := dummyResource(video.THUMBNAIL_HALF, "../../thumb.png", []byte("image content"))
+
+	media, err := sut.StoreImage(10, resource)
+
+	assert.Nil(t, err)
+	assert.Equal(t, filepath.Join(root, "videos", "10", "thumbnail_half", "thumb.png"), media.Location)
+	assert.Equal(t, resource.Resource.Checksum, media.Checksum)
+	assert.Equal(t, int64(0), media.ID)
+}
+
+func TestStoreImageWithWrongType(t *testing.T) {
+	sut := infra_media.NewLocalMediaResourceGateway(t.TempDir(), "", referenceCounter{})
+
+	media, err := sut.StoreImage(10, dummyResource(video.VIDEO, "movie.mp4", []byte("video")))
+
+	assert.Nil(t, media)
+	assert.Equal(t, "Video is not an image media type", err.Error())
+}
+
+func TestGetResource(t *testing.T) {
+	sut := infra_media.NewLocalMediaResourceGateway(t.TempDir(), "", referenceCounter{})
+	stored := dummyResource(video.BANNER, "banner.png", []byte("image content"))
+	media, _ := sut.StoreImage(10, stored)
+	sut.Commit(media.Location, media.Checksum)
+
+	resource, err := sut.GetResource(10, video.BANNER)
+
+	assert.Nil(t, err)
+	defer resource.Stream.(io.Closer).Close()
+	content, _ := io.ReadAll(resource.Stream)
+	assert.Equal(t, stored.Resource.Content, content)
+	assert.Equal(t, int64(len(stored.Resource.Content)), resource.Size)
+	assert.False(t, resource.ModifiedAt.IsZero())
+	assert.Equal(t, "banner.png", resource.Name)
+	assert.Equal(t, "image/png", resource.ContentType)
+}
+
+func TestGetResourceDetectsContentType(t *testing.T) {
+	sut := infra_media.NewLocalMediaResourceGateway(t.TempDir(), "", referenceCounter{})
+	media, _ := sut.StoreAudioVideo(10, dummyResource(video.VIDEO, "master", []byte("plain text master")))
+	sut.Commit(media.RawLocation, media.Checksum)
+
+	resource, err := sut.GetResource(10, video.VIDEO)
+
+	assert.Nil(t, err)
+	defer resource.Stream.(io.Closer).Close()
+	assert.Equal(t, "text/plain; charset=utf-8", resource.ContentType)
+	content, _ := io.ReadAll(resource.Stream)
+	assert.Equal(t, "plain text master", string(content))
+}
+
+func TestGetResourceWhenItDoesNotExist(t *testing.T) {
+	sut := infra_media.NewLocalMediaResourceGateway(t.TempDir(), "", referenceCounter{})
+
+	resource, err := sut.GetResource(10, video.BANNER)
+
+	assert.Nil(t, resource)
+	assert.Equal(t, video.ErrResourceNotFound, err)
+}
+
+func TestStoreTextTrack(t *testing.T) {
+	root := t.TempDir()
+	sut := infra_media.NewLocalMediaResourceGateway(root, "", referenceCounter{})
+	english := dummyResource(video.SUBTITLE, "movie.vtt", []byte("WEBVTT\n\n00:01.000 --> 00:02.000\nHi\n"))
+	forced := dummyResource(video.SUBTITLE, "movie.vtt", []byte("WEBVTT\n\n00:01.000 --> 00:02.000\nOi\n"))
+
+	track, err := sut.StoreTextTrack(10, *video.NewTextTrack("en", video.CAPTIONS), english.Resource)
+	forcedTrack, _ := sut.StoreTextTrack(10, *video.NewTextTrack("pt-BR", video.FORCED), forced.Resource)
+	sut.Commit(forcedTrack.Location, forcedTrack.Checksum)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "en", track.Language)
+	assert.Equal(t, video.CAPTIONS, track.Kind)
+	assert.Equal(t, english.Resource.Checksum, track.Checksum)
+	assert.Equal(t, "movie.vtt", track.Name)
+	assert.Equal(t, filepath.Join(root, "videos", "10", "subtitle", "en", "captions", "movie.vtt"), track.Location)
+
+	resource, err := sut.GetTextTrack(10, "pt-BR", video.FORCED)
+
+	assert.Nil(t, err)
+	defer resource.Stream.(io.Closer).Close()
+	assert.Equal(t, "text/vtt; charset=utf-8", resource.ContentType)
+	content, _ := io.ReadAll(resource.Stream)
+	assert.Equal(t, forced.Resource.Content, content)
+}
+
+func TestGetTextTrackWhenItDoesNotExist(t *testing.T) {
+	sut := infra_media.NewLocalMediaResourceGateway(t.TempDir(), "", referenceCounter{})
+
+	resource, err := sut.GetTextTrack(10, "en", video.SUBTITLES)
+
+	assert.Nil(t, resource)
+	assert.Equal(t, video.ErrResourceNotFound, err)
+}
+
+func TestStoreAndRemoveAudioTrack(t *testing.T) {
+	root := t.TempDir()
+	sut := infra_media.NewLocalMediaResourceGateway(root, "", referenceCounter{})
+	english := dummyResource(video.AUDIO, "movie.en.mp4", []byte("english audio"))
+	spanish := dummyResource(video.AUDIO, "movie.es.mp4", []byte("spanish audio"))
+
+	media, err := sut.StoreAudioTrack(10, "en", english.Resource)
+	spanishMedia, _ := sut.StoreAudioTrack(10, "es", spanish.Resource)
+	sut.Commit(media.RawLocation, media.Checksum)
+	sut.Commit(spanishMedia.RawLocation, spanishMedia.Checksum)
+
+	assert.Nil(t, err)
+	assert.Equal(t, filepath.Join(root, "videos", "10", "audio", "en", "movie.en.mp4"), media.RawLocation)
+	assert.Equal(t, english.Resource.Checksum, media.Checksum)
+	assert.Equal(t, video.PENDING, *media.Status)
+	assert.FileExists(t, filepath.Join(root, "videos", "10", "audio", "es", "movie.es.mp4"))
+
+	err = sut.RemoveAudioTrack(10, "en")
+
+	assert.Nil(t, err)
+	assert.NoDirExists(t, filepath.Join(root, "videos", "10", "audio", "en"))
+	assert.FileExists(t, filepath.Join(root, "videos", "10", "audio", "es", "movie.es.mp4"))
+}
+
+func TestStoreGetAndRemoveExtra(t *testing.T) {
+	root := t.TempDir()
+	sut := infra_media.NewLocalMediaResourceGateway(root, "", referenceCounter{})
+	teaser := dummyResource(video.TRAILER, "teaser.mp4", []byte("teaser content"))
+
+	media, err := sut.StoreExtra(10, 3, teaser.Resource)
+
+	assert.Nil(t, err)
+	assert.Equal(t, filepath.Join(root, "videos", "10", "extras", "3", "teaser.mp4"), media.RawLocation)
+	assert.Equal(t, video.PENDING, *media.Status)
+	sut.Commit(media.RawLocation, media.Checksum)
+
+	resource, err := sut.GetExtra(10, 3)
+
+	assert.Nil(t, err)
+	content, _ := io.ReadAll(resource.Stream)
+	resource.Stream.(io.Closer).Close()
+	assert.Equal(t, "teaser content", string(content))
+
+	err = sut.RemoveExtra(10, 3)
+
+	assert.Nil(t, err)
+	_, err = sut.GetExtra(10, 3)
+	assert.ErrorIs(t, err, video.ErrResourceNotFound)
+}
+
+func TestStoreGetAndRemoveArtwork(t *testing.T) {
+	root := t.TempDir()
+	sut := infra_media.NewLocalMediaResourceGateway(root, "", referenceCounter{})
+	poster := dummyResource(video.ARTWORK, "poster.png", []byte("poster content"))
+	neutral := *video.NewArtwork(video.POSTER, "", 600, 900, nil)
+	localized := *video.NewArtwork(video.POSTER, "pt-BR", 600, 900, nil)
+
+	media, err := sut.StoreArtwork(10, neutral, poster.Resource)
+
+	assert.Nil(t, err)
+	assert.Equal(t, filepath.Join(root, "videos", "10", "artwork", "poster", "neutral", "600", "poster.png"), media.Location)
+	assert.Equal(t, poster.Resource.Checksum, media.Checksum)
+	sut.Commit(media.Location, media.Checksum)
+
+	_, err = sut.GetArtwork(10, localized)
+	assert.ErrorIs(t, err, video.ErrResourceNotFound)
+
+	resource, err := sut.GetArtwork(10, neutral)
+
+	assert.Nil(t, err)
+	content, _ := io.ReadAll(resource.Stream)
+	resource.Stream.(io.Closer).Close()
+	assert.Equal(t, "poster content", string(content))
+
+	err = sut.RemoveArtwork(10, neutral)
+
+	assert.Nil(t, err)
+	_, err = sut.GetArtwork(10, neutral)
+	assert.ErrorIs(t, err, video.ErrResourceNotFound)
+}
+
+func TestClearResources(t *testing.T) {
+	root := t.TempDir()
+	sut := infra_media.NewLocalMediaResourceGateway(root, "", referenceCounter{})
+	banner, _ := sut.StoreImage(10, dummyResource(video.BANNER, "banner.png", []byte("image")))
+	movie, _ := sut.StoreAudioVideo(10, dummyResource(video.VIDEO, "movie.mp4", []byte("video")))
+	other, _ := sut.StoreImage(11, dummyResource(video.BANNER, "banner.png", []byte("image")))
+	sut.Commit(banner.Location, banner.Checksum)
+	sut.Commit(movie.RawLocation, movie.Checksum)
+	sut.Commit(other.Location, other.Checksum)
+
+	err := sut.ClearResources(10)
+
+	assert.Nil(t, err)
+	assert.NoDirExists(t, filepath.Join(root, "videos", "10"))
+	assert.DirExists(t, filepath.Join(root, "videos", "11"))
+}
+
+func TestStoreDeduplicatesContent(t *testing.T) {
+	root := t.TempDir()
+	sut := infra_media.NewLocalMediaResourceGateway(root, "", referenceCounter{})
+	resource := dummyResource(video.BANNER, "banner.png", []byte("shared banner"))
+
+	first, err := sut.StoreImage(10, resource)
+	assert.Nil(t, err)
+	sut.Commit(first.Location, first.Checksum)
+
+	resource.Resource.Content = nil
+	second, err := sut.StoreImage(11, resource)
+
+	assert.Nil(t, err)
+	sut.Commit(second.Location, second.Checksum)
+	assert.Equal(t, first.Checksum, second.Checksum)
+	assert.NotEqual(t, first.Location, second.Location)
+	content, _ := os.ReadFile(second.Location)
+	assert.Equal(t, []byte("shared banner"), content)
+	blobs, _ := filepath.Glob(filepath.Join(root, "blobs", "*", "*"))
+	assert.Equal(t, []string{filepath.Join(root, "blobs", first.Checksum[:2], first.Checksum)}, blobs)
+}
+
+func TestStoreVerifiesContentOfKnownChecksum(t *testing.T) {
+	root := t.TempDir()
+	sut := infra_media.NewLocalMediaResourceGateway(root, "", referenceCounter{})
+	resource := dummyResource(video.BANNER, "banner.png", []byte("shared banner"))
+	first, _ := sut.StoreImage(10, resource)
+	sut.Commit(first.Location, first.Checksum)
+
+	resource.Resource.Content = []byte("another banner")
+	media, err := sut.StoreImage(11, resource)
+
+	assert.Nil(t, media)
+	assert.ErrorIs(t, err, video.ErrChecksumMismatch)
+	content, _ := os.ReadFile(first.Location)
+	assert.Equal(t, []byte("shared banner"), content)
+	entries, _ := os.ReadDir(filepath.Join(root, "videos", "11", "banner"))
+	assert.Empty(t, entries)
+
+	resource.Resource.Content = nil
+	resource.Resource.Stream = iotest.ErrReader(errors.New("broken stream"))
+	_, err = sut.StoreImage(11, resource)
+
+	assert.EqualError(t, err, "broken stream")
+}
+
+func TestExists(t *testing.T) {
+	sut := infra_media.NewLocalMediaResourceGateway(t.TempDir(), "", referenceCounter{})
+	resource := dummyResource(video.VIDEO, "movie.mp4", []byte("video"))
+	sut.StoreAudioVideo(10, resource)
+
+	exists, err := sut.Exists(resource.Resource.Checksum)
+	assert.Nil(t, err)
+	assert.True(t, exists)
+
+	exists, err = sut.Exists(strings.Repeat("a", 64))
+	assert.Nil(t, err)
+	assert.False(t, exists)
+
+	exists, err = sut.Exists("../../etc/passwd")
+	assert.Nil(t, err)
+	assert.False(t, exists)
+}
+
+func TestReleaseKeepsReferencedContent(t *testing.T) {
+	root := t.TempDir()
+	references := referenceCounter{}
+	sut := infra_media.NewLocalMediaResourceGateway(root, "", references)
+	resource := dummyResource(video.TRAILER, "trailer.mp4", []byte("trailer"))
+	media, _ := sut.StoreAudioVideo(10, resource)
+	sut.Commit(media.RawLocation, media.Checksum)
+	checksum := resource.Resource.Checksum
+
+	references[checksum] = 1
+	assert.Nil(t, sut.Release(checksum))
+	exists, _ := sut.Exists(checksum)
+	assert.True(t, exists)
+
+	references[checksum] = 0
+	assert.Nil(t, sut.Release(checksum))
+	exists, _ = sut.Exists(checksum)
+	assert.False(t, exists)
+}
+
+func TestReleaseKeepsContentNotYetCommitted(t *testing.T) {
+	root := t.TempDir()
+	sut := infra_media.NewLocalMediaResourceGateway(root, "", referenceCounter{})
+	resource := dummyResource(video.TRAILER, "trailer.mp4", []byte("trailer"))
+	media, _ := sut.StoreAudioVideo(10, resource)
+
+	assert.Nil(t, sut.Release(media.Checksum))
+	exists, _ := sut.Exists(media.Checksum)
+	assert.True(t, exists)
+
+	assert.Nil(t, sut.Commit(media.RawLocation, media.Checksum))
+	assert.Nil(t, sut.Release(media.Checksum))
+	exists, _ = sut.Exists(media.Checksum)
+	assert.False(t, exists)
+}
+
+func TestDiscard(t *testing.T) {
+	root := t.TempDir()
+	references := referenceCounter{}
+	sut := infra_media.NewLocalMediaResourceGateway(root, "", references)
+	owned := dummyResource(video.VIDEO, "movie.mp4", []byte("owned"))
+	shared := dummyResource(video.BANNER, "banner.png", []byte("shared"))
+	movie, _ := sut.StoreAudioVideo(10, owned)
+	banner, _ := sut.StoreImage(10, shared)
+	references[shared.Resource.Checksum] = 1
+
+	assert.Nil(t, sut.Discard(movie.Checksum))
+	assert.Nil(t, sut.Discard(banner.Checksum))
+
+	ownedExists, _ := sut.Exists(owned.Resource.Checksum)
+	sharedExists, _ := sut.Exists(shared.Resource.Checksum)
+	assert.False(t, ownedExists)
+	assert.True(t, sharedExists)
+	assert.NoFileExists(t, movie.RawLocation)
+	assert.NoFileExists(t, banner.Location)
+}
+
+func TestGetBlob(t *testing.T) {
+	sut := infra_media.NewLocalMediaResourceGateway(t.TempDir(), "", referenceCounter{})
+	resource := dummyResource(video.VIDEO, "movie.mp4", []byte("video"))
+	sut.StoreAudioVideo(10, resource)
+
+	blob, err := sut.GetBlob(resource.Resource.Checksum)
+
+	assert.Nil(t, err)
+	content, _ := io.ReadAll(blob.Stream)
+	blob.Stream.(io.Closer).Close()
+	assert.Equal(t, "video", string(content))
+
+	_, err = sut.GetBlob("../../etc/passwd")
+	assert.ErrorIs(t, err, video.ErrResourceNotFound)
+
+	_, err = sut.GetBlob(strings.Repeat("a", 64))
+	assert.ErrorIs(t, err, video.ErrResourceNotFound)
+}
+
+func TestClearResourcesReleasesUnreferencedContent(t *testing.T) {
+	root := t.TempDir()
+	references := referenceCounter{}
+	sut := infra_media.NewLocalMediaResourceGateway(root, "", references)
+	shared := dummyResource(video.BANNER, "banner.png", []byte("shared"))
+	owned := dummyResource(video.VIDEO, "movie.mp4", []byte("owned"))
+	banner, _ := sut.StoreImage(10, shared)
+	movie, _ := sut.StoreAudioVideo(10, owned)
+	other, _ := sut.StoreImage(11, shared)
+	sut.Commit(banner.Location, banner.Checksum)
+	sut.Commit(movie.RawLocation, movie.Checksum)
+	sut.Commit(other.Location, other.Checksum)
+	references[shared.Resource.Checksum] = 1
+
+	err := sut.ClearResources(10)
+
+	assert.Nil(t, err)
+	sharedExists, _ := sut.Exists(shared.Resource.Checksum)
+	ownedExists, _ := sut.Exists(owned.Resource.Checksum)
+	assert.True(t, sharedExists)
+	assert.False(t, ownedExists)
+	resource, err := sut.GetResource(11, video.BANNER)
+	assert.Nil(t, err)
+	resource.Stream.(io.Closer).Close()
+}
+
+func TestGetEncoded(t *testing.T) {
+	encodedDir := t.TempDir()
+	sut := infra_media.NewLocalMediaResourceGateway(t.TempDir(), encodedDir, referenceCounter{})
+	location := filepath.Join(encodedDir, "movie.mp4")
+	os.WriteFile(location, []byte("encoded"), 0o644)
+
+	resource, err := sut.GetEncoded(location)
+
+	assert.Nil(t, err)
+	defer resource.Stream.(io.Closer).Close()
+	assert.Equal(t, "movie.mp4", resource.Name)
+	assert.Equal(t, "video/mp4", resource.ContentType)
+	assert.Equal(t, int64(7), resource.Size)
+
+	_, err = sut.GetEncoded(encodedDir)
+	assert.ErrorIs(t, err, video.ErrResourceNotFound)
+
+	_, err = sut.GetEncoded(filepath.Join(encodedDir, "missing.mp4"))
+	assert.ErrorIs(t, err, video.ErrResourceNotFound)
+}
+
+func TestGetEncodedOutsideTheEncodedRoot(t *testing.T) {
+	encodedDir := t.TempDir()
+	sut := infra_media.NewLocalMediaResourceGateway(t.TempDir(), encodedDir, referenceCounter{})
+	outside := filepath.Join(t.TempDir(), "secret.mp4")
+	os.WriteFile(outside, []byte("secret"), 0o644)
+	os.Symlink(outside, filepath.Join(encodedDir, "link.mp4"))
+	os.WriteFile(filepath.Join(encodedDir, "movie.mp4"), []byte("encoded"), 0o644)
+
+	for _, location := range []string{
+		outside,
+		filepath.Join(encodedDir, "..", filepath.Base(filepath.Dir(outside)), "secret.mp4"),
+		filepath.Join(encodedDir, "link.mp4"),
+		"../" + filepath.Base(filepath.Dir(outside)) + "/secret.mp4",
+	} {
+		_, err := sut.GetEncoded(location)
+		assert.ErrorIs(t, err, video.ErrResourceNotFound, location)
+	}
+
+	resource, err := sut.GetEncoded("movie.mp4")
+
+	assert.Nil(t, err)
+	defer resource.Stream.(io.Closer).Close()
+	assert.Equal(t, int64(7), resource.Size)
+}
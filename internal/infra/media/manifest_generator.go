@@ -0,0 +1,160 @@
+package infra_media
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/video"
+)
+
+// the encoder writes CMAF segments shared by both formats into each rendition location
+const (
+	hlsMediaPlaylist  = "index.m3u8"
+	dashInitSegment   = "init.mp4"
+	dashMediaSegment  = "segment_$Number$.m4s"
+	dashTimescale     = 1000
+	dashMinBufferTime = "PT2S"
+)
+
+type AdaptiveManifestGenerator struct{}
+
+func NewAdaptiveManifestGenerator() *AdaptiveManifestGenerator {
+	return &AdaptiveManifestGenerator{}
+}
+
+func (g AdaptiveManifestGenerator) Generate(format video.ManifestFormat, media video.AudioVideoMedia) (*video.Resource, error) {
+	ladder := media.Ladder()
+	if len(ladder) == 0 {
+		return nil, fmt.Errorf("%w: media %d has no renditions", video.ErrResourceNotFound, media.ID)
+	}
+
+	var content []byte
+	var contentType, name string
+	var err error
+
+	switch format {
+	case video.HLS:
+		content, contentType, name = hlsMasterPlaylist(ladder), "application/vnd.apple.mpegurl", "master.m3u8"
+	case video.DASH:
+		// a static manifest is not playable without the presentation duration
+		if media.Metadata == nil || media.Metadata.Duration <= 0 {
+			return nil, fmt.Errorf("%w: media %d has no known duration", video.ErrResourceNotFound, media.ID)
+		}
+		content, err = dashManifest(ladder, media.Metadata.Duration)
+		contentType, name = "application/dash+xml", "manifest.mpd"
+	default:
+		err = fmt.Errorf("unsupported manifest format %s", format)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &video.Resource{
+		Stream:      bytes.NewReader(content),
+		ContentType: contentType,
+		Name:        name,
+		Size:        int64(len(content)),
+	}, nil
+}
+
+func hlsMasterPlaylist(ladder []video.Rendition) []byte {
+	var b strings.Builder
+
+	b.WriteString("#EXTM3U\n")
+	b.WriteString("#EXT-X-VERSION:7\n")
+	b.WriteString("#EXT-X-INDEPENDENT-SEGMENTS\n")
+
+	for _, r := range ladder {
+		fmt.Fprintf(&b, "#EXT-X-STREAM-INF:BANDWIDTH=%d,RESOLUTION=%s,CODECS=\"%s\"\n", r.Bandwidth, r.Resolution(), strings.Join(r.Codecs, ","))
+		b.WriteString(renditionPath(r.Location, hlsMediaPlaylist) + "\n")
+	}
+
+	return []byte(b.String())
+}
+
+type mpd struct {
+	XMLName                   xml.Name `xml:"urn:mpeg:dash:schema:mpd:2011 MPD"`
+	Type                      string   `xml:"type,attr"`
+	Profiles                  string   `xml:"profiles,attr"`
+	MinBufferTime             string   `xml:"minBufferTime,attr"`
+	MediaPresentationDuration string   `xml:"mediaPresentationDuration,attr"`
+	Period                    period   `xml:"Period"`
+}
+
+type period struct {
+	ID            string        `xml:"id,attr"`
+	AdaptationSet adaptationSet `xml:"AdaptationSet"`
+}
+
+type adaptationSet struct {
+	ContentType      string           `xml:"contentType,attr"`
+	MimeType         string           `xml:"mimeType,attr"`
+	SegmentAlignment bool             `xml:"segmentAlignment,attr"`
+	Representations  []representation `xml:"Representation"`
+}
+
+type representation struct {
+	ID              string          `xml:"id,attr"`
+	Bandwidth       int64           `xml:"bandwidth,attr"`
+	Width           int             `xml:"width,attr"`
+	Height          int             `xml:"height,attr"`
+	Codecs          string          `xml:"codecs,attr"`
+	BaseURL         string          `xml:"BaseURL"`
+	SegmentTemplate segmentTemplate `xml:"SegmentTemplate"`
+}
+
+type segmentTemplate struct {
+	Timescale      int    `xml:"timescale,attr"`
+	Duration       int64  `xml:"duration,attr"`
+	StartNumber    int    `xml:"startNumber,attr"`
+	Initialization string `xml:"initialization,attr"`
+	Media          string `xml:"media,attr"`
+}
+
+func dashManifest(ladder []video.Rendition, duration float64) ([]byte, error) {
+	manifest := mpd{
+		Type:                      "static",
+		Profiles:                  "urn:mpeg:dash:profile:isoff-live:2011",
+		MinBufferTime:             dashMinBufferTime,
+		MediaPresentationDuration: isoDuration(duration),
+		Period:                    period{ID: "0", AdaptationSet: adaptationSet{ContentType: "video", MimeType: "video/mp4", SegmentAlignment: true}},
+	}
+
+	for i, r := range ladder {
+		manifest.Period.AdaptationSet.Representations = append(manifest.Period.AdaptationSet.Representations, representation{
+			ID:        strconv.Itoa(i),
+			Bandwidth: r.Bandwidth,
+			Width:     r.Width,
+			Height:    r.Height,
+			Codecs:    strings.Join(r.Codecs, ","),
+			BaseURL:   renditionPath(r.Location, ""),
+			SegmentTemplate: segmentTemplate{
+				Timescale:      dashTimescale,
+				Duration:       int64(math.Round(r.SegmentDuration * dashTimescale)),
+				StartNumber:    1,
+				Initialization: dashInitSegment,
+				Media:          dashMediaSegment,
+			},
+		})
+	}
+
+	content, err := xml.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]byte(xml.Header), append(content, '\n')...), nil
+}
+
+func renditionPath(location, file string) string {
+	return strings.TrimSuffix(location, "/") + "/" + file
+}
+
+func isoDuration(seconds float64) string {
+	return "PT" + strconv.FormatFloat(seconds, 'f', -1, 64) + "S"
+}
@@ -0,0 +1,88 @@
+package infra_media_test
+
+import (
+	"io"
+	"testing"
+
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/video"
+	infra_media "github.com.br/gibranct/admin_do_catalogo/internal/infra/media"
+	"github.com/stretchr/testify/assert"
+)
+
+func encodedMedia() video.AudioVideoMedia {
+	status := video.COMPLETED
+	media := video.NewAudioVideoMediaWith(10, &status, "sum", "movie.mp4", "/movie.mp4", "https://cdn/videos/1")
+	media.Metadata = &video.MediaMetadata{Duration: 5400.5}
+	media.Renditions = []video.Rendition{
+		*video.NewRendition(5_000_000, 1920, 1080, []string{"avc1.640028", "mp4a.40.2"}, "https://cdn/videos/1/1080p/", 6),
+		*video.NewRendition(800_000, 640, 360, []string{"avc1.42c01e", "mp4a.40.2"}, "https://cdn/videos/1/360p", 6),
+	}
+	return *media
+}
+
+func TestGenerateHLSMasterPlaylist(t *testing.T) {
+	sut := infra_media.NewAdaptiveManifestGenerator()
+
+	resource, err := sut.Generate(video.HLS, encodedMedia())
+
+	assert.Nil(t, err)
+	assert.Equal(t, "application/vnd.apple.mpegurl", resource.ContentType)
+	assert.Equal(t, "master.m3u8", resource.Name)
+	content, _ := io.ReadAll(resource.Stream)
+	assert.Equal(t, int64(len(content)), resource.Size)
+	assert.Equal(t, `#EXTM3U
+#EXT-X-VERSION:7
+#EXT-X-INDEPENDENT-SEGMENTS
+#EXT-X-STREAM-INF:BANDWIDTH=800000,RESOLUTION=640x360,CODECS="avc1.42c01e,mp4a.40.2"
+https://cdn/videos/1/360p/index.m3u8
+#EXT-X-STREAM-INF:BANDWIDTH=5000000,RESOLUTION=1920x1080,CODECS="avc1.640028,mp4a.40.2"
+https://cdn/videos/1/1080p/index.m3u8
+`, string(content))
+}
+
+func TestGenerateDASHManifest(t *testing.T) {
+	sut := infra_media.NewAdaptiveManifestGenerator()
+
+	resource, err := sut.Generate(video.DASH, encodedMedia())
+
+	assert.Nil(t, err)
+	assert.Equal(t, "application/dash+xml", resource.ContentType)
+	assert.Equal(t, "manifest.mpd", resource.Name)
+	content, _ := io.ReadAll(resource.Stream)
+	assert.Equal(t, `<?xml version="1.0" encoding="UTF-8"?>
+<MPD xmlns="urn:mpeg:dash:schema:mpd:2011" type="static" profiles="urn:mpeg:dash:profile:isoff-live:2011" minBufferTime="PT2S" mediaPresentationDuration="PT5400.5S">
+  <Period id="0">
+    <AdaptationSet contentType="video" mimeType="video/mp4" segmentAlignment="true">
+      <Representation id="0" bandwidth="800000" width="640" height="360" codecs="avc1.42c01e,mp4a.40.2">
+        <BaseURL>https://cdn/videos/1/360p/</BaseURL>
+        <SegmentTemplate timescale="1000" duration="6000" startNumber="1" initialization="init.mp4" media="segment_$Number$.m4s"></SegmentTemplate>
+      </Representation>
+      <Representation id="1" bandwidth="5000000" width="1920" height="1080" codecs="avc1.640028,mp4a.40.2">
+        <BaseURL>https://cdn/videos/1/1080p/</BaseURL>
+        <SegmentTemplate timescale="1000" duration="6000" startNumber="1" initialization="init.mp4" media="segment_$Number$.m4s"></SegmentTemplate>
+      </Representation>
+    </AdaptationSet>
+  </Period>
+</MPD>
+`, string(content))
+}
+
+func TestGenerateManifestWithoutRenditions(t *testing.T) {
+	sut := infra_media.NewAdaptiveManifestGenerator()
+	media := encodedMedia()
+	media.Renditions = nil
+
+	_, err := sut.Generate(video.HLS, media)
+
+	assert.ErrorIs(t, err, video.ErrResourceNotFound)
+}
+
+func TestGenerateDASHManifestWithoutDuration(t *testing.T) {
+	sut := infra_media.NewAdaptiveManifestGenerator()
+	media := encodedMedia()
+	media.Metadata = nil
+
+	_, err := sut.Generate(video.DASH, media)
+
+	assert.ErrorIs(t, err, video.ErrResourceNotFound)
+}
@@ -0,0 +1,107 @@
+package infra_media
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"io"
+
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/video"
+)
+
+const jpegQuality = 90
+
+// decoding allocates the whole image, so thumbnails above this many pixels are not decoded
+const maxThumbnailPixels = 8192 * 8192
+
+type ImageThumbnailGenerator struct{}
+
+func NewImageThumbnailGenerator() *ImageThumbnailGenerator {
+	return &ImageThumbnailGenerator{}
+}
+
+func (g ImageThumbnailGenerator) GenerateHalf(thumbnail video.Resource) (*video.Resource, error) {
+	var content io.Reader = bytes.NewReader(thumbnail.Content)
+	if thumbnail.Stream != nil {
+		content = thumbnail.Stream
+	}
+
+	// the header read for the dimensions is replayed to the decoder
+	var header bytes.Buffer
+	config, _, err := image.DecodeConfig(io.TeeReader(content, &header))
+	if errors.Is(err, image.ErrFormat) {
+		return nil, fmt.Errorf("%w: %s", video.ErrUnsupportedImage, thumbnail.Name)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if int64(config.Width)*int64(config.Height) > maxThumbnailPixels {
+		return nil, fmt.Errorf("%w: %s is %dx%d, above the limit of %d pixels",
+			video.ErrUnsupportedImage, thumbnail.Name, config.Width, config.Height, maxThumbnailPixels)
+	}
+
+	src, format, err := image.Decode(io.MultiReader(&header, content))
+	if err != nil {
+		return nil, err
+	}
+
+	half := halve(src)
+
+	var buf bytes.Buffer
+	switch format {
+	case "jpeg":
+		err = jpeg.Encode(&buf, half, &jpeg.Options{Quality: jpegQuality})
+	case "png":
+		err = png.Encode(&buf, half)
+	case "gif":
+		err = gif.Encode(&buf, half, nil)
+	default:
+		return nil, fmt.Errorf("%w: %s", video.ErrUnsupportedImage, thumbnail.Name)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &video.Resource{
+		Content:     buf.Bytes(),
+		ContentType: "image/" + format,
+		Name:        video.HalfThumbnailName(thumbnail.Name),
+		Size:        int64(buf.Len()),
+	}, nil
+}
+
+func halve(src image.Image) *image.RGBA {
+	bounds := src.Bounds()
+	dst := image.NewRGBA(image.Rect(0, 0, max(bounds.Dx()/2, 1), max(bounds.Dy()/2, 1)))
+
+	for y := 0; y < dst.Rect.Dy(); y++ {
+		for x := 0; x < dst.Rect.Dx(); x++ {
+			var r, g, b, a, n uint32
+			for dy := 0; dy < 2; dy++ {
+				for dx := 0; dx < 2; dx++ {
+					px, py := bounds.Min.X+2*x+dx, bounds.Min.Y+2*y+dy
+					if px >= bounds.Max.X || py >= bounds.Max.Y {
+						continue
+					}
+					pr, pg, pb, pa := src.At(px, py).RGBA()
+					r, g, b, a, n = r+pr, g+pg, b+pb, a+pa, n+1
+				}
+			}
+			dst.SetRGBA(x, y, color.RGBA{
+				R: uint8(r / n >> 8),
+				G: uint8(g / n >> 8),
+				B: uint8(b / n >> 8),
+				A: uint8(a / n >> 8),
+			})
+		}
+	}
+
+	return dst
+}
@@ -0,0 +1,101 @@
+package infra_media_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"testing"
+
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/video"
+	infra_media "github.com.br/gibranct/admin_do_catalogo/internal/infra/media"
+	"github.com/stretchr/testify/assert"
+)
+
+func dummyImage(width, height int) *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			img.SetRGBA(x, y, color.RGBA{R: 200, G: 100, B: 50, A: 255})
+		}
+	}
+	return img
+}
+
+func TestGenerateHalfFromPng(t *testing.T) {
+	var buf bytes.Buffer
+	assert.Nil(t, png.Encode(&buf, dummyImage(64, 36)))
+	sut := infra_media.NewImageThumbnailGenerator()
+
+	half, err := sut.GenerateHalf(video.Resource{Stream: &buf, Name: "cover.png"})
+
+	assert.Nil(t, err)
+	assert.Equal(t, "cover_half.png", half.Name)
+	assert.Equal(t, "image/png", half.ContentType)
+	assert.Equal(t, int64(len(half.Content)), half.Size)
+	decoded, err := png.Decode(bytes.NewReader(half.Content))
+	assert.Nil(t, err)
+	assert.Equal(t, image.Rect(0, 0, 32, 18), decoded.Bounds())
+	assert.Equal(t, color.RGBA{R: 200, G: 100, B: 50, A: 255}, color.RGBAModel.Convert(decoded.At(5, 5)))
+}
+
+func TestGenerateHalfFromJpeg(t *testing.T) {
+	var buf bytes.Buffer
+	assert.Nil(t, jpeg.Encode(&buf, dummyImage(33, 21), nil))
+	sut := infra_media.NewImageThumbnailGenerator()
+
+	half, err := sut.GenerateHalf(video.Resource{Content: buf.Bytes(), Name: "cover.jpg"})
+
+	assert.Nil(t, err)
+	assert.Equal(t, "cover_half.jpg", half.Name)
+	assert.Equal(t, "image/jpeg", half.ContentType)
+	decoded, err := jpeg.Decode(bytes.NewReader(half.Content))
+	assert.Nil(t, err)
+	assert.Equal(t, image.Rect(0, 0, 16, 10), decoded.Bounds())
+}
+
+func TestGenerateHalfFromGif(t *testing.T) {
+	var buf bytes.Buffer
+	assert.Nil(t, gif.Encode(&buf, dummyImage(64, 36), nil))
+	sut := infra_media.NewImageThumbnailGenerator()
+
+	half, err := sut.GenerateHalf(video.Resource{Content: buf.Bytes(), Name: "cover.gif"})
+
+	assert.Nil(t, err)
+	assert.Equal(t, "cover_half.gif", half.Name)
+	assert.Equal(t, "image/gif", half.ContentType)
+	decoded, err := gif.Decode(bytes.NewReader(half.Content))
+	assert.Nil(t, err)
+	assert.Equal(t, image.Rect(0, 0, 32, 18), decoded.Bounds())
+}
+
+func TestGenerateHalfFromUnsupportedImage(t *testing.T) {
+	sut := infra_media.NewImageThumbnailGenerator()
+
+	half, err := sut.GenerateHalf(video.Resource{Content: []byte("not an image"), Name: "cover.webp"})
+
+	assert.Nil(t, half)
+	assert.True(t, errors.Is(err, video.ErrUnsupportedImage))
+}
+
+func TestGenerateHalfFromImageAboveThePixelLimit(t *testing.T) {
+	// only the header is written, the generator must not try to decode the pixels
+	var buf bytes.Buffer
+	assert.Nil(t, png.Encode(&buf, dummyImage(1, 1)))
+	header := buf.Bytes()[:33]
+	binary.BigEndian.PutUint32(header[16:20], 100_000)
+	binary.BigEndian.PutUint32(header[20:24], 100_000)
+	binary.BigEndian.PutUint32(header[29:33], crc32.ChecksumIEEE(header[12:29]))
+	sut := infra_media.NewImageThumbnailGenerator()
+
+	half, err := sut.GenerateHalf(video.Resource{Content: header, Name: "cover.png"})
+
+	assert.Nil(t, half)
+	assert.ErrorIs(t, err, video.ErrUnsupportedImage)
+	assert.ErrorContains(t, err, "cover.png is 100000x100000")
+}
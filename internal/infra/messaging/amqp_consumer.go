@@ -0,0 +1,112 @@
+package infra_messaging
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+)
+
+// a message that failed for another reason than being permanently invalid, e.g. the
+// database being unavailable, is requeued once the retry delay has passed, the delay
+// doubling with every consecutive failure up to MaxRetryDelay
+type AMQPConsumer struct {
+	URL           string
+	Queue         string
+	Prefetch      int
+	RetryDelay    time.Duration
+	MaxRetryDelay time.Duration
+	Logger        *slog.Logger
+}
+
+func NewAMQPConsumer(url, queue string, logger *slog.Logger) *AMQPConsumer {
+	return &AMQPConsumer{
+		URL:           url,
+		Queue:         queue,
+		Prefetch:      10,
+		RetryDelay:    time.Second,
+		MaxRetryDelay: time.Minute,
+		Logger:        logger,
+	}
+}
+
+func (c AMQPConsumer) Consume(ctx context.Context, handler MessageHandler) error {
+	conn, err := amqp.Dial(c.URL)
+	if err != nil {
+		return fmt.Errorf("unable to connect to broker: %w", err)
+	}
+	defer conn.Close()
+
+	ch, err := conn.Channel()
+	if err != nil {
+		return fmt.Errorf("unable to open channel: %w", err)
+	}
+	defer ch.Close()
+
+	if _, err = ch.QueueDeclare(c.Queue, true, false, false, false, nil); err != nil {
+		return fmt.Errorf("unable to declare queue %s: %w", c.Queue, err)
+	}
+
+	if err = ch.Qos(c.Prefetch, 0, false); err != nil {
+		return err
+	}
+
+	deliveries, err := ch.ConsumeWithContext(ctx, c.Queue, "", false, false, false, false, nil)
+	if err != nil {
+		return fmt.Errorf("unable to consume queue %s: %w", c.Queue, err)
+	}
+
+	failures := 0
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case delivery, ok := <-deliveries:
+			if !ok {
+				return errors.New("broker closed the delivery channel")
+			}
+
+			err := handler(delivery.Body)
+
+			if err == nil {
+				failures = 0
+				delivery.Ack(false)
+				continue
+			}
+
+			// poison messages are rejected instead of requeued, the broker
+			// dead-letters them when a policy is configured
+			var permanent PermanentError
+			if errors.As(err, &permanent) {
+				c.Logger.Error("failed to handle message", "queue", c.Queue, "error", err.Error())
+				delivery.Nack(false, false)
+				continue
+			}
+
+			failures++
+			delay := c.retryDelay(failures)
+			c.Logger.Warn("failed to handle message, requeueing it", "queue", c.Queue, "error", err.Error(), "retryIn", delay)
+
+			select {
+			case <-ctx.Done():
+				delivery.Nack(false, true)
+				return ctx.Err()
+			case <-time.After(delay):
+				delivery.Nack(false, true)
+			}
+		}
+	}
+}
+
+func (c AMQPConsumer) retryDelay(failures int) time.Duration {
+	delay := c.RetryDelay
+	for i := 1; i < failures && delay < c.MaxRetryDelay; i++ {
+		delay *= 2
+	}
+
+	return min(delay, c.MaxRetryDelay)
+}
@@ -0,0 +1,127 @@
+package infra_messaging
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+)
+
+// bounds dialing when the context carries no deadline, as amqp.Dial does
+const defaultDialTimeout = 30 * time.Second
+
+// the connection and its channel are opened on the first message and reopened
+// once the broker closes them, so a message does not pay for a new handshake
+type AMQPPublisher struct {
+	URL   string
+	Queue string
+
+	mu   sync.Mutex
+	conn *amqp.Connection
+	ch   *amqp.Channel
+}
+
+func NewAMQPPublisher(url, queue string) *AMQPPublisher {
+	return &AMQPPublisher{URL: url, Queue: queue}
+}
+
+func (p *AMQPPublisher) Publish(ctx context.Context, body []byte) error {
+	ch, err := p.channel(ctx)
+	if err != nil {
+		return err
+	}
+
+	confirmation, err := ch.PublishWithDeferredConfirmWithContext(ctx, "", p.Queue, false, false, amqp.Publishing{
+		ContentType:  "application/json",
+		DeliveryMode: amqp.Persistent,
+		Body:         body,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to publish to queue %s: %w", p.Queue, err)
+	}
+
+	acked, err := confirmation.WaitContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !acked {
+		return fmt.Errorf("broker did not confirm the message on queue %s", p.Queue)
+	}
+
+	return nil
+}
+
+func (p *AMQPPublisher) Close() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.conn == nil || p.conn.IsClosed() {
+		return nil
+	}
+
+	err := p.conn.Close()
+	p.conn, p.ch = nil, nil
+
+	return err
+}
+
+func (p *AMQPPublisher) channel(ctx context.Context) (*amqp.Channel, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.ch != nil && !p.ch.IsClosed() {
+		return p.ch, nil
+	}
+
+	if p.conn == nil || p.conn.IsClosed() {
+		timeout, err := dialTimeout(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("unable to connect to broker: %w", err)
+		}
+
+		conn, err := amqp.DialConfig(p.URL, amqp.Config{
+			Heartbeat: 10 * time.Second,
+			Locale:    "en_US",
+			Dial:      amqp.DefaultDial(timeout),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("unable to connect to broker: %w", err)
+		}
+		p.conn = conn
+	}
+
+	ch, err := p.conn.Channel()
+	if err != nil {
+		return nil, fmt.Errorf("unable to open channel: %w", err)
+	}
+
+	if _, err = ch.QueueDeclare(p.Queue, true, false, false, false, nil); err != nil {
+		ch.Close()
+		return nil, fmt.Errorf("unable to declare queue %s: %w", p.Queue, err)
+	}
+
+	if err = ch.Confirm(false); err != nil {
+		ch.Close()
+		return nil, err
+	}
+
+	p.ch = ch
+
+	return ch, nil
+}
+
+func dialTimeout(ctx context.Context) (time.Duration, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return defaultDialTimeout, nil
+	}
+
+	return min(time.Until(deadline), defaultDialTimeout), nil
+}
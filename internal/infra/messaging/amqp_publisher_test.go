@@ -0,0 +1,40 @@
+package infra_messaging_test
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	infra_messaging "github.com.br/gibranct/admin_do_catalogo/internal/infra/messaging"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAMQPPublisherDialIsBoundedByTheContext(t *testing.T) {
+	// a broker that accepts the connection but never answers the handshake
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %s", err)
+	}
+	defer listener.Close()
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			defer conn.Close()
+		}
+	}()
+
+	sut := infra_messaging.NewAMQPPublisher("amqp://guest:guest@"+listener.Addr().String()+"/", "video.encode")
+	defer sut.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	err = sut.Publish(ctx, []byte("{}"))
+
+	assert.ErrorContains(t, err, "unable to connect to broker")
+	assert.Less(t, time.Since(start), 2*time.Second)
+}
@@ -0,0 +1,32 @@
+package infra_messaging
+
+import (
+	"context"
+	"log/slog"
+)
+
+type ChannelConsumer struct {
+	Messages <-chan []byte
+	Logger   *slog.Logger
+}
+
+func NewChannelConsumer(messages <-chan []byte, logger *slog.Logger) *ChannelConsumer {
+	return &ChannelConsumer{Messages: messages, Logger: logger}
+}
+
+func (c ChannelConsumer) Consume(ctx context.Context, handler MessageHandler) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case body, ok := <-c.Messages:
+			if !ok {
+				return nil
+			}
+
+			if err := handler(body); err != nil {
+				c.Logger.Error("failed to handle message", "error", err.Error())
+			}
+		}
+	}
+}
@@ -0,0 +1,46 @@
+package infra_messaging_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	infra_messaging "github.com.br/gibranct/admin_do_catalogo/internal/infra/messaging"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChannelConsumer(t *testing.T) {
+	messages := make(chan []byte, 3)
+	sut := infra_messaging.NewChannelConsumer(messages, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	received := []string{}
+
+	messages <- []byte("first")
+	messages <- []byte("broken")
+	messages <- []byte("second")
+	close(messages)
+
+	err := sut.Consume(context.Background(), func(body []byte) error {
+		received = append(received, string(body))
+		if string(body) == "broken" {
+			return errors.New("cannot handle message")
+		}
+		return nil
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"first", "broken", "second"}, received)
+}
+
+func TestChannelConsumerStopsWhenContextIsDone(t *testing.T) {
+	messages := make(chan []byte)
+	sut := infra_messaging.NewChannelConsumer(messages, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	err := sut.Consume(ctx, func(body []byte) error { return nil })
+
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
@@ -0,0 +1,22 @@
+package infra_messaging
+
+import (
+	"context"
+)
+
+type ChannelPublisher struct {
+	Messages chan<- []byte
+}
+
+func NewChannelPublisher(messages chan<- []byte) *ChannelPublisher {
+	return &ChannelPublisher{Messages: messages}
+}
+
+func (p ChannelPublisher) Publish(ctx context.Context, body []byte) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case p.Messages <- body:
+		return nil
+	}
+}
@@ -0,0 +1,29 @@
+package infra_messaging
+
+import (
+	"context"
+)
+
+type MessageHandler func(body []byte) error
+
+type Consumer interface {
+	Consume(ctx context.Context, handler MessageHandler) error
+}
+
+// a message that fails the same way however often it is delivered, consumers
+// drop it instead of redelivering it
+type PermanentError struct {
+	Err error
+}
+
+func (e PermanentError) Error() string {
+	return e.Err.Error()
+}
+
+func (e PermanentError) Unwrap() error {
+	return e.Err
+}
+
+func Permanent(err error) error {
+	return PermanentError{Err: err}
+}
@@ -0,0 +1,22 @@
+package infra_messaging_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	infra_messaging "github.com.br/gibranct/admin_do_catalogo/internal/infra/messaging"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPermanentError(t *testing.T) {
+	cause := errors.New("invalid encoder result")
+	var permanent infra_messaging.PermanentError
+
+	err := fmt.Errorf("could not handle message: %w", infra_messaging.Permanent(cause))
+
+	assert.ErrorAs(t, err, &permanent)
+	assert.ErrorIs(t, err, cause)
+	assert.EqualError(t, err, "could not handle message: invalid encoder result")
+	assert.False(t, errors.As(cause, &permanent))
+}
@@ -0,0 +1,9 @@
+package infra_messaging
+
+import (
+	"context"
+)
+
+type Publisher interface {
+	Publish(ctx context.Context, body []byte) error
+}
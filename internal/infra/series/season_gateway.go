@@ -0,0 +1,292 @@
+package infra_series
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/series"
+)
+
+type SeasonGateway struct {
+	Db *sql.DB
+}
+
+func NewSeasonGateway(db *sql.DB) *SeasonGateway {
+	return &SeasonGateway{Db: db}
+}
+
+func (sg SeasonGateway) Create(aSeason *series.Season) error {
+	tx, err := sg.Db.Begin()
+
+	if err != nil {
+		return fmt.Errorf("unable to create transaction: %s", err.Error())
+	}
+
+	defer tx.Rollback()
+
+	query := `
+		INSERT INTO seasons (series_id, number, title, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id
+	`
+
+	err = tx.QueryRow(
+		query, aSeason.SeriesId, aSeason.Number, aSeason.Title, aSeason.CreatedAt, aSeason.UpdatedAt,
+	).Scan(&aSeason.ID)
+	if err != nil {
+		return err
+	}
+
+	if err = saveEpisodes(tx, aSeason.ID, aSeason.Episodes); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (sg SeasonGateway) FindById(seasonId int64) (*series.Season, error) {
+	query := `
+		SELECT id, series_id, number, title, created_at, updated_at
+		FROM seasons
+		WHERE id = $1
+	`
+
+	aSeason, err := scanSeason(sg.Db.QueryRow(query, seasonId))
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, series.ErrSeasonNotFound
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if aSeason.Episodes, err = sg.findEpisodes(aSeason.ID); err != nil {
+		return nil, err
+	}
+
+	return aSeason, nil
+}
+
+func (sg SeasonGateway) FindBySeriesId(seriesId int64) ([]*series.Season, error) {
+	query := `
+		SELECT id, series_id, number, title, created_at, updated_at
+		FROM seasons
+		WHERE series_id = $1
+		ORDER BY number
+	`
+
+	rows, err := sg.Db.Query(query, seriesId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	seasons := []*series.Season{}
+
+	for rows.Next() {
+		aSeason, err := scanSeason(rows)
+		if err != nil {
+			return nil, err
+		}
+		seasons = append(seasons, aSeason)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	for _, aSeason := range seasons {
+		if aSeason.Episodes, err = sg.findEpisodes(aSeason.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	return seasons, nil
+}
+
+func (sg SeasonGateway) Update(aSeason series.Season) error {
+	tx, err := sg.Db.Begin()
+
+	if err != nil {
+		return fmt.Errorf("unable to create transaction: %s", err.Error())
+	}
+
+	defer tx.Rollback()
+
+	result, err := tx.Exec(
+		"UPDATE seasons SET number = $1, title = $2, updated_at = $3 WHERE id = $4",
+		aSeason.Number, aSeason.Title, aSeason.UpdatedAt, aSeason.ID,
+	)
+	if err != nil {
+		return err
+	}
+
+	if affected, err := result.RowsAffected(); err != nil {
+		return err
+	} else if affected == 0 {
+		return series.ErrSeasonNotFound
+	}
+
+	if _, err = tx.Exec("DELETE FROM seasons_episodes WHERE season_id = $1", aSeason.ID); err != nil {
+		return err
+	}
+
+	if err = saveEpisodes(tx, aSeason.ID, aSeason.Episodes); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (sg SeasonGateway) DeleteById(seasonId int64) error {
+	_, err := sg.Db.Exec("DELETE FROM seasons WHERE id = $1", seasonId)
+
+	return err
+}
+
+func (sg SeasonGateway) FindEpisodeSeasons(videoIds []int64) (map[int64]int64, error) {
+	seasons := map[int64]int64{}
+	if len(videoIds) == 0 {
+		return seasons, nil
+	}
+
+	var stringIds []string
+	for _, id := range videoIds {
+		stringIds = append(stringIds, strconv.FormatInt(id, 10))
+	}
+
+	rows, err := sg.Db.Query(fmt.Sprintf(
+		"SELECT video_id, season_id FROM seasons_episodes WHERE video_id IN (%s)",
+		strings.Join(stringIds, ","),
+	))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var videoId, seasonId int64
+		if err = rows.Scan(&videoId, &seasonId); err != nil {
+			return nil, err
+		}
+		seasons[videoId] = seasonId
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return seasons, nil
+}
+
+func (sg SeasonGateway) findEpisodes(seasonId int64) ([]series.Episode, error) {
+	rows, err := sg.Db.Query(
+		"SELECT video_id, number, title FROM seasons_episodes WHERE season_id = $1 ORDER BY number",
+		seasonId,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	episodes := []series.Episode{}
+
+	for rows.Next() {
+		var episode series.Episode
+		if err = rows.Scan(&episode.VideoId, &episode.Number, &episode.Title); err != nil {
+			return nil, err
+		}
+		episodes = append(episodes, episode)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	categoryIds, err := sg.findOverrides("videos_categories", "category_id", seasonId)
+	if err != nil {
+		return nil, err
+	}
+
+	genreIds, err := sg.findOverrides("videos_genres", "genre_id", seasonId)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range episodes {
+		episodes[i].CategoryIds = categoryIds[episodes[i].VideoId]
+		episodes[i].GenreIds = genreIds[episodes[i].VideoId]
+	}
+
+	return episodes, nil
+}
+
+func (sg SeasonGateway) findOverrides(table, column string, seasonId int64) (map[int64][]int64, error) {
+	rows, err := sg.Db.Query(fmt.Sprintf(`
+		SELECT o.video_id, o.%s FROM %s o
+		JOIN seasons_episodes se ON se.video_id = o.video_id
+		WHERE se.season_id = $1
+		ORDER BY o.%s`, column, table, column),
+		seasonId,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	overrides := map[int64][]int64{}
+
+	for rows.Next() {
+		var videoId, id int64
+		if err = rows.Scan(&videoId, &id); err != nil {
+			return nil, err
+		}
+		overrides[videoId] = append(overrides[videoId], id)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return overrides, nil
+}
+
+func saveEpisodes(tx *sql.Tx, seasonId int64, episodes []series.Episode) error {
+	query := `
+		INSERT INTO seasons_episodes (video_id, season_id, number, title) VALUES ($1, $2, $3, $4)
+	`
+
+	for _, episode := range episodes {
+		if _, err := tx.Exec(query, episode.VideoId, seasonId, episode.Number, episode.Title); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanSeason(row rowScanner) (*series.Season, error) {
+	var aSeason series.Season
+
+	err := row.Scan(
+		&aSeason.ID,
+		&aSeason.SeriesId,
+		&aSeason.Number,
+		&aSeason.Title,
+		&aSeason.CreatedAt,
+		&aSeason.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &aSeason, nil
+}
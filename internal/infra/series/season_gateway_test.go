@@ -0,0 +1,87 @@
+package infra_series_test
+
+import (
+	"log"
+	"testing"
+
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/series"
+	infra_series "github.com.br/gibranct/admin_do_catalogo/internal/infra/series"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindSeasonById(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		log.Fatalf("Failed to create DB connection: %s", err)
+	}
+	defer db.Close()
+
+	sg := infra_series.NewSeasonGateway(db)
+	aSeason := series.NewSeason(3, 1, "Season 1")
+
+	mock.ExpectQuery("SELECT id, series_id, number, title, created_at, updated_at FROM seasons").WithArgs(int64(4)).
+		WillReturnRows(sqlmock.NewRows(make([]string, 6)).
+			AddRow(4, 3, 1, aSeason.Title, aSeason.CreatedAt, aSeason.UpdatedAt))
+	mock.ExpectQuery("SELECT video_id, number, title FROM seasons_episodes").WithArgs(int64(4)).
+		WillReturnRows(sqlmock.NewRows(make([]string, 3)).AddRow(10, 1, "Secrets").AddRow(11, 2, "Lies"))
+	mock.ExpectQuery("FROM videos_categories").WithArgs(int64(4)).
+		WillReturnRows(sqlmock.NewRows(make([]string, 2)).AddRow(11, 5).AddRow(11, 6))
+	mock.ExpectQuery("FROM videos_genres").WithArgs(int64(4)).
+		WillReturnRows(sqlmock.NewRows(make([]string, 2)))
+
+	found, err := sg.FindById(4)
+
+	assert.Nil(t, err)
+	assert.Equal(t, int64(3), found.SeriesId)
+	assert.Equal(t, []series.Episode{
+		{VideoId: 10, Number: 1, Title: "Secrets"},
+		{VideoId: 11, Number: 2, Title: "Lies", CategoryIds: []int64{5, 6}},
+	}, found.Episodes)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdateSeasonReplacesEpisodes(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		log.Fatalf("Failed to create DB connection: %s", err)
+	}
+	defer db.Close()
+
+	sg := infra_series.NewSeasonGateway(db)
+	aSeason := series.NewSeason(3, 2, "Season 2")
+	aSeason.ID = 4
+	aSeason.ReplaceEpisodes([]series.Episode{series.NewEpisode(10, 1, "Secrets")})
+
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE seasons SET").WithArgs(2, "Season 2", aSeason.UpdatedAt, int64(4)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("DELETE FROM seasons_episodes").WithArgs(int64(4)).WillReturnResult(sqlmock.NewResult(0, 3))
+	mock.ExpectExec("INSERT INTO seasons_episodes").WithArgs(int64(10), int64(4), 1, "Secrets").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	err = sg.Update(*aSeason)
+
+	assert.Nil(t, err)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestFindEpisodeSeasons(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		log.Fatalf("Failed to create DB connection: %s", err)
+	}
+	defer db.Close()
+
+	sg := infra_series.NewSeasonGateway(db)
+
+	mock.ExpectQuery(`SELECT video_id, season_id FROM seasons_episodes WHERE video_id IN \(10,11\)`).
+		WillReturnRows(sqlmock.NewRows(make([]string, 2)).AddRow(10, 4))
+
+	seasons, err := sg.FindEpisodeSeasons([]int64{10, 11})
+
+	assert.Nil(t, err)
+	assert.Equal(t, map[int64]int64{10: 4}, seasons)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
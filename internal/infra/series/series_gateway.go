@@ -0,0 +1,246 @@
+package infra_series
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain"
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/series"
+)
+
+type SeriesGateway struct {
+	Db *sql.DB
+}
+
+func NewSeriesGateway(db *sql.DB) *SeriesGateway {
+	return &SeriesGateway{Db: db}
+}
+
+func (sg SeriesGateway) Create(aSeries *series.Series) error {
+	tx, err := sg.Db.Begin()
+
+	if err != nil {
+		return fmt.Errorf("unable to create transaction: %s", err.Error())
+	}
+
+	defer tx.Rollback()
+
+	query := `
+		INSERT INTO series (title, description, created_at, updated_at)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id
+	`
+
+	err = tx.QueryRow(query, aSeries.Title, aSeries.Description, aSeries.CreatedAt, aSeries.UpdatedAt).Scan(&aSeries.ID)
+	if err != nil {
+		return err
+	}
+
+	if err = saveIds(tx, "series_categories", "series_id", "category_id", aSeries.ID, aSeries.CategoryIds); err != nil {
+		return err
+	}
+
+	if err = saveIds(tx, "series_genres", "series_id", "genre_id", aSeries.ID, aSeries.GenreIds); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (sg SeriesGateway) FindById(seriesId int64) (*series.Series, error) {
+	query := `
+		SELECT id, title, description, created_at, updated_at
+		FROM series
+		WHERE id = $1
+	`
+
+	var aSeries series.Series
+
+	err := sg.Db.QueryRow(query, seriesId).Scan(
+		&aSeries.ID,
+		&aSeries.Title,
+		&aSeries.Description,
+		&aSeries.CreatedAt,
+		&aSeries.UpdatedAt,
+	)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, series.ErrSeriesNotFound
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	aSeries.CategoryIds, err = findIds(sg.Db, "SELECT category_id FROM series_categories WHERE series_id = $1 ORDER BY category_id", seriesId)
+	if err != nil {
+		return nil, err
+	}
+
+	aSeries.GenreIds, err = findIds(sg.Db, "SELECT genre_id FROM series_genres WHERE series_id = $1 ORDER BY genre_id", seriesId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &aSeries, nil
+}
+
+func (sg SeriesGateway) FindByEpisode(videoId int64) (*series.Series, error) {
+	query := `
+		SELECT s.series_id
+		FROM seasons_episodes se
+		JOIN seasons s ON s.id = se.season_id
+		WHERE se.video_id = $1
+	`
+
+	var seriesId int64
+
+	err := sg.Db.QueryRow(query, videoId).Scan(&seriesId)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, series.ErrSeriesNotFound
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return sg.FindById(seriesId)
+}
+
+func (sg SeriesGateway) Update(aSeries series.Series) error {
+	tx, err := sg.Db.Begin()
+
+	if err != nil {
+		return fmt.Errorf("unable to create transaction: %s", err.Error())
+	}
+
+	defer tx.Rollback()
+
+	result, err := tx.Exec(
+		"UPDATE series SET title = $1, description = $2, updated_at = $3 WHERE id = $4",
+		aSeries.Title, aSeries.Description, aSeries.UpdatedAt, aSeries.ID,
+	)
+	if err != nil {
+		return err
+	}
+
+	if affected, err := result.RowsAffected(); err != nil {
+		return err
+	} else if affected == 0 {
+		return series.ErrSeriesNotFound
+	}
+
+	if err = replaceIds(tx, "series_categories", "series_id", "category_id", aSeries.ID, aSeries.CategoryIds); err != nil {
+		return err
+	}
+
+	if err = replaceIds(tx, "series_genres", "series_id", "genre_id", aSeries.ID, aSeries.GenreIds); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (sg SeriesGateway) DeleteById(seriesId int64) error {
+	_, err := sg.Db.Exec("DELETE FROM series WHERE id = $1", seriesId)
+
+	return err
+}
+
+func (sg SeriesGateway) FindAll(query domain.SearchQuery) (*domain.Pagination[series.Series], error) {
+	sql := fmt.Sprintf(`
+		SELECT COUNT(*) OVER(), s.id, s.title, s.description, s.created_at, s.updated_at
+		FROM series s
+		WHERE s.title ILIKE $1 OR s.description ILIKE $1
+		ORDER BY s.%s %s, s.id
+		LIMIT $2 OFFSET $3`,
+		query.SortColumn(), query.SortDirection())
+
+	rows, err := sg.Db.Query(sql, "%"+query.Term+"%", query.Limit(), query.Offset())
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+	items := []*series.Series{}
+	totalRecords := 0
+
+	for rows.Next() {
+		var s series.Series
+		err := rows.Scan(
+			&totalRecords,
+			&s.ID,
+			&s.Title,
+			&s.Description,
+			&s.CreatedAt,
+			&s.UpdatedAt,
+		)
+
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, &s)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	lastPage := math.Ceil(float64(totalRecords) / float64(query.PerPage))
+	return &domain.Pagination[series.Series]{
+		Items:       items,
+		PerPage:     query.PerPage,
+		CurrentPage: query.Page,
+		Total:       totalRecords,
+		IsLast:      lastPage == float64(query.Page),
+	}, nil
+}
+
+func saveIds(tx *sql.Tx, table, ownerColumn, column string, ownerId int64, ids []int64) error {
+	query := fmt.Sprintf("INSERT INTO %s (%s, %s) VALUES ($1, $2)", table, ownerColumn, column)
+
+	for _, id := range ids {
+		if _, err := tx.Exec(query, ownerId, id); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func replaceIds(tx *sql.Tx, table, ownerColumn, column string, ownerId int64, ids []int64) error {
+	if _, err := tx.Exec(fmt.Sprintf("DELETE FROM %s WHERE %s = $1", table, ownerColumn), ownerId); err != nil {
+		return err
+	}
+
+	return saveIds(tx, table, ownerColumn, column, ownerId, ids)
+}
+
+func findIds(db *sql.DB, query string, arg any) ([]int64, error) {
+	rows, err := db.Query(query, arg)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	ids := []int64{}
+
+	for rows.Next() {
+		var id int64
+		if err = rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
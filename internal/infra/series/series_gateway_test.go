@@ -0,0 +1,157 @@
+package infra_series_test
+
+import (
+	"log"
+	"testing"
+
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain"
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/series"
+	infra_series "github.com.br/gibranct/admin_do_catalogo/internal/infra/series"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateSeries(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		log.Fatalf("Failed to create DB connection: %s", err)
+	}
+	defer db.Close()
+
+	sg := infra_series.NewSeriesGateway(db)
+	aSeries := series.NewSeries("Dark", "time travel", []int64{1}, []int64{2, 3})
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("INSERT INTO series").
+		WithArgs(aSeries.Title, aSeries.Description, aSeries.CreatedAt, aSeries.UpdatedAt).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(7))
+	mock.ExpectExec("INSERT INTO series_categories").WithArgs(int64(7), int64(1)).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("INSERT INTO series_genres").WithArgs(int64(7), int64(2)).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("INSERT INTO series_genres").WithArgs(int64(7), int64(3)).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	err = sg.Create(aSeries)
+
+	assert.Nil(t, err)
+	assert.Equal(t, int64(7), aSeries.ID)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestFindSeriesById(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		log.Fatalf("Failed to create DB connection: %s", err)
+	}
+	defer db.Close()
+
+	sg := infra_series.NewSeriesGateway(db)
+	aSeries := series.NewSeries("Dark", "time travel", nil, nil)
+
+	mock.ExpectQuery("SELECT id, title, description, created_at, updated_at FROM series").WithArgs(int64(7)).
+		WillReturnRows(sqlmock.NewRows(make([]string, 5)).
+			AddRow(7, aSeries.Title, aSeries.Description, aSeries.CreatedAt, aSeries.UpdatedAt))
+	mock.ExpectQuery("SELECT category_id FROM series_categories").WithArgs(int64(7)).
+		WillReturnRows(sqlmock.NewRows([]string{"category_id"}).AddRow(1))
+	mock.ExpectQuery("SELECT genre_id FROM series_genres").WithArgs(int64(7)).
+		WillReturnRows(sqlmock.NewRows([]string{"genre_id"}))
+
+	found, err := sg.FindById(7)
+
+	assert.Nil(t, err)
+	assert.Equal(t, aSeries.Title, found.Title)
+	assert.Equal(t, []int64{1}, found.CategoryIds)
+	assert.Equal(t, []int64{}, found.GenreIds)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestFindSeriesByEpisode(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		log.Fatalf("Failed to create DB connection: %s", err)
+	}
+	defer db.Close()
+
+	sg := infra_series.NewSeriesGateway(db)
+	aSeries := series.NewSeries("Dark", "time travel", nil, nil)
+
+	mock.ExpectQuery("SELECT s.series_id FROM seasons_episodes").WithArgs(int64(10)).
+		WillReturnRows(sqlmock.NewRows([]string{"series_id"}).AddRow(7))
+	mock.ExpectQuery("SELECT id, title, description, created_at, updated_at FROM series").WithArgs(int64(7)).
+		WillReturnRows(sqlmock.NewRows(make([]string, 5)).
+			AddRow(7, aSeries.Title, aSeries.Description, aSeries.CreatedAt, aSeries.UpdatedAt))
+	mock.ExpectQuery("SELECT category_id FROM series_categories").WithArgs(int64(7)).
+		WillReturnRows(sqlmock.NewRows([]string{"category_id"}).AddRow(1))
+	mock.ExpectQuery("SELECT genre_id FROM series_genres").WithArgs(int64(7)).
+		WillReturnRows(sqlmock.NewRows([]string{"genre_id"}))
+
+	found, err := sg.FindByEpisode(10)
+
+	assert.Nil(t, err)
+	assert.Equal(t, int64(7), found.ID)
+	assert.Equal(t, []int64{1}, found.CategoryIds)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestFindSeriesByEpisodeWhenVideoIsNotAnEpisode(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		log.Fatalf("Failed to create DB connection: %s", err)
+	}
+	defer db.Close()
+
+	sg := infra_series.NewSeriesGateway(db)
+
+	mock.ExpectQuery("SELECT s.series_id FROM seasons_episodes").WithArgs(int64(10)).
+		WillReturnRows(sqlmock.NewRows([]string{"series_id"}))
+
+	found, err := sg.FindByEpisode(10)
+
+	assert.Nil(t, found)
+	assert.ErrorIs(t, err, series.ErrSeriesNotFound)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdateSeriesWhenItIsNotFound(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		log.Fatalf("Failed to create DB connection: %s", err)
+	}
+	defer db.Close()
+
+	sg := infra_series.NewSeriesGateway(db)
+	aSeries := series.NewSeries("Dark", "time travel", nil, nil)
+	aSeries.ID = 7
+
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE series SET").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectRollback()
+
+	err = sg.Update(*aSeries)
+
+	assert.Equal(t, series.ErrSeriesNotFound, err)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestFindAllSeries(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		log.Fatalf("Failed to create DB connection: %s", err)
+	}
+	defer db.Close()
+
+	sg := infra_series.NewSeriesGateway(db)
+	aSeries := series.NewSeries("Dark", "time travel", nil, nil)
+	query := domain.SearchQuery{Page: 1, PerPage: 10, Term: "dark", Sort: "title"}
+
+	mock.ExpectQuery(`ORDER BY s.title ASC, s.id\s+LIMIT \$2 OFFSET \$3`).WithArgs("%dark%", 10, 0).
+		WillReturnRows(sqlmock.NewRows(make([]string, 6)).
+			AddRow(1, 7, aSeries.Title, aSeries.Description, aSeries.CreatedAt, aSeries.UpdatedAt))
+
+	page, err := sg.FindAll(query)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 1, page.Total)
+	assert.True(t, page.IsLast)
+	assert.Equal(t, int64(7), page.Items[0].ID)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,112 @@
+package infra_upload
+
+import (
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/upload"
+)
+
+type LocalChunkStorage struct {
+	RootDir string
+	mu      sync.Mutex
+	locks   map[string]*sync.Mutex
+}
+
+func NewLocalChunkStorage(rootDir string) *LocalChunkStorage {
+	return &LocalChunkStorage{
+		RootDir: rootDir,
+		locks:   map[string]*sync.Mutex{},
+	}
+}
+
+func (s *LocalChunkStorage) Append(uploadId string, offset int64, chunk io.Reader) (int64, error) {
+	lock := s.lockFor(uploadId)
+	lock.Lock()
+	defer lock.Unlock()
+
+	if err := os.MkdirAll(s.RootDir, 0o755); err != nil {
+		return 0, err
+	}
+
+	file, err := os.OpenFile(s.pathOf(uploadId), os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return 0, err
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return 0, err
+	}
+
+	if info.Size() < offset {
+		return 0, upload.ErrOffsetMismatch
+	}
+
+	// the persisted offset is the source of truth, so bytes written by an
+	// interrupted request that never got acknowledged are discarded
+	if err = file.Truncate(offset); err != nil {
+		return 0, err
+	}
+
+	if _, err = file.Seek(offset, io.SeekStart); err != nil {
+		return 0, err
+	}
+
+	written, err := io.Copy(file, chunk)
+	if err != nil {
+		return written, err
+	}
+
+	return written, file.Sync()
+}
+
+func (s *LocalChunkStorage) Open(uploadId string) (io.ReadCloser, error) {
+	file, err := os.Open(s.pathOf(uploadId))
+
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, upload.ErrUploadNotFound
+	}
+
+	return file, err
+}
+
+func (s *LocalChunkStorage) Remove(uploadId string) error {
+	lock := s.lockFor(uploadId)
+	lock.Lock()
+	defer lock.Unlock()
+
+	err := os.Remove(s.pathOf(uploadId))
+
+	s.mu.Lock()
+	delete(s.locks, uploadId)
+	s.mu.Unlock()
+
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+
+	return err
+}
+
+func (s *LocalChunkStorage) lockFor(uploadId string) *sync.Mutex {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	lock, ok := s.locks[uploadId]
+	if !ok {
+		lock = &sync.Mutex{}
+		s.locks[uploadId] = lock
+	}
+
+	return lock
+}
+
+func (s *LocalChunkStorage) pathOf(uploadId string) string {
+	return filepath.Join(s.RootDir, filepath.Base(uploadId)+".part")
+}
@@ -0,0 +1,66 @@
+package infra_upload_test
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/upload"
+	infra_upload "github.com.br/gibranct/admin_do_catalogo/internal/infra/upload"
+	"github.com/stretchr/testify/assert"
+)
+
+func readAll(t *testing.T, sut *infra_upload.LocalChunkStorage, uploadId string) string {
+	reader, err := sut.Open(uploadId)
+	assert.Nil(t, err)
+	defer reader.Close()
+
+	content, err := io.ReadAll(reader)
+	assert.Nil(t, err)
+
+	return string(content)
+}
+
+func TestAppendChunks(t *testing.T) {
+	sut := infra_upload.NewLocalChunkStorage(t.TempDir())
+
+	written, err := sut.Append("abc", 0, strings.NewReader("hello "))
+	assert.Nil(t, err)
+	assert.Equal(t, int64(6), written)
+
+	written, err = sut.Append("abc", 6, strings.NewReader("world"))
+	assert.Nil(t, err)
+	assert.Equal(t, int64(5), written)
+
+	assert.Equal(t, "hello world", readAll(t, sut, "abc"))
+}
+
+func TestAppendDiscardsUnacknowledgedBytes(t *testing.T) {
+	sut := infra_upload.NewLocalChunkStorage(t.TempDir())
+
+	sut.Append("abc", 0, strings.NewReader("hello wor"))
+
+	_, err := sut.Append("abc", 6, strings.NewReader("world"))
+
+	assert.Nil(t, err)
+	assert.Equal(t, "hello world", readAll(t, sut, "abc"))
+}
+
+func TestAppendBeyondStoredBytes(t *testing.T) {
+	sut := infra_upload.NewLocalChunkStorage(t.TempDir())
+
+	_, err := sut.Append("abc", 3, strings.NewReader("world"))
+
+	assert.ErrorIs(t, err, upload.ErrOffsetMismatch)
+}
+
+func TestRemoveChunks(t *testing.T) {
+	sut := infra_upload.NewLocalChunkStorage(t.TempDir())
+	sut.Append("abc", 0, strings.NewReader("hello"))
+
+	assert.Nil(t, sut.Remove("abc"))
+	assert.Nil(t, sut.Remove("abc"))
+
+	_, err := sut.Open("abc")
+	assert.ErrorIs(t, err, upload.ErrUploadNotFound)
+}
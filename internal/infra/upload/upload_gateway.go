@@ -0,0 +1,121 @@
+package infra_upload
+
+import (
+	"database/sql"
+	"errors"
+
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/upload"
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/video"
+)
+
+type UploadGateway struct {
+	Db *sql.DB
+}
+
+func NewUploadGateway(db *sql.DB) *UploadGateway {
+	return &UploadGateway{Db: db}
+}
+
+func (ug UploadGateway) Create(u *upload.Upload) error {
+	query := `
+		INSERT INTO videos_uploads (id, video_id, media_type, upload_length, upload_offset, file_name, checksum, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	`
+	args := []any{u.ID, u.VideoId, u.MediaType.String(), u.Length, u.Offset, u.FileName, u.Checksum, u.CreatedAt, u.UpdatedAt}
+
+	_, err := ug.Db.Exec(query, args...)
+
+	return err
+}
+
+func (ug UploadGateway) FindById(uploadId string) (*upload.Upload, error) {
+	query := `
+		SELECT id, video_id, media_type, upload_length, upload_offset, file_name, checksum, created_at, updated_at,
+		finalized_at
+		FROM videos_uploads
+		WHERE id = $1
+	`
+
+	u := upload.Upload{}
+	var mediaType string
+
+	err := ug.Db.QueryRow(query, uploadId).Scan(
+		&u.ID,
+		&u.VideoId,
+		&mediaType,
+		&u.Length,
+		&u.Offset,
+		&u.FileName,
+		&u.Checksum,
+		&u.CreatedAt,
+		&u.UpdatedAt,
+		&u.FinalizedAt,
+	)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, upload.ErrUploadNotFound
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	u.MediaType, err = video.GetVideoType(mediaType)
+	if err != nil {
+		return nil, err
+	}
+
+	return &u, nil
+}
+
+func (ug UploadGateway) UpdateOffset(u upload.Upload, previousOffset int64) error {
+	query := `
+		UPDATE videos_uploads SET upload_offset = $1, updated_at = $2
+		WHERE id = $3 AND upload_offset = $4
+	`
+
+	result, err := ug.Db.Exec(query, u.Offset, u.UpdatedAt, u.ID, previousOffset)
+	if err != nil {
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return upload.ErrOffsetMismatch
+	}
+
+	return nil
+}
+
+func (ug UploadGateway) Finalize(u upload.Upload) error {
+	query := `
+		UPDATE videos_uploads SET finalized_at = $1, updated_at = $2
+		WHERE id = $3
+	`
+
+	result, err := ug.Db.Exec(query, u.FinalizedAt, u.UpdatedAt, u.ID)
+	if err != nil {
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return upload.ErrUploadNotFound
+	}
+
+	return nil
+}
+
+func (ug UploadGateway) DeleteById(uploadId string) error {
+	_, err := ug.Db.Exec("DELETE FROM videos_uploads WHERE id = $1", uploadId)
+
+	return err
+}
@@ -0,0 +1,149 @@
+package infra_upload_test
+
+import (
+	"errors"
+	"log"
+	"testing"
+
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/upload"
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/video"
+	infra_upload "github.com.br/gibranct/admin_do_catalogo/internal/infra/upload"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateUpload(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		log.Fatalf("Failed to create DB connection: %s", err)
+	}
+	defer db.Close()
+
+	ug := infra_upload.NewUploadGateway(db)
+	u := upload.NewUpload(10, video.VIDEO, 100, "movie.mp4", "sum")
+
+	mock.ExpectExec("INSERT INTO videos_uploads").WithArgs(
+		u.ID, u.VideoId, "Video", u.Length, u.Offset, u.FileName, u.Checksum, u.CreatedAt, u.UpdatedAt,
+	).WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err = ug.Create(u)
+
+	assert.Nil(t, err)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestFindUploadById(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		log.Fatalf("Failed to create DB connection: %s", err)
+	}
+	defer db.Close()
+
+	ug := infra_upload.NewUploadGateway(db)
+	expected := upload.NewUpload(10, video.TRAILER, 100, "trailer.mp4", "sum")
+	expected.Offset = 40
+
+	rows := sqlmock.NewRows([]string{
+		"id", "video_id", "media_type", "upload_length", "upload_offset", "file_name", "checksum", "created_at", "updated_at",
+		"finalized_at",
+	}).AddRow(
+		expected.ID, expected.VideoId, "Trailer", expected.Length, expected.Offset,
+		expected.FileName, expected.Checksum, expected.CreatedAt, expected.UpdatedAt, nil,
+	)
+	mock.ExpectQuery("SELECT (.+) FROM videos_uploads").WithArgs(expected.ID).WillReturnRows(rows)
+
+	u, err := ug.FindById(expected.ID)
+
+	assert.Nil(t, err)
+	assert.Equal(t, expected, u)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestFindUploadByIdNotFound(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		log.Fatalf("Failed to create DB connection: %s", err)
+	}
+	defer db.Close()
+
+	ug := infra_upload.NewUploadGateway(db)
+
+	mock.ExpectQuery("SELECT (.+) FROM videos_uploads").WithArgs("missing").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	u, err := ug.FindById("missing")
+
+	assert.Nil(t, u)
+	assert.ErrorIs(t, err, upload.ErrUploadNotFound)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdateUploadOffset(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		log.Fatalf("Failed to create DB connection: %s", err)
+	}
+	defer db.Close()
+
+	ug := infra_upload.NewUploadGateway(db)
+	u := upload.NewUpload(10, video.VIDEO, 100, "movie.mp4", "")
+	u.Advance(30)
+
+	mock.ExpectExec("UPDATE videos_uploads").WithArgs(int64(30), u.UpdatedAt, u.ID, int64(0)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	assert.Nil(t, ug.UpdateOffset(*u, 0))
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdateUploadOffsetConflict(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		log.Fatalf("Failed to create DB connection: %s", err)
+	}
+	defer db.Close()
+
+	ug := infra_upload.NewUploadGateway(db)
+	u := upload.NewUpload(10, video.VIDEO, 100, "movie.mp4", "")
+	u.Advance(30)
+
+	mock.ExpectExec("UPDATE videos_uploads").WithArgs(int64(30), u.UpdatedAt, u.ID, int64(0)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	assert.ErrorIs(t, ug.UpdateOffset(*u, 0), upload.ErrOffsetMismatch)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestFinalizeUpload(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		log.Fatalf("Failed to create DB connection: %s", err)
+	}
+	defer db.Close()
+
+	ug := infra_upload.NewUploadGateway(db)
+	u := upload.NewUpload(10, video.VIDEO, 100, "movie.mp4", "")
+	u.Finalize()
+
+	mock.ExpectExec("UPDATE videos_uploads SET finalized_at").WithArgs(u.FinalizedAt, u.UpdatedAt, u.ID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	assert.Nil(t, ug.Finalize(*u))
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestDeleteUploadById(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		log.Fatalf("Failed to create DB connection: %s", err)
+	}
+	defer db.Close()
+
+	ug := infra_upload.NewUploadGateway(db)
+
+	mock.ExpectExec("DELETE FROM videos_uploads").WithArgs("abc").
+		WillReturnError(errors.New("connection reset"))
+
+	assert.EqualError(t, ug.DeleteById("abc"), "connection reset")
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,134 @@
+package infra_video
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/video"
+)
+
+// a variant with the same role, language and width is replaced in place
+func (vg VideoGateway) SaveArtwork(videoId int64, artwork video.Artwork) (*video.Artwork, error) {
+	tx, err := vg.Db.Begin()
+
+	if err != nil {
+		return nil, fmt.Errorf("unable to create transaction: %s", err.Error())
+	}
+
+	defer tx.Rollback()
+
+	imageId, err := upsertImageMedia(tx, artwork.Image)
+	if err != nil {
+		return nil, err
+	}
+
+	query := `
+		INSERT INTO videos_artworks (video_id, role, language, aspect_ratio, width, height, image_media_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (video_id, role, language, width)
+		DO UPDATE SET aspect_ratio = EXCLUDED.aspect_ratio, height = EXCLUDED.height, image_media_id = EXCLUDED.image_media_id
+		RETURNING id
+	`
+
+	err = tx.QueryRow(
+		query,
+		videoId,
+		artwork.Role.String(),
+		artwork.Language,
+		artwork.AspectRatio(),
+		artwork.Width,
+		artwork.Height,
+		imageId,
+	).Scan(&artwork.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	image := *artwork.Image
+	image.ID = *imageId
+	artwork.Image = &image
+
+	return &artwork, nil
+}
+
+func (vg VideoGateway) DeleteArtwork(videoId int64, artworkId int64) error {
+	tx, err := vg.Db.Begin()
+
+	if err != nil {
+		return fmt.Errorf("unable to create transaction: %s", err.Error())
+	}
+
+	defer tx.Rollback()
+
+	var imageId int64
+
+	err = tx.QueryRow(
+		"DELETE FROM videos_artworks WHERE id = $1 AND video_id = $2 RETURNING image_media_id",
+		artworkId, videoId,
+	).Scan(&imageId)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return video.ErrArtworkNotFound
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if _, err = tx.Exec("DELETE FROM videos_image_media WHERE id = $1", imageId); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func findArtworks(db *sql.DB, videoId int64) ([]video.Artwork, error) {
+	query := `
+		SELECT a.id, a.role, a.language, a.width, a.height,
+		im.id, im.name, im.checksum, im.file_path
+		FROM videos_artworks a
+		JOIN videos_image_media im ON im.id = a.image_media_id
+		WHERE a.video_id = $1 ORDER BY a.role, a.language, a.width
+	`
+
+	rows, err := db.Query(query, videoId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	artworks := []video.Artwork{}
+
+	for rows.Next() {
+		var artwork video.Artwork
+		var role string
+		var image imageMediaRow
+
+		err = rows.Scan(
+			&artwork.ID, &role, &artwork.Language, &artwork.Width, &artwork.Height,
+			&image.id, &image.name, &image.checksum, &image.location,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		if artwork.Role, err = video.StringToArtworkRole(role); err != nil {
+			return nil, err
+		}
+
+		artwork.Image = image.toDomain()
+		artworks = append(artworks, artwork)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return artworks, nil
+}
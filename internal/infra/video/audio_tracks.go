@@ -0,0 +1,127 @@
+package infra_video
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/video"
+)
+
+// audio track medias live in videos_video_media, so encoder status updates and reference counting cover them
+func (vg VideoGateway) SaveAudioTrack(videoId int64, track video.AudioTrack) (*video.AudioTrack, error) {
+	tx, err := vg.Db.Begin()
+
+	if err != nil {
+		return nil, fmt.Errorf("unable to create transaction: %s", err.Error())
+	}
+
+	defer tx.Rollback()
+
+	mediaId, err := upsertVideoMedia(tx, track.Media)
+	if err != nil {
+		return nil, err
+	}
+
+	query := `
+		INSERT INTO videos_audio_tracks (video_id, language, role, video_media_id) VALUES ($1, $2, $3, $4)
+		ON CONFLICT (video_id, language) DO UPDATE SET role = EXCLUDED.role, video_media_id = EXCLUDED.video_media_id
+	`
+
+	if _, err = tx.Exec(query, videoId, track.Language, track.Role.String(), *mediaId); err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	media := *track.Media
+	media.ID = *mediaId
+	track.Media = &media
+
+	return &track, nil
+}
+
+func (vg VideoGateway) DeleteAudioTrack(videoId int64, language string) error {
+	tx, err := vg.Db.Begin()
+
+	if err != nil {
+		return fmt.Errorf("unable to create transaction: %s", err.Error())
+	}
+
+	defer tx.Rollback()
+
+	var mediaId int64
+
+	err = tx.QueryRow(
+		"DELETE FROM videos_audio_tracks WHERE video_id = $1 AND language = $2 RETURNING video_media_id",
+		videoId, language,
+	).Scan(&mediaId)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return video.ErrResourceNotFound
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if _, err = tx.Exec("DELETE FROM videos_video_media WHERE id = $1", mediaId); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func findAudioTracks(db *sql.DB, videoId int64) ([]video.AudioTrack, error) {
+	query := `
+		SELECT at.language, at.role,
+		vm.id, vm.name, vm.checksum, vm.file_path, vm.encoded_path, vm.media_status, vm.failure_reason, vm.attempts,
+		vm.duration_seconds, vm.width, vm.height, vm.codecs, vm.track_count
+		FROM videos_audio_tracks at
+		JOIN videos_video_media vm ON vm.id = at.video_media_id
+		WHERE at.video_id = $1 ORDER BY at.language
+	`
+
+	rows, err := db.Query(query, videoId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	tracks := []video.AudioTrack{}
+
+	for rows.Next() {
+		var track video.AudioTrack
+		var role string
+		var media audioVideoMediaRow
+
+		err = rows.Scan(
+			&track.Language, &role,
+			&media.id, &media.name, &media.checksum,
+			&media.rawLocation, &media.encodedLocation, &media.status,
+			&media.failureReason, &media.attempts,
+			&media.durationSeconds, &media.width, &media.height, &media.codecs, &media.trackCount,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		if track.Role, err = video.StringToAudioTrackRole(role); err != nil {
+			return nil, err
+		}
+
+		if track.Media, err = media.toDomain(); err != nil {
+			return nil, err
+		}
+
+		tracks = append(tracks, track)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return tracks, nil
+}
@@ -0,0 +1,78 @@
+package infra_video
+
+import (
+	"database/sql"
+	"strings"
+
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/video"
+)
+
+func saveDescriptors(tx *sql.Tx, videoId int64, descriptors []video.ContentDescriptor) error {
+	query := `
+		INSERT INTO videos_content_descriptors (video_id, descriptor) VALUES ($1, $2)
+	`
+
+	for _, descriptor := range descriptors {
+		if _, err := tx.Exec(query, videoId, descriptor.String()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func replaceDescriptors(tx *sql.Tx, videoId int64, descriptors []video.ContentDescriptor) error {
+	if _, err := tx.Exec("DELETE FROM videos_content_descriptors WHERE video_id = $1", videoId); err != nil {
+		return err
+	}
+
+	return saveDescriptors(tx, videoId, descriptors)
+}
+
+func findDescriptors(db *sql.DB, videoId int64) ([]video.ContentDescriptor, error) {
+	rows, err := db.Query(
+		"SELECT descriptor FROM videos_content_descriptors WHERE video_id = $1 ORDER BY descriptor",
+		videoId,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	descriptors := []video.ContentDescriptor{}
+
+	for rows.Next() {
+		var code string
+		if err = rows.Scan(&code); err != nil {
+			return nil, err
+		}
+
+		descriptor, err := video.StringToContentDescriptor(code)
+		if err != nil {
+			return nil, err
+		}
+
+		descriptors = append(descriptors, descriptor)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return descriptors, nil
+}
+
+func splitDescriptors(codes string) []video.ContentDescriptor {
+	descriptors := []video.ContentDescriptor{}
+	if codes == "" {
+		return descriptors
+	}
+
+	for _, code := range strings.Split(codes, ",") {
+		if descriptor, err := video.StringToContentDescriptor(code); err == nil {
+			descriptors = append(descriptors, descriptor)
+		}
+	}
+
+	return descriptors
+}
@@ -0,0 +1,226 @@
+package infra_video
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/video"
+)
+
+func (vg VideoGateway) SaveExtra(videoId int64, extra video.Extra) (*video.Extra, error) {
+	tx, err := vg.Db.Begin()
+
+	if err != nil {
+		return nil, fmt.Errorf("unable to create transaction: %s", err.Error())
+	}
+
+	defer tx.Rollback()
+
+	mediaId, err := upsertVideoMedia(tx, extra.Media)
+	if err != nil {
+		return nil, err
+	}
+
+	if extra.ID == 0 {
+		query := `
+			INSERT INTO videos_extras (video_id, extra_type, title, position, video_media_id)
+			VALUES ($1, $2, $3, $4, $5) RETURNING id
+		`
+
+		err = tx.QueryRow(query, videoId, extra.Type.String(), extra.Title, extra.Position, mediaId).Scan(&extra.ID)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		query := `
+			UPDATE videos_extras SET extra_type=$1, title=$2, position=$3, video_media_id=$4
+			WHERE id = $5 AND video_id = $6
+		`
+
+		result, err := tx.Exec(query, extra.Type.String(), extra.Title, extra.Position, mediaId, extra.ID, videoId)
+		if err != nil {
+			return nil, err
+		}
+
+		affected, err := result.RowsAffected()
+		if err != nil {
+			return nil, err
+		}
+
+		if affected == 0 {
+			return nil, video.ErrExtraNotFound
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	if mediaId != nil {
+		media := *extra.Media
+		media.ID = *mediaId
+		extra.Media = &media
+	}
+
+	return &extra, nil
+}
+
+func (vg VideoGateway) DeleteExtra(videoId int64, extraId int64) error {
+	tx, err := vg.Db.Begin()
+
+	if err != nil {
+		return fmt.Errorf("unable to create transaction: %s", err.Error())
+	}
+
+	defer tx.Rollback()
+
+	// trailer_id cascades to the video, it must stop pointing to the media before it is deleted
+	_, err = tx.Exec(`
+		UPDATE videos SET trailer_id = NULL
+		WHERE id = $1 AND trailer_id = (SELECT video_media_id FROM videos_extras WHERE id = $2 AND video_id = $1)
+	`, videoId, extraId)
+
+	if err != nil {
+		return err
+	}
+
+	var mediaId sql.NullInt64
+	var position int
+
+	err = tx.QueryRow(
+		"DELETE FROM videos_extras WHERE id = $1 AND video_id = $2 RETURNING video_media_id, position",
+		extraId, videoId,
+	).Scan(&mediaId, &position)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return video.ErrExtraNotFound
+	}
+
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec("UPDATE videos_extras SET position = position - 1 WHERE video_id = $1 AND position > $2", videoId, position)
+	if err != nil {
+		return err
+	}
+
+	if mediaId.Valid {
+		if _, err = tx.Exec("DELETE FROM videos_video_media WHERE id = $1", mediaId.Int64); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (vg VideoGateway) ReorderExtras(videoId int64, extras []video.Extra) error {
+	tx, err := vg.Db.Begin()
+
+	if err != nil {
+		return fmt.Errorf("unable to create transaction: %s", err.Error())
+	}
+
+	defer tx.Rollback()
+
+	for _, extra := range extras {
+		_, err = tx.Exec("UPDATE videos_extras SET position = $1 WHERE id = $2 AND video_id = $3", extra.Position, extra.ID, videoId)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// a standalone trailer is dropped once an extra takes its place, extras keep their media
+func (vg VideoGateway) UpdatePrimaryTrailer(videoId int64, mediaId int64) error {
+	tx, err := vg.Db.Begin()
+
+	if err != nil {
+		return fmt.Errorf("unable to create transaction: %s", err.Error())
+	}
+
+	defer tx.Rollback()
+
+	var previousId sql.NullInt64
+
+	err = tx.QueryRow("SELECT trailer_id FROM videos WHERE id = $1 FOR UPDATE", videoId).Scan(&previousId)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return video.ErrVideoNotFound
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if _, err = tx.Exec("UPDATE videos SET trailer_id = $1 WHERE id = $2", mediaId, videoId); err != nil {
+		return err
+	}
+
+	if previousId.Valid && previousId.Int64 != mediaId {
+		_, err = tx.Exec(
+			"DELETE FROM videos_video_media WHERE id = $1 AND NOT EXISTS (SELECT 1 FROM videos_extras WHERE video_media_id = $1)",
+			previousId.Int64,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func findExtras(db *sql.DB, videoId int64) ([]video.Extra, error) {
+	query := `
+		SELECT e.id, e.extra_type, e.title, e.position,
+		vm.id, vm.name, vm.checksum, vm.file_path, vm.encoded_path, vm.media_status, vm.failure_reason, vm.attempts,
+		vm.duration_seconds, vm.width, vm.height, vm.codecs, vm.track_count
+		FROM videos_extras e
+		LEFT JOIN videos_video_media vm ON vm.id = e.video_media_id
+		WHERE e.video_id = $1 ORDER BY e.position
+	`
+
+	rows, err := db.Query(query, videoId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	extras := []video.Extra{}
+
+	for rows.Next() {
+		var extra video.Extra
+		var extraType string
+		var media audioVideoMediaRow
+
+		err = rows.Scan(
+			&extra.ID, &extraType, &extra.Title, &extra.Position,
+			&media.id, &media.name, &media.checksum,
+			&media.rawLocation, &media.encodedLocation, &media.status,
+			&media.failureReason, &media.attempts,
+			&media.durationSeconds, &media.width, &media.height, &media.codecs, &media.trackCount,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		if extra.Type, err = video.StringToExtraType(extraType); err != nil {
+			return nil, err
+		}
+
+		if extra.Media, err = media.toDomain(); err != nil {
+			return nil, err
+		}
+
+		extras = append(extras, extra)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return extras, nil
+}
@@ -0,0 +1,63 @@
+package infra_video
+
+import (
+	"database/sql"
+
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/video"
+)
+
+func saveRatings(tx *sql.Tx, videoId int64, ratings []video.TerritoryRating) error {
+	query := `
+		INSERT INTO videos_ratings (video_id, rating_system, territory, value) VALUES ($1, $2, $3, $4)
+	`
+
+	for _, rating := range ratings {
+		if _, err := tx.Exec(query, videoId, rating.System.String(), rating.Territory, rating.Value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func replaceRatings(tx *sql.Tx, videoId int64, ratings []video.TerritoryRating) error {
+	if _, err := tx.Exec("DELETE FROM videos_ratings WHERE video_id = $1", videoId); err != nil {
+		return err
+	}
+
+	return saveRatings(tx, videoId, ratings)
+}
+
+func findRatings(db *sql.DB, videoId int64) ([]video.TerritoryRating, error) {
+	rows, err := db.Query(
+		"SELECT rating_system, territory, value FROM videos_ratings WHERE video_id = $1 ORDER BY rating_system, territory",
+		videoId,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	ratings := []video.TerritoryRating{}
+
+	for rows.Next() {
+		var system string
+		var rating video.TerritoryRating
+
+		if err = rows.Scan(&system, &rating.Territory, &rating.Value); err != nil {
+			return nil, err
+		}
+
+		if rating.System, err = video.StringToRatingSystem(system); err != nil {
+			return nil, err
+		}
+
+		ratings = append(ratings, rating)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return ratings, nil
+}
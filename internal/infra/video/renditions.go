@@ -0,0 +1,71 @@
+package infra_video
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/video"
+)
+
+func saveRenditions(tx *sql.Tx, mediaId int64, renditions []video.Rendition) error {
+	if _, err := tx.Exec("DELETE FROM videos_media_renditions WHERE video_media_id = $1", mediaId); err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO videos_media_renditions (video_media_id, bandwidth, width, height, codecs, segment_location, segment_duration)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`
+
+	for _, r := range renditions {
+		_, err := tx.Exec(query, mediaId, r.Bandwidth, r.Width, r.Height, strings.Join(r.Codecs, ","), r.Location, r.SegmentDuration)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func loadRenditions(db *sql.DB, medias ...*video.AudioVideoMedia) error {
+	byId := map[int64]*video.AudioVideoMedia{}
+	ids := []int64{}
+	for _, media := range medias {
+		if media != nil {
+			byId[media.ID] = media
+			ids = append(ids, media.ID)
+		}
+	}
+
+	if len(ids) == 0 {
+		return nil
+	}
+
+	query := fmt.Sprintf(`
+		SELECT video_media_id, bandwidth, width, height, codecs, segment_location, segment_duration
+		FROM videos_media_renditions
+		WHERE video_media_id IN (%s) ORDER BY video_media_id, id
+	`, joinIds(ids))
+
+	rows, err := db.Query(query)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var mediaId int64
+		var codecs string
+		var r video.Rendition
+
+		if err = rows.Scan(&mediaId, &r.Bandwidth, &r.Width, &r.Height, &codecs, &r.Location, &r.SegmentDuration); err != nil {
+			return err
+		}
+
+		r.Codecs = splitCodecs(codecs)
+		byId[mediaId].Renditions = append(byId[mediaId].Renditions, r)
+	}
+
+	return rows.Err()
+}
@@ -0,0 +1,63 @@
+package infra_video
+
+import (
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/video"
+)
+
+func (vg VideoGateway) SaveTextTrack(videoId int64, track video.TextTrack) error {
+	query := `
+		INSERT INTO videos_text_tracks (video_id, language, kind, checksum, name, file_path, cues)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (video_id, language, kind)
+		DO UPDATE SET checksum = EXCLUDED.checksum, name = EXCLUDED.name, file_path = EXCLUDED.file_path, cues = EXCLUDED.cues
+	`
+
+	_, err := vg.Db.Exec(query,
+		videoId,
+		track.Language,
+		track.Kind.String(),
+		track.Checksum,
+		track.Name,
+		track.Location,
+		track.Cues,
+	)
+
+	return err
+}
+
+func (vg VideoGateway) FindTextTracks(videoId int64) ([]video.TextTrack, error) {
+	query := `
+		SELECT language, kind, checksum, name, file_path, cues
+		FROM videos_text_tracks WHERE video_id = $1 ORDER BY language, kind
+	`
+
+	rows, err := vg.Db.Query(query, videoId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	tracks := []video.TextTrack{}
+
+	for rows.Next() {
+		var track video.TextTrack
+		var kind string
+
+		err = rows.Scan(&track.Language, &kind, &track.Checksum, &track.Name, &track.Location, &track.Cues)
+		if err != nil {
+			return nil, err
+		}
+
+		if track.Kind, err = video.StringToTextTrackKind(kind); err != nil {
+			return nil, err
+		}
+
+		tracks = append(tracks, track)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return tracks, nil
+}
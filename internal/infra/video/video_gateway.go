@@ -2,8 +2,15 @@ package infra_video
 
 import (
 	"database/sql"
+	"errors"
 	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain"
 	"github.com.br/gibranct/admin_do_catalogo/internal/domain/video"
 )
 
@@ -58,8 +65,9 @@ func (vg VideoGateway) Create(aVideo video.Video) (*video.Video, error) {
 	createVideoQuery := `
 		INSERT INTO VIDEOS (
 		title, description, year_launched, opened, published, rating,
-		duration, created_at, updated_at, video_id, trailer_id, banner_id, thumbnail_id, thumbnail_half_id)
-		VALUES($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14) RETURNING id
+		duration, created_at, updated_at, video_id, trailer_id, banner_id, thumbnail_id, thumbnail_half_id,
+		publication_status, publish_at)
+		VALUES($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16) RETURNING id
 	`
 
 	var lastInsertId int64
@@ -79,6 +87,8 @@ func (vg VideoGateway) Create(aVideo video.Video) (*video.Video, error) {
 		bannerResourceId,
 		thumbnailResourceId,
 		thumbnailHalfResourceId,
+		aVideo.PublicationStatus.String(),
+		aVideo.PublishAt,
 	).Scan(&lastInsertId)
 
 	if err != nil {
@@ -106,6 +116,18 @@ func (vg VideoGateway) Create(aVideo video.Video) (*video.Video, error) {
 		}
 	}
 
+	if err = saveRatings(tx, lastInsertId, aVideo.Ratings); err != nil {
+		return nil, err
+	}
+
+	if err = saveDescriptors(tx, lastInsertId, aVideo.Descriptors); err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
 	aVideo.ID = lastInsertId
 	if videoResourceId != nil {
 		aVideo.Video.ID = *videoResourceId
@@ -126,12 +148,743 @@ func (vg VideoGateway) Create(aVideo video.Video) (*video.Video, error) {
 	return &aVideo, nil
 }
 
-func (vg VideoGateway) DeleteById(aVideo int64) error {
-	return nil
+func (vg VideoGateway) Update(aVideo video.Video) (*video.Video, error) {
+	tx, err := vg.Db.Begin()
+
+	if err != nil {
+		return nil, fmt.Errorf("unable to create transaction: %s", err.Error())
+	}
+
+	defer tx.Rollback()
+
+	var previousVideoId, previousTrailerId sql.NullInt64
+
+	err = tx.QueryRow("SELECT video_id, trailer_id FROM videos WHERE id = $1 FOR UPDATE", aVideo.ID).
+		Scan(&previousVideoId, &previousTrailerId)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, video.ErrVideoNotFound
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	var videoResourceId *int64
+	var trailerResourceId *int64
+	var bannerResourceId *int64
+	var thumbnailResourceId *int64
+	var thumbnailHalfResourceId *int64
+
+	videoResourceId, err = upsertVideoMedia(tx, aVideo.Video)
+	if err != nil {
+		return nil, err
+	}
+
+	trailerResourceId, err = upsertVideoMedia(tx, aVideo.Trailer)
+	if err != nil {
+		return nil, err
+	}
+
+	bannerResourceId, err = upsertImageMedia(tx, aVideo.Banner)
+	if err != nil {
+		return nil, err
+	}
+
+	thumbnailResourceId, err = upsertImageMedia(tx, aVideo.ThumbNail)
+	if err != nil {
+		return nil, err
+	}
+
+	thumbnailHalfResourceId, err = upsertImageMedia(tx, aVideo.ThumbNailHalf)
+	if err != nil {
+		return nil, err
+	}
+
+	updateVideoQuery := `
+		UPDATE videos SET title=$1, description=$2, year_launched=$3, opened=$4, rating=$5,
+		duration=$6, updated_at=$7, video_id=$8, trailer_id=$9, banner_id=$10, thumbnail_id=$11, thumbnail_half_id=$12
+		WHERE id = $13
+	`
+
+	result, err := tx.Exec(updateVideoQuery,
+		aVideo.Title,
+		aVideo.Description,
+		aVideo.LaunchedAt,
+		aVideo.Opened,
+		aVideo.Rating.String(),
+		aVideo.Duration,
+		aVideo.UpdatedAt,
+		videoResourceId,
+		trailerResourceId,
+		bannerResourceId,
+		thumbnailResourceId,
+		thumbnailHalfResourceId,
+		aVideo.ID,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+
+	if rowsAffected == 0 {
+		return nil, video.ErrVideoNotFound
+	}
+
+	if err = deleteSupersededMedia(tx, previousVideoId, videoResourceId); err != nil {
+		return nil, err
+	}
+
+	if err = deleteSupersededMedia(tx, previousTrailerId, trailerResourceId); err != nil {
+		return nil, err
+	}
+
+	err = syncRelation(tx, "videos_categories", "category_id", aVideo.ID, aVideo.CategoryIds, saveCategory)
+	if err != nil {
+		return nil, err
+	}
+
+	err = syncRelation(tx, "videos_genres", "genre_id", aVideo.ID, aVideo.GenreIds, saveGenre)
+	if err != nil {
+		return nil, err
+	}
+
+	err = syncRelation(tx, "videos_cast_members", "cast_member_id", aVideo.ID, aVideo.CastMemberIds, saveCastMember)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = replaceRatings(tx, aVideo.ID, aVideo.Ratings); err != nil {
+		return nil, err
+	}
+
+	if err = replaceDescriptors(tx, aVideo.ID, aVideo.Descriptors); err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	if videoResourceId != nil {
+		aVideo.Video.ID = *videoResourceId
+	}
+	if trailerResourceId != nil {
+		aVideo.Trailer.ID = *trailerResourceId
+	}
+	if bannerResourceId != nil {
+		aVideo.Banner.ID = *bannerResourceId
+	}
+	if thumbnailResourceId != nil {
+		aVideo.ThumbNail.ID = *thumbnailResourceId
+	}
+	if thumbnailHalfResourceId != nil {
+		aVideo.ThumbNailHalf.ID = *thumbnailHalfResourceId
+	}
+
+	return &aVideo, nil
+}
+
+func (vg VideoGateway) DeleteById(videoId int64) error {
+	tx, err := vg.Db.Begin()
+
+	if err != nil {
+		return fmt.Errorf("unable to create transaction: %s", err.Error())
+	}
+
+	defer tx.Rollback()
+
+	var videoMediaId, trailerMediaId, bannerMediaId, thumbnailMediaId, thumbnailHalfMediaId sql.NullInt64
+
+	err = tx.QueryRow(
+		"SELECT video_id, trailer_id, banner_id, thumbnail_id, thumbnail_half_id FROM videos WHERE id = $1",
+		videoId,
+	).Scan(&videoMediaId, &trailerMediaId, &bannerMediaId, &thumbnailMediaId, &thumbnailHalfMediaId)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return video.ErrVideoNotFound
+	}
+
+	if err != nil {
+		return err
+	}
+
+	deleteQueries := []string{
+		"DELETE FROM videos_categories WHERE video_id = $1",
+		"DELETE FROM videos_genres WHERE video_id = $1",
+		"DELETE FROM videos_cast_members WHERE video_id = $1",
+		"DELETE FROM videos_ratings WHERE video_id = $1",
+		"DELETE FROM videos_content_descriptors WHERE video_id = $1",
+		"DELETE FROM videos_text_tracks WHERE video_id = $1",
+		`WITH tracks AS (DELETE FROM videos_audio_tracks WHERE video_id = $1 RETURNING video_media_id)
+		DELETE FROM videos_video_media WHERE id IN (SELECT video_media_id FROM tracks)`,
+		`WITH extras AS (DELETE FROM videos_extras WHERE video_id = $1 RETURNING video_media_id)
+		DELETE FROM videos_video_media WHERE id IN (SELECT video_media_id FROM extras)
+		AND id NOT IN (SELECT trailer_id FROM videos WHERE id = $1 AND trailer_id IS NOT NULL)`,
+		`WITH artworks AS (DELETE FROM videos_artworks WHERE video_id = $1 RETURNING image_media_id)
+		DELETE FROM videos_image_media WHERE id IN (SELECT image_media_id FROM artworks)`,
+		"DELETE FROM videos WHERE id = $1",
+	}
+
+	for _, query := range deleteQueries {
+		if _, err = tx.Exec(query, videoId); err != nil {
+			return err
+		}
+	}
+
+	videoMediaIds := validIds(videoMediaId, trailerMediaId)
+	if len(videoMediaIds) > 0 {
+		query := fmt.Sprintf("DELETE FROM videos_video_media WHERE id IN (%s)", joinIds(videoMediaIds))
+		if _, err = tx.Exec(query); err != nil {
+			return err
+		}
+	}
+
+	imageMediaIds := validIds(bannerMediaId, thumbnailMediaId, thumbnailHalfMediaId)
+	if len(imageMediaIds) > 0 {
+		query := fmt.Sprintf("DELETE FROM videos_image_media WHERE id IN (%s)", joinIds(imageMediaIds))
+		if _, err = tx.Exec(query); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (vg VideoGateway) UpdateMediaStatus(media video.AudioVideoMedia) error {
+	tx, err := vg.Db.Begin()
+
+	if err != nil {
+		return fmt.Errorf("unable to create transaction: %s", err.Error())
+	}
+
+	defer tx.Rollback()
+
+	query := `
+		UPDATE videos_video_media SET media_status=$1, encoded_path=$2, failure_reason=$3, attempts=$4
+		WHERE id = $5
+	`
+
+	result, err := tx.Exec(query, media.Status.String(), media.EncodedLocation, media.FailureReason, media.Attempts, media.ID)
+	if err != nil {
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return video.ErrResourceNotFound
+	}
+
+	if *media.Status == video.COMPLETED {
+		if err = saveRenditions(tx, media.ID, media.Renditions); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (vg VideoGateway) CountMediaReferences(checksum string) (int64, error) {
+	query := `
+		SELECT
+		(SELECT COUNT(*) FROM videos_video_media WHERE checksum = $1) +
+		(SELECT COUNT(*) FROM videos_image_media WHERE checksum = $1) +
+		(SELECT COUNT(*) FROM videos_text_tracks WHERE checksum = $1)
+	`
+
+	var references int64
+	err := vg.Db.QueryRow(query, checksum).Scan(&references)
+
+	return references, err
+}
+
+// the previous status guards against two requests moving the same video at once
+func (vg VideoGateway) UpdatePublication(aVideo video.Video, previous video.PublicationStatus) error {
+	query := `
+		UPDATE videos SET publication_status=$1, publish_at=$2, published=$3, updated_at=$4
+		WHERE id = $5 AND publication_status = $6
+	`
+
+	result, err := vg.Db.Exec(query,
+		aVideo.PublicationStatus.String(),
+		aVideo.PublishAt,
+		aVideo.Published,
+		aVideo.UpdatedAt,
+		aVideo.ID,
+		previous.String(),
+	)
+
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected > 0 {
+		return nil
+	}
+
+	var exists bool
+	err = vg.Db.QueryRow("SELECT EXISTS (SELECT 1 FROM videos WHERE id = $1)", aVideo.ID).Scan(&exists)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		return video.ErrVideoNotFound
+	}
+
+	return video.ErrPublicationConflict
+}
+
+func (vg VideoGateway) FindDueForPublication(now time.Time) ([]int64, error) {
+	return findRelatedIds(
+		vg.Db,
+		"SELECT id FROM videos WHERE publication_status = 'SCHEDULED' AND publish_at <= $1 ORDER BY publish_at, id",
+		now,
+	)
 }
 
 func (vg VideoGateway) FindById(videoId int64) (*video.Video, error) {
-	return nil, nil
+	query := `
+		SELECT v.id, v.title, v.description, v.year_launched, v.opened, v.published, v.rating,
+		v.duration, v.created_at, v.updated_at, v.publication_status, v.publish_at,
+		vm.id, vm.name, vm.checksum, vm.file_path, vm.encoded_path, vm.media_status, vm.failure_reason, vm.attempts,
+		vm.duration_seconds, vm.width, vm.height, vm.codecs, vm.track_count,
+		tm.id, tm.name, tm.checksum, tm.file_path, tm.encoded_path, tm.media_status, tm.failure_reason, tm.attempts,
+		tm.duration_seconds, tm.width, tm.height, tm.codecs, tm.track_count,
+		bm.id, bm.name, bm.checksum, bm.file_path,
+		thm.id, thm.name, thm.checksum, thm.file_path,
+		thhm.id, thhm.name, thhm.checksum, thhm.file_path, thhm.generated
+		FROM videos v
+		LEFT JOIN videos_video_media vm ON vm.id = v.video_id
+		LEFT JOIN videos_video_media tm ON tm.id = v.trailer_id
+		LEFT JOIN videos_image_media bm ON bm.id = v.banner_id
+		LEFT JOIN videos_image_media thm ON thm.id = v.thumbnail_id
+		LEFT JOIN videos_image_media thhm ON thhm.id = v.thumbnail_half_id
+		WHERE v.id = $1
+	`
+
+	var aVideo video.Video
+	var rating, publicationStatus sql.NullString
+	var videoMedia, trailerMedia audioVideoMediaRow
+	var banner, thumbnail, thumbnailHalf imageMediaRow
+
+	err := vg.Db.QueryRow(query, videoId).Scan(
+		&aVideo.ID,
+		&aVideo.Title,
+		&aVideo.Description,
+		&aVideo.LaunchedAt,
+		&aVideo.Opened,
+		&aVideo.Published,
+		&rating,
+		&aVideo.Duration,
+		&aVideo.CreatedAt,
+		&aVideo.UpdatedAt,
+		&publicationStatus,
+		&aVideo.PublishAt,
+		&videoMedia.id, &videoMedia.name, &videoMedia.checksum,
+		&videoMedia.rawLocation, &videoMedia.encodedLocation, &videoMedia.status,
+		&videoMedia.failureReason, &videoMedia.attempts,
+		&videoMedia.durationSeconds, &videoMedia.width, &videoMedia.height, &videoMedia.codecs, &videoMedia.trackCount,
+		&trailerMedia.id, &trailerMedia.name, &trailerMedia.checksum,
+		&trailerMedia.rawLocation, &trailerMedia.encodedLocation, &trailerMedia.status,
+		&trailerMedia.failureReason, &trailerMedia.attempts,
+		&trailerMedia.durationSeconds, &trailerMedia.width, &trailerMedia.height, &trailerMedia.codecs, &trailerMedia.trackCount,
+		&banner.id, &banner.name, &banner.checksum, &banner.location,
+		&thumbnail.id, &thumbnail.name, &thumbnail.checksum, &thumbnail.location,
+		&thumbnailHalf.id, &thumbnailHalf.name, &thumbnailHalf.checksum, &thumbnailHalf.location, &thumbnailHalf.generated,
+	)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, video.ErrVideoNotFound
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	aVideo.Rating, _ = video.StringToRating(rating.String)
+	aVideo.PublicationStatus, _ = video.StringToPublicationStatus(publicationStatus.String)
+
+	if aVideo.Video, err = videoMedia.toDomain(); err != nil {
+		return nil, err
+	}
+
+	if aVideo.Trailer, err = trailerMedia.toDomain(); err != nil {
+		return nil, err
+	}
+
+	if err = loadRenditions(vg.Db, aVideo.Video, aVideo.Trailer); err != nil {
+		return nil, err
+	}
+
+	aVideo.Banner = banner.toDomain()
+	aVideo.ThumbNail = thumbnail.toDomain()
+	aVideo.ThumbNailHalf = thumbnailHalf.toDomain()
+
+	aVideo.CategoryIds, err = findRelatedIds(vg.Db, "SELECT category_id FROM videos_categories WHERE video_id = $1 ORDER BY category_id", videoId)
+	if err != nil {
+		return nil, err
+	}
+
+	aVideo.GenreIds, err = findRelatedIds(vg.Db, "SELECT genre_id FROM videos_genres WHERE video_id = $1 ORDER BY genre_id", videoId)
+	if err != nil {
+		return nil, err
+	}
+
+	aVideo.CastMemberIds, err = findRelatedIds(vg.Db, "SELECT cast_member_id FROM videos_cast_members WHERE video_id = $1 ORDER BY cast_member_id", videoId)
+	if err != nil {
+		return nil, err
+	}
+
+	aVideo.Ratings, err = findRatings(vg.Db, videoId)
+	if err != nil {
+		return nil, err
+	}
+
+	aVideo.Descriptors, err = findDescriptors(vg.Db, videoId)
+	if err != nil {
+		return nil, err
+	}
+
+	aVideo.AudioTracks, err = findAudioTracks(vg.Db, videoId)
+	if err != nil {
+		return nil, err
+	}
+
+	aVideo.Extras, err = findExtras(vg.Db, videoId)
+	if err != nil {
+		return nil, err
+	}
+
+	aVideo.Artworks, err = findArtworks(vg.Db, videoId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &aVideo, nil
+}
+
+func (vg VideoGateway) ExistsByIds(videoIds []int64) ([]int64, error) {
+	if len(videoIds) == 0 {
+		return []int64{}, nil
+	}
+
+	rows, err := vg.Db.Query(fmt.Sprintf("SELECT id FROM videos WHERE id IN (%s) ORDER BY id", joinIds(videoIds)))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	ids := []int64{}
+
+	for rows.Next() {
+		var id int64
+		if err = rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
+
+func (vg VideoGateway) FindAll(query video.VideoSearchQuery) (*domain.Pagination[video.Video], error) {
+	where, args := buildVideoFilters(query)
+
+	sql := fmt.Sprintf(`
+		SELECT COUNT(*) OVER(), v.id, v.title, v.description, v.year_launched, v.opened, v.published,
+		v.rating, v.duration, v.created_at, v.updated_at, v.publication_status, v.publish_at,
+		COALESCE((SELECT string_agg(vcd.descriptor, ',' ORDER BY vcd.descriptor)
+			FROM videos_content_descriptors vcd WHERE vcd.video_id = v.id), '')
+		FROM videos v
+		WHERE %s
+		ORDER BY v.%s %s, v.id
+		LIMIT $%d OFFSET $%d`,
+		strings.Join(where, " AND "), query.SortColumn(), query.SortDirection(), len(args)+1, len(args)+2)
+
+	args = append(args, query.Limit(), query.Offset())
+
+	rows, err := vg.Db.Query(sql, args...)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+	videos := []*video.Video{}
+	totalRecords := 0
+
+	for rows.Next() {
+		var v video.Video
+		var rating, publicationStatus, descriptors string
+		err := rows.Scan(
+			&totalRecords,
+			&v.ID,
+			&v.Title,
+			&v.Description,
+			&v.LaunchedAt,
+			&v.Opened,
+			&v.Published,
+			&rating,
+			&v.Duration,
+			&v.CreatedAt,
+			&v.UpdatedAt,
+			&publicationStatus,
+			&v.PublishAt,
+			&descriptors,
+		)
+
+		if err != nil {
+			return nil, err
+		}
+
+		v.Rating, _ = video.StringToRating(rating)
+		v.PublicationStatus, _ = video.StringToPublicationStatus(publicationStatus)
+		v.Descriptors = splitDescriptors(descriptors)
+
+		videos = append(videos, &v)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	lastPage := math.Ceil(float64(totalRecords) / float64(query.PerPage))
+	return &domain.Pagination[video.Video]{
+		Items:       videos,
+		PerPage:     query.PerPage,
+		CurrentPage: query.Page,
+		Total:       totalRecords,
+		IsLast:      lastPage == float64(query.Page),
+	}, nil
+}
+
+func buildVideoFilters(query video.VideoSearchQuery) ([]string, []any) {
+	args := []any{"%" + query.Term + "%"}
+	where := []string{"(v.title ILIKE $1 OR v.description ILIKE $1)"}
+
+	addFilter := func(condition string, value any) {
+		args = append(args, value)
+		where = append(where, fmt.Sprintf(condition, len(args)))
+	}
+
+	addDescriptorArgs := func(descriptors []video.ContentDescriptor) string {
+		placeholders := []string{}
+		for _, descriptor := range descriptors {
+			args = append(args, descriptor.String())
+			placeholders = append(placeholders, fmt.Sprintf("$%d", len(args)))
+		}
+		return strings.Join(placeholders, ",")
+	}
+
+	if query.Rating != nil {
+		addFilter("v.rating = $%d", query.Rating.String())
+	}
+	if query.Published != nil {
+		addFilter("v.published = $%d", *query.Published)
+	}
+	if query.Opened != nil {
+		addFilter("v.opened = $%d", *query.Opened)
+	}
+	if query.YearLaunchedFrom != nil {
+		addFilter("v.year_launched >= $%d", *query.YearLaunchedFrom)
+	}
+	if query.YearLaunchedTo != nil {
+		addFilter("v.year_launched <= $%d", *query.YearLaunchedTo)
+	}
+
+	if len(query.CategoryIds) > 0 {
+		where = append(where, inheritedRelationFilter("videos_categories", "series_categories", "category_id", query.CategoryIds))
+	}
+	if len(query.GenreIds) > 0 {
+		where = append(where, inheritedRelationFilter("videos_genres", "series_genres", "genre_id", query.GenreIds))
+	}
+	if len(query.CastMemberIds) > 0 {
+		where = append(where, fmt.Sprintf(
+			"EXISTS (SELECT 1 FROM videos_cast_members vcm WHERE vcm.video_id = v.id AND vcm.cast_member_id IN (%s))",
+			joinIds(query.CastMemberIds),
+		))
+	}
+
+	if len(query.Descriptors) > 0 {
+		where = append(where, fmt.Sprintf(
+			"EXISTS (SELECT 1 FROM videos_content_descriptors vcd WHERE vcd.video_id = v.id AND vcd.descriptor IN (%s))",
+			addDescriptorArgs(query.Descriptors),
+		))
+	}
+	if len(query.ExcludedDescriptors) > 0 {
+		where = append(where, fmt.Sprintf(
+			"NOT EXISTS (SELECT 1 FROM videos_content_descriptors vcd WHERE vcd.video_id = v.id AND vcd.descriptor IN (%s))",
+			addDescriptorArgs(query.ExcludedDescriptors),
+		))
+	}
+
+	return where, args
+}
+
+// an episode without its own categories or genres is matched by the ones of its series
+func inheritedRelationFilter(videoTable, seriesTable, column string, ids []int64) string {
+	return fmt.Sprintf(
+		"(EXISTS (SELECT 1 FROM %[1]s r WHERE r.video_id = v.id AND r.%[3]s IN (%[4]s)) OR "+
+			"(NOT EXISTS (SELECT 1 FROM %[1]s r WHERE r.video_id = v.id) AND "+
+			"EXISTS (SELECT 1 FROM seasons_episodes se JOIN seasons s ON s.id = se.season_id "+
+			"JOIN %[2]s r ON r.series_id = s.series_id WHERE se.video_id = v.id AND r.%[3]s IN (%[4]s))))",
+		videoTable, seriesTable, column, joinIds(ids),
+	)
+}
+
+func validIds(nullableIds ...sql.NullInt64) []int64 {
+	var ids []int64
+	for _, id := range nullableIds {
+		if id.Valid {
+			ids = append(ids, id.Int64)
+		}
+	}
+	return ids
+}
+
+func joinIds(ids []int64) string {
+	var stringIds []string
+	for _, id := range ids {
+		stringIds = append(stringIds, strconv.FormatInt(id, 10))
+	}
+	return strings.Join(stringIds, ",")
+}
+
+type audioVideoMediaRow struct {
+	id              sql.NullInt64
+	name            sql.NullString
+	checksum        sql.NullString
+	rawLocation     sql.NullString
+	encodedLocation sql.NullString
+	status          sql.NullString
+	failureReason   sql.NullString
+	attempts        sql.NullInt64
+	durationSeconds sql.NullFloat64
+	width           sql.NullInt64
+	height          sql.NullInt64
+	codecs          sql.NullString
+	trackCount      sql.NullInt64
+}
+
+func (row audioVideoMediaRow) toDomain() (*video.AudioVideoMedia, error) {
+	if !row.id.Valid {
+		return nil, nil
+	}
+
+	status, err := video.StringToMediaStatus(row.status.String)
+	if err != nil {
+		return nil, err
+	}
+
+	media := video.NewAudioVideoMediaWith(
+		row.id.Int64,
+		&status,
+		row.checksum.String,
+		row.name.String,
+		row.rawLocation.String,
+		row.encodedLocation.String,
+	)
+	media.FailureReason = row.failureReason.String
+	media.Attempts = int(row.attempts.Int64)
+
+	if row.trackCount.Valid {
+		media.Metadata = &video.MediaMetadata{
+			Duration:   row.durationSeconds.Float64,
+			Width:      int(row.width.Int64),
+			Height:     int(row.height.Int64),
+			Codecs:     splitCodecs(row.codecs.String),
+			TrackCount: int(row.trackCount.Int64),
+		}
+	}
+
+	return media, nil
+}
+
+func splitCodecs(codecs string) []string {
+	if codecs == "" {
+		return []string{}
+	}
+	return strings.Split(codecs, ",")
+}
+
+func metadataColumns(metadata *video.MediaMetadata) []any {
+	if metadata == nil {
+		return []any{nil, nil, nil, nil, nil}
+	}
+
+	return []any{
+		metadata.Duration,
+		metadata.Width,
+		metadata.Height,
+		strings.Join(metadata.Codecs, ","),
+		metadata.TrackCount,
+	}
+}
+
+type imageMediaRow struct {
+	id        sql.NullInt64
+	name      sql.NullString
+	checksum  sql.NullString
+	location  sql.NullString
+	generated sql.NullBool
+}
+
+func (row imageMediaRow) toDomain() *video.ImageMedia {
+	if !row.id.Valid {
+		return nil
+	}
+
+	image := video.NewImageMediaWithId(
+		row.id.Int64,
+		row.checksum.String,
+		row.name.String,
+		row.location.String,
+	)
+	image.Generated = row.generated.Bool
+	return image
+}
+
+func findRelatedIds(db *sql.DB, query string, arg any) ([]int64, error) {
+	rows, err := db.Query(query, arg)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	ids := []int64{}
+
+	for rows.Next() {
+		var id int64
+		if err = rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return ids, nil
 }
 
 func saveVideoMedia(tx *sql.Tx, video *video.AudioVideoMedia) (*int64, error) {
@@ -140,19 +893,24 @@ func saveVideoMedia(tx *sql.Tx, video *video.AudioVideoMedia) (*int64, error) {
 	}
 
 	createVideoMediaQuery := `
-	INSERT INTO videos_video_media (name, checksum, file_path, encoded_path, media_status)
-	VALUES ($1, $2, $3, $4, $5) RETURNING id
+	INSERT INTO videos_video_media (name, checksum, file_path, encoded_path, media_status, failure_reason, attempts,
+	duration_seconds, width, height, codecs, track_count)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12) RETURNING id
 `
 
 	var lastInsertId int64
 
-	err := tx.QueryRow(createVideoMediaQuery,
+	args := append([]any{
 		video.Name,
 		video.Checksum,
 		video.RawLocation,
 		video.EncodedLocation,
 		video.Status.String(),
-	).Scan(&lastInsertId)
+		video.FailureReason,
+		video.Attempts,
+	}, metadataColumns(video.Metadata)...)
+
+	err := tx.QueryRow(createVideoMediaQuery, args...).Scan(&lastInsertId)
 
 	if err != nil {
 		return nil, err
@@ -167,13 +925,13 @@ func saveImageMedia(tx *sql.Tx, image *video.ImageMedia) (*int64, error) {
 	}
 
 	createImageMediaQuery := `
-		INSERT INTO videos_image_media (name, checksum, file_path)
-		VALUES ($1, $2, $3) RETURNING id
+		INSERT INTO videos_image_media (name, checksum, file_path, generated)
+		VALUES ($1, $2, $3, $4) RETURNING id
 	`
 
 	var lastInsertId int64
 
-	err := tx.QueryRow(createImageMediaQuery, image.Name, image.Checksum, image.Location).Scan(&lastInsertId)
+	err := tx.QueryRow(createImageMediaQuery, image.Name, image.Checksum, image.Location, image.Generated).Scan(&lastInsertId)
 
 	if err != nil {
 		return nil, err
@@ -182,6 +940,128 @@ func saveImageMedia(tx *sql.Tx, image *video.ImageMedia) (*int64, error) {
 	return &lastInsertId, nil
 }
 
+func upsertVideoMedia(tx *sql.Tx, video *video.AudioVideoMedia) (*int64, error) {
+	if video == nil || video.ID == 0 {
+		return saveVideoMedia(tx, video)
+	}
+
+	updateVideoMediaQuery := `
+		UPDATE videos_video_media SET name=$1, checksum=$2, file_path=$3, encoded_path=$4, media_status=$5,
+		failure_reason=$6, attempts=$7, duration_seconds=$8, width=$9, height=$10, codecs=$11, track_count=$12
+		WHERE id = $13
+	`
+
+	args := append([]any{
+		video.Name,
+		video.Checksum,
+		video.RawLocation,
+		video.EncodedLocation,
+		video.Status.String(),
+		video.FailureReason,
+		video.Attempts,
+	}, metadataColumns(video.Metadata)...)
+
+	_, err := tx.Exec(updateVideoMediaQuery, append(args, video.ID)...)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &video.ID, nil
+}
+
+// a replaced video or trailer is stored as a new media, the one it supersedes is removed along with
+// its renditions and encoding profile unless an extra still holds it
+func deleteSupersededMedia(tx *sql.Tx, previousId sql.NullInt64, currentId *int64) error {
+	if !previousId.Valid || (currentId != nil && *currentId == previousId.Int64) {
+		return nil
+	}
+
+	_, err := tx.Exec(
+		"DELETE FROM videos_video_media WHERE id = $1 AND NOT EXISTS (SELECT 1 FROM videos_extras WHERE video_media_id = $1)",
+		previousId.Int64,
+	)
+
+	return err
+}
+
+func upsertImageMedia(tx *sql.Tx, image *video.ImageMedia) (*int64, error) {
+	if image == nil || image.ID == 0 {
+		return saveImageMedia(tx, image)
+	}
+
+	updateImageMediaQuery := `
+		UPDATE videos_image_media SET name=$1, checksum=$2, file_path=$3, generated=$4
+		WHERE id = $5
+	`
+
+	_, err := tx.Exec(updateImageMediaQuery, image.Name, image.Checksum, image.Location, image.Generated, image.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &image.ID, nil
+}
+
+func syncRelation(
+	tx *sql.Tx,
+	table, column string,
+	videoId int64,
+	ids []int64,
+	save func(tx *sql.Tx, videoId, id int64) error,
+) error {
+	rows, err := tx.Query(fmt.Sprintf("SELECT %s FROM %s WHERE video_id = $1", column, table), videoId)
+	if err != nil {
+		return err
+	}
+
+	var currentIds []int64
+
+	for rows.Next() {
+		var id int64
+		if err = rows.Scan(&id); err != nil {
+			rows.Close()
+			return err
+		}
+		currentIds = append(currentIds, id)
+	}
+	rows.Close()
+
+	if err = rows.Err(); err != nil {
+		return err
+	}
+
+	var removedIds []int64
+	for _, id := range currentIds {
+		if !slices.Contains(ids, id) {
+			removedIds = append(removedIds, id)
+		}
+	}
+
+	if len(removedIds) > 0 {
+		query := fmt.Sprintf("DELETE FROM %s WHERE video_id = $1 AND %s IN (%s)", table, column, joinIds(removedIds))
+		if _, err = tx.Exec(query, videoId); err != nil {
+			return err
+		}
+	}
+
+	var addedIds []int64
+	for _, id := range ids {
+		if !slices.Contains(currentIds, id) && !slices.Contains(addedIds, id) {
+			addedIds = append(addedIds, id)
+		}
+	}
+
+	for _, id := range addedIds {
+		if err = save(tx, videoId, id); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func saveCategory(tx *sql.Tx, videoId, categoryId int64) error {
 	query := `
 		INSERT INTO videos_categories (video_id, category_id) VALUES ($1, $2)
@@ -1,10 +1,13 @@
 package infra_video_test
 
 import (
+	"database/sql"
 	"errors"
 	"log"
 	"testing"
+	"time"
 
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain"
 	"github.com.br/gibranct/admin_do_catalogo/internal/domain/video"
 	infra_video "github.com.br/gibranct/admin_do_catalogo/internal/infra/video"
 	"github.com/DATA-DOG/go-sqlmock"
@@ -41,6 +44,8 @@ func TestCreateVideo(t *testing.T) {
 		nil,
 		nil,
 		nil,
+		"DRAFT",
+		nil,
 	).WillReturnRows(sqlmock.NewRows([]string{"1"}).AddRow(videoId))
 
 	mock.ExpectExec("INSERT INTO videos_categories").WithArgs(
@@ -91,6 +96,8 @@ func TestCreateVideoWhenItFails(t *testing.T) {
 		nil,
 		nil,
 		nil,
+		"DRAFT",
+		nil,
 	).WillReturnRows(sqlmock.NewRows([]string{"1"}).AddRow(videoId))
 	mock.ExpectExec("INSERT INTO videos_categories").WithArgs(
 		videoId, video.CategoryIds[0],
@@ -105,6 +112,468 @@ func TestCreateVideoWhenItFails(t *testing.T) {
 	assert.Equal(t, createVideoError.Error(), err.Error())
 }
 
+func TestFindVideoById(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		log.Fatalf("Failed to create DB connection: %s", err)
+	}
+	defer db.Close()
+
+	vg := infra_video.NewVideoGateway(db)
+	aVideo := dummyVideo()
+	aVideo.ID = int64(85)
+
+	publishAt := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	rows := sqlmock.NewRows(make([]string, 51)).AddRow(
+		aVideo.ID,
+		aVideo.Title,
+		aVideo.Description,
+		aVideo.LaunchedAt,
+		aVideo.Opened,
+		aVideo.Published,
+		aVideo.Rating.String(),
+		aVideo.Duration,
+		aVideo.CreatedAt,
+		aVideo.UpdatedAt,
+		"SCHEDULED",
+		publishAt,
+		int64(10), "video.mp4", "video-checksum", "/raw/video.mp4", "/encoded/video", "FAILED", "bad codec", int64(2),
+		5400.5, int64(1920), int64(1080), "avc1,mp4a", int64(2),
+		nil, nil, nil, nil, nil, nil, nil, nil,
+		nil, nil, nil, nil, nil,
+		int64(20), "banner.png", "banner-checksum", "/banner.png",
+		nil, nil, nil, nil,
+		int64(22), "thumb_half.png", "half-checksum", "/thumb_half.png", true,
+	)
+
+	mock.ExpectQuery("SELECT (.+) FROM videos v").WithArgs(aVideo.ID).WillReturnRows(rows)
+	mock.ExpectQuery(`SELECT (.+) FROM videos_media_renditions WHERE video_media_id IN \(10\)`).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"video_media_id", "bandwidth", "width", "height", "codecs", "segment_location", "segment_duration",
+		}).AddRow(10, 5000000, 1920, 1080, "avc1.640028,mp4a.40.2", "/encoded/video/1080p", 6.0))
+	mock.ExpectQuery("SELECT category_id FROM videos_categories").WithArgs(aVideo.ID).
+		WillReturnRows(sqlmock.NewRows([]string{"category_id"}).AddRow(aVideo.CategoryIds[0]))
+	mock.ExpectQuery("SELECT genre_id FROM videos_genres").WithArgs(aVideo.ID).
+		WillReturnRows(sqlmock.NewRows([]string{"genre_id"}).AddRow(aVideo.GenreIds[0]))
+	mock.ExpectQuery("SELECT cast_member_id FROM videos_cast_members").WithArgs(aVideo.ID).
+		WillReturnRows(sqlmock.NewRows([]string{"cast_member_id"}).AddRow(aVideo.CastMemberIds[0]))
+	mock.ExpectQuery("SELECT rating_system, territory, value FROM videos_ratings").WithArgs(aVideo.ID).
+		WillReturnRows(sqlmock.NewRows([]string{"rating_system", "territory", "value"}).AddRow("MPAA", "US", "PG-13"))
+	mock.ExpectQuery("SELECT descriptor FROM videos_content_descriptors").WithArgs(aVideo.ID).
+		WillReturnRows(sqlmock.NewRows([]string{"descriptor"}).AddRow("FEAR").AddRow("VIOLENCE"))
+	mock.ExpectQuery("SELECT (.+) FROM videos_audio_tracks at JOIN videos_video_media").WithArgs(aVideo.ID).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"language", "role", "id", "name", "checksum", "file_path", "encoded_path", "media_status", "failure_reason",
+			"attempts", "duration_seconds", "width", "height", "codecs", "track_count",
+		}).AddRow("es", "DUB", 30, "movie.es.mp4", "sum", "/audio/es/movie.es.mp4", "", "PENDING", "", 0, nil, nil, nil, nil, nil))
+	mock.ExpectQuery("SELECT (.+) FROM videos_extras e LEFT JOIN videos_video_media").WithArgs(aVideo.ID).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"id", "extra_type", "title", "position", "id", "name", "checksum", "file_path", "encoded_path", "media_status",
+			"failure_reason", "attempts", "duration_seconds", "width", "height", "codecs", "track_count",
+		}).
+			AddRow(5, "TEASER", "Teaser", 1, 40, "teaser.mp4", "sum", "/extras/5/teaser.mp4", "", "PENDING", "", 0, nil, nil, nil, nil, nil).
+			AddRow(6, "CLIP", "Clip", 2, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil))
+	mock.ExpectQuery("SELECT (.+) FROM videos_artworks a JOIN videos_image_media").WithArgs(aVideo.ID).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"id", "role", "language", "width", "height", "id", "name", "checksum", "file_path",
+		}).AddRow(7, "POSTER", "pt-BR", 600, 900, 50, "poster.png", "sum", "/artwork/poster/pt-BR/600/poster.png"))
+
+	foundVideo, err := vg.FindById(aVideo.ID)
+
+	assert.Nil(t, err)
+	assert.Equal(t, aVideo.ID, foundVideo.ID)
+	assert.Equal(t, aVideo.Title, foundVideo.Title)
+	assert.Equal(t, aVideo.Rating, foundVideo.Rating)
+	assert.Equal(t, video.SCHEDULED, foundVideo.PublicationStatus)
+	assert.Equal(t, publishAt, *foundVideo.PublishAt)
+	assert.Equal(t, int64(10), foundVideo.Video.ID)
+	assert.Equal(t, video.FAILED, *foundVideo.Video.Status)
+	assert.Equal(t, "bad codec", foundVideo.Video.FailureReason)
+	assert.Equal(t, 2, foundVideo.Video.Attempts)
+	assert.Equal(t, "/encoded/video", foundVideo.Video.EncodedLocation)
+	assert.Equal(t, &video.MediaMetadata{
+		Duration:   5400.5,
+		Width:      1920,
+		Height:     1080,
+		Codecs:     []string{"avc1", "mp4a"},
+		TrackCount: 2,
+	}, foundVideo.Video.Metadata)
+	assert.Equal(t, []video.Rendition{
+		*video.NewRendition(5000000, 1920, 1080, []string{"avc1.640028", "mp4a.40.2"}, "/encoded/video/1080p", 6),
+	}, foundVideo.Video.Renditions)
+	assert.Nil(t, foundVideo.Trailer)
+	assert.Equal(t, int64(20), foundVideo.Banner.ID)
+	assert.Equal(t, "/banner.png", foundVideo.Banner.Location)
+	assert.Nil(t, foundVideo.ThumbNail)
+	assert.Equal(t, int64(22), foundVideo.ThumbNailHalf.ID)
+	assert.True(t, foundVideo.ThumbNailHalf.Generated)
+	assert.Equal(t, aVideo.CategoryIds, foundVideo.CategoryIds)
+	assert.Equal(t, aVideo.GenreIds, foundVideo.GenreIds)
+	assert.Equal(t, aVideo.CastMemberIds, foundVideo.CastMemberIds)
+	assert.Equal(t, []video.TerritoryRating{video.NewTerritoryRating(video.MPAA, "US", "PG-13")}, foundVideo.Ratings)
+	assert.Equal(t, []video.ContentDescriptor{video.FEAR, video.VIOLENCE}, foundVideo.Descriptors)
+	assert.Len(t, foundVideo.AudioTracks, 1)
+	assert.Equal(t, "es", foundVideo.AudioTracks[0].Language)
+	assert.Equal(t, video.DUB, foundVideo.AudioTracks[0].Role)
+	assert.Equal(t, int64(30), foundVideo.AudioTracks[0].Media.ID)
+	assert.Equal(t, video.PENDING, *foundVideo.AudioTracks[0].Media.Status)
+	assert.Len(t, foundVideo.Extras, 2)
+	assert.Equal(t, video.EXTRA_TEASER, foundVideo.Extras[0].Type)
+	assert.Equal(t, int64(40), foundVideo.Extras[0].Media.ID)
+	assert.Equal(t, 2, foundVideo.Extras[1].Position)
+	assert.Nil(t, foundVideo.Extras[1].Media)
+	assert.Equal(t, []video.Artwork{{
+		ID:       7,
+		Role:     video.POSTER,
+		Language: "pt-BR",
+		Width:    600,
+		Height:   900,
+		Image:    video.NewImageMediaWithId(50, "sum", "poster.png", "/artwork/poster/pt-BR/600/poster.png"),
+	}}, foundVideo.Artworks)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestFindVideoByIdWhenNotFound(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		log.Fatalf("Failed to create DB connection: %s", err)
+	}
+	defer db.Close()
+
+	vg := infra_video.NewVideoGateway(db)
+	videoId := int64(85)
+
+	mock.ExpectQuery("SELECT (.+) FROM videos v").WithArgs(videoId).WillReturnError(sql.ErrNoRows)
+
+	foundVideo, err := vg.FindById(videoId)
+
+	assert.Nil(t, foundVideo)
+	assert.Equal(t, video.ErrVideoNotFound, err)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestFindAllVideos(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		log.Fatalf("Failed to create DB connection: %s", err)
+	}
+	defer db.Close()
+
+	vg := infra_video.NewVideoGateway(db)
+	aVideo := dummyVideo()
+	aVideo.ID = int64(85)
+	published := true
+	yearFrom := 2000
+	rating := video.L
+	query := video.VideoSearchQuery{
+		SearchQuery: domain.SearchQuery{
+			Page:      1,
+			PerPage:   10,
+			Term:      "dummy",
+			Sort:      "year_launched",
+			Direction: "DESC",
+		},
+		CategoryIds:         []int64{78, 79},
+		CastMemberIds:       []int64{55},
+		Rating:              &rating,
+		Published:           &published,
+		YearLaunchedFrom:    &yearFrom,
+		Descriptors:         []video.ContentDescriptor{video.FEAR, video.VIOLENCE},
+		ExcludedDescriptors: []video.ContentDescriptor{video.EXPLICIT_SEX},
+	}
+
+	rows := sqlmock.NewRows(make([]string, 14)).AddRow(
+		1,
+		aVideo.ID,
+		aVideo.Title,
+		aVideo.Description,
+		aVideo.LaunchedAt,
+		aVideo.Opened,
+		aVideo.Published,
+		aVideo.Rating.String(),
+		aVideo.Duration,
+		aVideo.CreatedAt,
+		aVideo.UpdatedAt,
+		"PUBLISHED",
+		nil,
+		"FEAR,VIOLENCE",
+	)
+
+	mock.ExpectQuery(
+		`v.rating = \$2 AND v.published = \$3 AND v.year_launched >= \$4 AND `+
+			`\(EXISTS \(SELECT 1 FROM videos_categories r WHERE r.video_id = v.id AND r.category_id IN \(78,79\)\) OR `+
+			`\(NOT EXISTS \(SELECT 1 FROM videos_categories r WHERE r.video_id = v.id\) AND `+
+			`EXISTS \(SELECT 1 FROM seasons_episodes se JOIN seasons s ON s.id = se.season_id `+
+			`JOIN series_categories r ON r.series_id = s.series_id WHERE se.video_id = v.id AND r.category_id IN \(78,79\)\)\)\) AND `+
+			`EXISTS \(SELECT 1 FROM videos_cast_members vcm WHERE vcm.video_id = v.id AND vcm.cast_member_id IN \(55\)\) AND `+
+			`EXISTS \(SELECT 1 FROM videos_content_descriptors vcd WHERE vcd.video_id = v.id AND vcd.descriptor IN \(\$5,\$6\)\) AND `+
+			`NOT EXISTS \(SELECT 1 FROM videos_content_descriptors vcd WHERE vcd.video_id = v.id AND vcd.descriptor IN \(\$7\)\)\s+`+
+			`ORDER BY v.year_launched DESC, v.id\s+LIMIT \$8 OFFSET \$9`,
+	).WithArgs("%dummy%", "Livre", true, 2000, "FEAR", "VIOLENCE", "EXPLICIT_SEX", 10, 0).WillReturnRows(rows)
+
+	page, err := vg.FindAll(query)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 1, page.Total)
+	assert.True(t, page.IsLast)
+	assert.Len(t, page.Items, 1)
+	assert.Equal(t, aVideo.ID, page.Items[0].ID)
+	assert.Equal(t, aVideo.Rating, page.Items[0].Rating)
+	assert.Equal(t, video.PUBLISHED, page.Items[0].PublicationStatus)
+	assert.Nil(t, page.Items[0].PublishAt)
+	assert.Equal(t, []video.ContentDescriptor{video.FEAR, video.VIOLENCE}, page.Items[0].Descriptors)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestFindAllVideosWhenItFails(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		log.Fatalf("Failed to create DB connection: %s", err)
+	}
+	defer db.Close()
+
+	vg := infra_video.NewVideoGateway(db)
+	expectedErr := errors.New("failed to list videos")
+	query := video.VideoSearchQuery{
+		SearchQuery: domain.SearchQuery{Page: 1, PerPage: 10},
+	}
+
+	mock.ExpectQuery("SELECT COUNT").WithArgs("%%", 10, 0).WillReturnError(expectedErr)
+
+	page, err := vg.FindAll(query)
+
+	assert.Nil(t, page)
+	assert.Equal(t, expectedErr, err)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdateVideo(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		log.Fatalf("Failed to create DB connection: %s", err)
+	}
+	defer db.Close()
+
+	vg := infra_video.NewVideoGateway(db)
+	aVideo := dummyVideo()
+	aVideo.ID = int64(85)
+	aVideo.CategoryIds = []int64{78, 80}
+	aVideo.GenreIds = []int64{39}
+	aVideo.CastMemberIds = []int64{}
+	aVideo.UpdateBannerMedia(video.NewImageMediaWithId(20, "checksum", "banner.png", "/banner.png"))
+	status := video.PENDING
+	aVideo.UpdateVideoMedia(video.NewAudioVideoMediaWith(10, &status, "video-checksum", "movie.mp4", "/movie.mp4", ""))
+	aVideo.Video.Metadata = &video.MediaMetadata{
+		Duration: 5400.5, Width: 1920, Height: 1080, Codecs: []string{"avc1", "mp4a"}, TrackCount: 2,
+	}
+	aVideo.UpdateRatings([]video.TerritoryRating{video.NewTerritoryRating(video.BBFC, "GB", "15")})
+	aVideo.UpdateDescriptors([]video.ContentDescriptor{video.STRONG_LANGUAGE})
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT video_id, trailer_id FROM videos (.+) FOR UPDATE").WithArgs(aVideo.ID).
+		WillReturnRows(sqlmock.NewRows([]string{"video_id", "trailer_id"}).AddRow(10, nil))
+	mock.ExpectExec("UPDATE videos_video_media").WithArgs(
+		"movie.mp4", "video-checksum", "/movie.mp4", "", "PENDING", "", 0,
+		5400.5, 1920, 1080, "avc1,mp4a", 2, int64(10),
+	).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("UPDATE videos_image_media").WithArgs(
+		"banner.png", "checksum", "/banner.png", false, int64(20),
+	).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("UPDATE videos SET").WithArgs(
+		aVideo.Title,
+		aVideo.Description,
+		aVideo.LaunchedAt,
+		aVideo.Opened,
+		aVideo.Rating.String(),
+		aVideo.Duration,
+		aVideo.UpdatedAt,
+		int64(10),
+		nil,
+		int64(20),
+		nil,
+		nil,
+		aVideo.ID,
+	).WillReturnResult(sqlmock.NewResult(0, 1))
+
+	mock.ExpectQuery("SELECT category_id FROM videos_categories").WithArgs(aVideo.ID).
+		WillReturnRows(sqlmock.NewRows([]string{"category_id"}).AddRow(78).AddRow(79))
+	mock.ExpectExec(`DELETE FROM videos_categories WHERE video_id = \$1 AND category_id IN \(79\)`).
+		WithArgs(aVideo.ID).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("INSERT INTO videos_categories").WithArgs(aVideo.ID, int64(80)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	mock.ExpectQuery("SELECT genre_id FROM videos_genres").WithArgs(aVideo.ID).
+		WillReturnRows(sqlmock.NewRows([]string{"genre_id"}).AddRow(39))
+
+	mock.ExpectQuery("SELECT cast_member_id FROM videos_cast_members").WithArgs(aVideo.ID).
+		WillReturnRows(sqlmock.NewRows([]string{"cast_member_id"}).AddRow(55))
+	mock.ExpectExec(`DELETE FROM videos_cast_members WHERE video_id = \$1 AND cast_member_id IN \(55\)`).
+		WithArgs(aVideo.ID).WillReturnResult(sqlmock.NewResult(0, 1))
+
+	mock.ExpectExec("DELETE FROM videos_ratings").WithArgs(aVideo.ID).WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec("INSERT INTO videos_ratings").WithArgs(aVideo.ID, "BBFC", "GB", "15").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	mock.ExpectExec("DELETE FROM videos_content_descriptors").WithArgs(aVideo.ID).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("INSERT INTO videos_content_descriptors").WithArgs(aVideo.ID, "STRONG_LANGUAGE").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	mock.ExpectCommit()
+
+	updatedVideo, err := vg.Update(aVideo)
+
+	assert.Nil(t, err)
+	assert.Equal(t, aVideo.ID, updatedVideo.ID)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdateVideoWhenItsVideoIsReplaced(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		log.Fatalf("Failed to create DB connection: %s", err)
+	}
+	defer db.Close()
+
+	vg := infra_video.NewVideoGateway(db)
+	aVideo := dummyVideo()
+	aVideo.ID = int64(85)
+	aVideo.CategoryIds = []int64{}
+	aVideo.GenreIds = []int64{}
+	aVideo.CastMemberIds = []int64{}
+	status := video.PENDING
+	aVideo.UpdateVideoMedia(video.NewAudioVideoMediaWith(0, &status, "new-checksum", "movie.mp4", "/movie.mp4", ""))
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT video_id, trailer_id FROM videos (.+) FOR UPDATE").WithArgs(aVideo.ID).
+		WillReturnRows(sqlmock.NewRows([]string{"video_id", "trailer_id"}).AddRow(10, 11))
+	mock.ExpectQuery("INSERT INTO videos_video_media").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(12))
+	mock.ExpectExec("UPDATE videos SET").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("DELETE FROM videos_video_media (.+) NOT EXISTS").WithArgs(int64(10)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("DELETE FROM videos_video_media (.+) NOT EXISTS").WithArgs(int64(11)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery("SELECT category_id FROM videos_categories").WithArgs(aVideo.ID).
+		WillReturnRows(sqlmock.NewRows([]string{"category_id"}))
+	mock.ExpectQuery("SELECT genre_id FROM videos_genres").WithArgs(aVideo.ID).
+		WillReturnRows(sqlmock.NewRows([]string{"genre_id"}))
+	mock.ExpectQuery("SELECT cast_member_id FROM videos_cast_members").WithArgs(aVideo.ID).
+		WillReturnRows(sqlmock.NewRows([]string{"cast_member_id"}))
+	mock.ExpectExec("DELETE FROM videos_ratings").WithArgs(aVideo.ID).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("DELETE FROM videos_content_descriptors").WithArgs(aVideo.ID).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+
+	updatedVideo, err := vg.Update(aVideo)
+
+	assert.Nil(t, err)
+	assert.Equal(t, int64(12), updatedVideo.Video.ID)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdateVideoWhenItIsNotFound(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		log.Fatalf("Failed to create DB connection: %s", err)
+	}
+	defer db.Close()
+
+	vg := infra_video.NewVideoGateway(db)
+	aVideo := dummyVideo()
+	aVideo.ID = int64(85)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT video_id, trailer_id FROM videos (.+) FOR UPDATE").WithArgs(aVideo.ID).
+		WillReturnRows(sqlmock.NewRows([]string{"video_id", "trailer_id"}))
+	mock.ExpectRollback()
+
+	updatedVideo, err := vg.Update(aVideo)
+
+	assert.Nil(t, updatedVideo)
+	assert.Equal(t, video.ErrVideoNotFound, err)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestDeleteVideoById(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		log.Fatalf("Failed to create DB connection: %s", err)
+	}
+	defer db.Close()
+
+	vg := infra_video.NewVideoGateway(db)
+	videoId := int64(85)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT video_id, trailer_id, banner_id, thumbnail_id, thumbnail_half_id FROM videos").
+		WithArgs(videoId).
+		WillReturnRows(sqlmock.NewRows(make([]string, 5)).AddRow(int64(10), nil, int64(20), int64(21), nil))
+	mock.ExpectExec("DELETE FROM videos_categories").WithArgs(videoId).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("DELETE FROM videos_genres").WithArgs(videoId).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("DELETE FROM videos_cast_members").WithArgs(videoId).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("DELETE FROM videos_ratings").WithArgs(videoId).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("DELETE FROM videos_content_descriptors").WithArgs(videoId).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("DELETE FROM videos_text_tracks").WithArgs(videoId).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("DELETE FROM videos_audio_tracks (.+) DELETE FROM videos_video_media").WithArgs(videoId).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec("DELETE FROM videos_extras (.+) DELETE FROM videos_video_media (.+) NOT IN").WithArgs(videoId).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("DELETE FROM videos_artworks (.+) DELETE FROM videos_image_media").WithArgs(videoId).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("DELETE FROM videos WHERE").WithArgs(videoId).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`DELETE FROM videos_video_media WHERE id IN \(10\)`).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`DELETE FROM videos_image_media WHERE id IN \(20,21\)`).WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectCommit()
+
+	err = vg.DeleteById(videoId)
+
+	assert.Nil(t, err)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestDeleteVideoByIdWhenItIsNotFound(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		log.Fatalf("Failed to create DB connection: %s", err)
+	}
+	defer db.Close()
+
+	vg := infra_video.NewVideoGateway(db)
+	videoId := int64(85)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT video_id").WithArgs(videoId).WillReturnError(sql.ErrNoRows)
+	mock.ExpectRollback()
+
+	err = vg.DeleteById(videoId)
+
+	assert.ErrorIs(t, err, video.ErrVideoNotFound)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestDeleteVideoByIdWhenItFails(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		log.Fatalf("Failed to create DB connection: %s", err)
+	}
+	defer db.Close()
+
+	vg := infra_video.NewVideoGateway(db)
+	videoId := int64(85)
+	expectedErr := errors.New("failed to delete genres")
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT video_id").WithArgs(videoId).
+		WillReturnRows(sqlmock.NewRows(make([]string, 5)).AddRow(nil, nil, nil, nil, nil))
+	mock.ExpectExec("DELETE FROM videos_categories").WithArgs(videoId).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("DELETE FROM videos_genres").WithArgs(videoId).WillReturnError(expectedErr)
+	mock.ExpectRollback()
+
+	err = vg.DeleteById(videoId)
+
+	assert.Equal(t, expectedErr, err)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
 func dummyVideo() video.Video {
 	return *video.NewVideo(
 		"dummy title",
@@ -112,10 +581,536 @@ func dummyVideo() video.Video {
 		2024,
 		120.0,
 		true,
-		true,
 		video.L,
 		[]int64{78},
 		[]int64{39},
 		[]int64{55},
 	)
 }
+
+func TestUpdateMediaStatus(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		log.Fatalf("Failed to create DB connection: %s", err)
+	}
+	defer db.Close()
+
+	vg := infra_video.NewVideoGateway(db)
+	status := video.COMPLETED
+	media := video.NewAudioVideoMediaWith(12, &status, "sum", "movie.mp4", "/raw/movie.mp4", "/encoded/movie")
+	media.Attempts = 1
+	media.Renditions = []video.Rendition{
+		*video.NewRendition(800000, 640, 360, []string{"avc1.42c01e", "mp4a.40.2"}, "/encoded/movie/360p", 6),
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE videos_video_media").WithArgs("COMPLETED", "/encoded/movie", "", 1, int64(12)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("DELETE FROM videos_media_renditions").WithArgs(int64(12)).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("INSERT INTO videos_media_renditions").
+		WithArgs(int64(12), int64(800000), 640, 360, "avc1.42c01e,mp4a.40.2", "/encoded/movie/360p", 6.0).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	err = vg.UpdateMediaStatus(*media)
+
+	assert.Nil(t, err)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdateMediaStatusWhenMediaIsNotFound(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		log.Fatalf("Failed to create DB connection: %s", err)
+	}
+	defer db.Close()
+
+	vg := infra_video.NewVideoGateway(db)
+	status := video.PROCESSING
+	media := video.NewAudioVideoMediaWith(12, &status, "sum", "movie.mp4", "/raw/movie.mp4", "")
+
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE videos_video_media").WithArgs("PROCESSING", "", "", 0, int64(12)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectRollback()
+
+	err = vg.UpdateMediaStatus(*media)
+
+	assert.ErrorIs(t, err, video.ErrResourceNotFound)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestCountMediaReferences(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		log.Fatalf("Failed to create DB connection: %s", err)
+	}
+	defer db.Close()
+
+	vg := infra_video.NewVideoGateway(db)
+
+	mock.ExpectQuery("SELECT (.+) FROM videos_video_media (.+) FROM videos_image_media (.+) FROM videos_text_tracks").WithArgs("sum").
+		WillReturnRows(sqlmock.NewRows([]string{"references"}).AddRow(3))
+
+	references, err := vg.CountMediaReferences("sum")
+
+	assert.Nil(t, err)
+	assert.Equal(t, int64(3), references)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdatePublication(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		log.Fatalf("Failed to create DB connection: %s", err)
+	}
+	defer db.Close()
+
+	vg := infra_video.NewVideoGateway(db)
+	aVideo := dummyVideo()
+	aVideo.ID = int64(85)
+	aVideo.Submit()
+	aVideo.Approve(time.Now(), time.Now())
+
+	mock.ExpectExec(`UPDATE videos SET publication_status(.+)WHERE id = \$5 AND publication_status = \$6`).
+		WithArgs("PUBLISHED", aVideo.PublishAt, true, aVideo.UpdatedAt, aVideo.ID, "IN_REVIEW").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err = vg.UpdatePublication(aVideo, video.IN_REVIEW)
+
+	assert.Nil(t, err)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdatePublicationWhenVideoIsNotFound(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		log.Fatalf("Failed to create DB connection: %s", err)
+	}
+	defer db.Close()
+
+	vg := infra_video.NewVideoGateway(db)
+	aVideo := dummyVideo()
+	aVideo.ID = int64(85)
+
+	mock.ExpectExec("UPDATE videos SET publication_status").
+		WithArgs("DRAFT", nil, false, aVideo.UpdatedAt, aVideo.ID, "IN_REVIEW").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery("SELECT EXISTS").WithArgs(aVideo.ID).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+
+	err = vg.UpdatePublication(aVideo, video.IN_REVIEW)
+
+	assert.Equal(t, video.ErrVideoNotFound, err)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdatePublicationWhenStatusChangedConcurrently(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		log.Fatalf("Failed to create DB connection: %s", err)
+	}
+	defer db.Close()
+
+	vg := infra_video.NewVideoGateway(db)
+	aVideo := dummyVideo()
+	aVideo.ID = int64(85)
+	aVideo.Submit()
+
+	mock.ExpectExec("UPDATE videos SET publication_status").
+		WithArgs("IN_REVIEW", nil, false, aVideo.UpdatedAt, aVideo.ID, "DRAFT").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery("SELECT EXISTS").WithArgs(aVideo.ID).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+
+	err = vg.UpdatePublication(aVideo, video.DRAFT)
+
+	assert.Equal(t, video.ErrPublicationConflict, err)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestFindDueForPublication(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		log.Fatalf("Failed to create DB connection: %s", err)
+	}
+	defer db.Close()
+
+	vg := infra_video.NewVideoGateway(db)
+	now := time.Now().UTC()
+
+	mock.ExpectQuery(`SELECT id FROM videos WHERE publication_status = 'SCHEDULED' AND publish_at <= \$1`).
+		WithArgs(now).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(int64(3)).AddRow(int64(7)))
+
+	ids, err := vg.FindDueForPublication(now)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []int64{3, 7}, ids)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestSaveTextTrack(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		log.Fatalf("Failed to create DB connection: %s", err)
+	}
+	defer db.Close()
+
+	vg := infra_video.NewVideoGateway(db)
+	track := video.TextTrack{
+		Language: "pt-BR",
+		Kind:     video.CAPTIONS,
+		Checksum: "sum",
+		Name:     "movie.vtt",
+		Location: "/subtitle/pt-BR/captions/movie.vtt",
+		Cues:     12,
+	}
+
+	mock.ExpectExec("INSERT INTO videos_text_tracks (.+) ON CONFLICT").
+		WithArgs(int64(10), "pt-BR", "CAPTIONS", "sum", "movie.vtt", track.Location, 12).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err = vg.SaveTextTrack(10, track)
+
+	assert.Nil(t, err)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestFindTextTracks(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		log.Fatalf("Failed to create DB connection: %s", err)
+	}
+	defer db.Close()
+
+	vg := infra_video.NewVideoGateway(db)
+
+	rows := sqlmock.NewRows([]string{"language", "kind", "checksum", "name", "file_path", "cues"}).
+		AddRow("en", "SUBTITLES", "sum1", "movie.vtt", "/en/subtitles/movie.vtt", 10).
+		AddRow("pt-BR", "FORCED", "sum2", "movie.vtt", "/pt-BR/forced/movie.vtt", 2)
+	mock.ExpectQuery("SELECT (.+) FROM videos_text_tracks WHERE video_id").WithArgs(int64(10)).WillReturnRows(rows)
+
+	tracks, err := vg.FindTextTracks(10)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []video.TextTrack{
+		{Language: "en", Kind: video.SUBTITLES, Checksum: "sum1", Name: "movie.vtt", Location: "/en/subtitles/movie.vtt", Cues: 10},
+		{Language: "pt-BR", Kind: video.FORCED, Checksum: "sum2", Name: "movie.vtt", Location: "/pt-BR/forced/movie.vtt", Cues: 2},
+	}, tracks)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestSaveAudioTrack(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		log.Fatalf("Failed to create DB connection: %s", err)
+	}
+	defer db.Close()
+
+	vg := infra_video.NewVideoGateway(db)
+	status := video.PENDING
+	media := video.NewAudioVideoMediaWith(0, &status, "sum", "movie.es.mp4", "/audio/es/movie.es.mp4", "")
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("INSERT INTO videos_video_media").
+		WithArgs("movie.es.mp4", "sum", "/audio/es/movie.es.mp4", "", "PENDING", "", 0, nil, nil, nil, nil, nil).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(30))
+	mock.ExpectExec("INSERT INTO videos_audio_tracks (.+) ON CONFLICT").WithArgs(int64(10), "es", "DUB", int64(30)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	track, err := vg.SaveAudioTrack(10, *video.NewAudioTrack("es", video.DUB, media))
+
+	assert.Nil(t, err)
+	assert.Equal(t, int64(30), track.Media.ID)
+	assert.Equal(t, int64(0), media.ID)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestDeleteAudioTrack(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		log.Fatalf("Failed to create DB connection: %s", err)
+	}
+	defer db.Close()
+
+	vg := infra_video.NewVideoGateway(db)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("DELETE FROM videos_audio_tracks (.+) RETURNING video_media_id").WithArgs(int64(10), "es").
+		WillReturnRows(sqlmock.NewRows([]string{"video_media_id"}).AddRow(30))
+	mock.ExpectExec("DELETE FROM videos_video_media WHERE id").WithArgs(int64(30)).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	err = vg.DeleteAudioTrack(10, "es")
+
+	assert.Nil(t, err)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestDeleteAudioTrackWhenNotFound(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		log.Fatalf("Failed to create DB connection: %s", err)
+	}
+	defer db.Close()
+
+	vg := infra_video.NewVideoGateway(db)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("DELETE FROM videos_audio_tracks").WithArgs(int64(10), "es").
+		WillReturnRows(sqlmock.NewRows([]string{"video_media_id"}))
+	mock.ExpectRollback()
+
+	err = vg.DeleteAudioTrack(10, "es")
+
+	assert.ErrorIs(t, err, video.ErrResourceNotFound)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestSaveExtra(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		log.Fatalf("Failed to create DB connection: %s", err)
+	}
+	defer db.Close()
+
+	vg := infra_video.NewVideoGateway(db)
+	extra := video.Extra{Type: video.EXTRA_TEASER, Title: "Teaser", Position: 2}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("INSERT INTO videos_extras").WithArgs(int64(10), "TEASER", "Teaser", 2, nil).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(5))
+	mock.ExpectCommit()
+
+	saved, err := vg.SaveExtra(10, extra)
+
+	assert.Nil(t, err)
+	assert.Equal(t, int64(5), saved.ID)
+	assert.Nil(t, saved.Media)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestSaveExtraWithMedia(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		log.Fatalf("Failed to create DB connection: %s", err)
+	}
+	defer db.Close()
+
+	vg := infra_video.NewVideoGateway(db)
+	status := video.PENDING
+	media := video.NewAudioVideoMediaWith(0, &status, "sum", "teaser.mp4", "/extras/5/teaser.mp4", "")
+	extra := video.Extra{ID: 5, Type: video.EXTRA_TEASER, Title: "Teaser", Position: 1, Media: media}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("INSERT INTO videos_video_media").
+		WithArgs("teaser.mp4", "sum", "/extras/5/teaser.mp4", "", "PENDING", "", 0, nil, nil, nil, nil, nil).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(40))
+	mock.ExpectExec("UPDATE videos_extras SET").WithArgs("TEASER", "Teaser", 1, int64(40), int64(5), int64(10)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	saved, err := vg.SaveExtra(10, extra)
+
+	assert.Nil(t, err)
+	assert.Equal(t, int64(40), saved.Media.ID)
+	assert.Equal(t, int64(0), media.ID)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestSaveExtraWhenNotFound(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		log.Fatalf("Failed to create DB connection: %s", err)
+	}
+	defer db.Close()
+
+	vg := infra_video.NewVideoGateway(db)
+
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE videos_extras SET").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectRollback()
+
+	_, err = vg.SaveExtra(10, video.Extra{ID: 5, Type: video.EXTRA_CLIP, Title: "Clip", Position: 1})
+
+	assert.ErrorIs(t, err, video.ErrExtraNotFound)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestDeleteExtra(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		log.Fatalf("Failed to create DB connection: %s", err)
+	}
+	defer db.Close()
+
+	vg := infra_video.NewVideoGateway(db)
+
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE videos SET trailer_id = NULL").WithArgs(int64(10), int64(5)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery("DELETE FROM videos_extras (.+) RETURNING video_media_id, position").WithArgs(int64(5), int64(10)).
+		WillReturnRows(sqlmock.NewRows([]string{"video_media_id", "position"}).AddRow(40, 2))
+	mock.ExpectExec("UPDATE videos_extras SET position = position - 1").WithArgs(int64(10), 2).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("DELETE FROM videos_video_media WHERE id").WithArgs(int64(40)).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	err = vg.DeleteExtra(10, 5)
+
+	assert.Nil(t, err)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestDeleteExtraWhenNotFound(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		log.Fatalf("Failed to create DB connection: %s", err)
+	}
+	defer db.Close()
+
+	vg := infra_video.NewVideoGateway(db)
+
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE videos SET trailer_id = NULL").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery("DELETE FROM videos_extras").WithArgs(int64(5), int64(10)).
+		WillReturnRows(sqlmock.NewRows([]string{"video_media_id", "position"}))
+	mock.ExpectRollback()
+
+	err = vg.DeleteExtra(10, 5)
+
+	assert.ErrorIs(t, err, video.ErrExtraNotFound)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestReorderExtras(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		log.Fatalf("Failed to create DB connection: %s", err)
+	}
+	defer db.Close()
+
+	vg := infra_video.NewVideoGateway(db)
+
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE videos_extras SET position").WithArgs(1, int64(6), int64(10)).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("UPDATE videos_extras SET position").WithArgs(2, int64(5), int64(10)).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	err = vg.ReorderExtras(10, []video.Extra{{ID: 6, Position: 1}, {ID: 5, Position: 2}})
+
+	assert.Nil(t, err)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdatePrimaryTrailer(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		log.Fatalf("Failed to create DB connection: %s", err)
+	}
+	defer db.Close()
+
+	vg := infra_video.NewVideoGateway(db)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT trailer_id FROM videos (.+) FOR UPDATE").WithArgs(int64(10)).
+		WillReturnRows(sqlmock.NewRows([]string{"trailer_id"}).AddRow(11))
+	mock.ExpectExec("UPDATE videos SET trailer_id").WithArgs(int64(40), int64(10)).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("DELETE FROM videos_video_media (.+) NOT EXISTS").WithArgs(int64(11)).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	err = vg.UpdatePrimaryTrailer(10, 40)
+
+	assert.Nil(t, err)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdatePrimaryTrailerWhenVideoIsNotFound(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		log.Fatalf("Failed to create DB connection: %s", err)
+	}
+	defer db.Close()
+
+	vg := infra_video.NewVideoGateway(db)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT trailer_id FROM videos").WithArgs(int64(10)).WillReturnRows(sqlmock.NewRows([]string{"trailer_id"}))
+	mock.ExpectRollback()
+
+	err = vg.UpdatePrimaryTrailer(10, 40)
+
+	assert.ErrorIs(t, err, video.ErrVideoNotFound)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestSaveArtwork(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		log.Fatalf("Failed to create DB connection: %s", err)
+	}
+	defer db.Close()
+
+	vg := infra_video.NewVideoGateway(db)
+	image := video.NewImageMediaWithoutId("sum", "poster.png", "/artwork/poster/neutral/1000/poster.png")
+	artwork := video.NewArtwork(video.POSTER, "", 1000, 1500, image)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("INSERT INTO videos_image_media").WithArgs("poster.png", "sum", "/artwork/poster/neutral/1000/poster.png", false).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(50))
+	mock.ExpectQuery("INSERT INTO videos_artworks (.+) ON CONFLICT").
+		WithArgs(int64(10), "POSTER", "", "2:3", 1000, 1500, int64(50)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(7))
+	mock.ExpectCommit()
+
+	saved, err := vg.SaveArtwork(10, *artwork)
+
+	assert.Nil(t, err)
+	assert.Equal(t, int64(7), saved.ID)
+	assert.Equal(t, int64(50), saved.Image.ID)
+	assert.Equal(t, int64(0), image.ID)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestDeleteArtwork(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		log.Fatalf("Failed to create DB connection: %s", err)
+	}
+	defer db.Close()
+
+	vg := infra_video.NewVideoGateway(db)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("DELETE FROM videos_artworks (.+) RETURNING image_media_id").WithArgs(int64(7), int64(10)).
+		WillReturnRows(sqlmock.NewRows([]string{"image_media_id"}).AddRow(50))
+	mock.ExpectExec("DELETE FROM videos_image_media WHERE id").WithArgs(int64(50)).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	err = vg.DeleteArtwork(10, 7)
+
+	assert.Nil(t, err)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestDeleteArtworkWhenNotFound(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		log.Fatalf("Failed to create DB connection: %s", err)
+	}
+	defer db.Close()
+
+	vg := infra_video.NewVideoGateway(db)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("DELETE FROM videos_artworks").WithArgs(int64(7), int64(10)).
+		WillReturnRows(sqlmock.NewRows([]string{"image_media_id"}))
+	mock.ExpectRollback()
+
+	err = vg.DeleteArtwork(10, 7)
+
+	assert.ErrorIs(t, err, video.ErrArtworkNotFound)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
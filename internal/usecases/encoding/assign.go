@@ -0,0 +1,149 @@
+package encoding_usecase
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/category"
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/encoding"
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/video"
+	"github.com.br/gibranct/admin_do_catalogo/pkg/notification"
+)
+
+// a nil profile removes the assignment
+type AssignVideoProfileCommand struct {
+	VideoId   int64
+	ProfileId *int64
+}
+
+type AssignCategoryProfileCommand struct {
+	CategoryId int64
+	ProfileId  *int64
+}
+
+type ResolvedProfileOutput struct {
+	Source  string         `json:"source"`
+	Profile *ProfileOutput `json:"profile"`
+}
+
+type AssignVideoProfileUseCase interface {
+	Execute(c AssignVideoProfileCommand) *notification.Notification
+}
+
+type AssignCategoryProfileUseCase interface {
+	Execute(c AssignCategoryProfileCommand) *notification.Notification
+}
+
+type GetVideoProfileUseCase interface {
+	Execute(videoId int64) (*ResolvedProfileOutput, error)
+}
+
+type DefaultAssignVideoProfileUseCase struct {
+	Gateway      encoding.ProfileGateway
+	VideoGateway video.VideoGateway
+}
+
+type DefaultAssignCategoryProfileUseCase struct {
+	Gateway         encoding.ProfileGateway
+	CategoryGateway category.CategoryGateway
+}
+
+type DefaultGetVideoProfileUseCase struct {
+	Gateway      encoding.ProfileGateway
+	VideoGateway video.VideoGateway
+}
+
+func (useCase DefaultAssignVideoProfileUseCase) Execute(command AssignVideoProfileCommand) *notification.Notification {
+	n := notification.CreateNotification()
+
+	if _, err := useCase.VideoGateway.FindById(command.VideoId); err != nil {
+		n.Add(err)
+		return n
+	}
+
+	if err := checkProfile(useCase.Gateway, command.ProfileId); err != nil {
+		n.Add(err)
+		return n
+	}
+
+	if err := useCase.Gateway.AssignToVideo(command.VideoId, command.ProfileId); err != nil {
+		n.Add(err)
+		return n
+	}
+
+	return nil
+}
+
+func (useCase DefaultAssignCategoryProfileUseCase) Execute(command AssignCategoryProfileCommand) *notification.Notification {
+	n := notification.CreateNotification()
+
+	ids, err := useCase.CategoryGateway.ExistsByIds([]int64{command.CategoryId})
+	if err != nil {
+		n.Add(err)
+		return n
+	}
+
+	if !slices.Contains(ids, command.CategoryId) {
+		n.Add(fmt.Errorf("%w: %d", category.ErrCategoryNotFound, command.CategoryId))
+		return n
+	}
+
+	if err = checkProfile(useCase.Gateway, command.ProfileId); err != nil {
+		n.Add(err)
+		return n
+	}
+
+	if err = useCase.Gateway.AssignToCategory(command.CategoryId, command.ProfileId); err != nil {
+		n.Add(err)
+		return n
+	}
+
+	return nil
+}
+
+func (useCase DefaultGetVideoProfileUseCase) Execute(videoId int64) (*ResolvedProfileOutput, error) {
+	if _, err := useCase.VideoGateway.FindById(videoId); err != nil {
+		return nil, err
+	}
+
+	aProfile, source, err := ResolveProfile(useCase.Gateway, videoId)
+	if err != nil {
+		return nil, err
+	}
+
+	if aProfile == nil {
+		return nil, fmt.Errorf("%w: video %d has no profile assigned", encoding.ErrProfileNotFound, videoId)
+	}
+
+	return &ResolvedProfileOutput{Source: source.String(), Profile: toProfileOutput(*aProfile)}, nil
+}
+
+// the profile the encoder should follow for a video, nil when neither the video
+// nor its categories have one so the encoder keeps its own defaults
+func ResolveProfile(gateway encoding.ProfileGateway, videoId int64) (*encoding.Profile, encoding.ProfileSource, error) {
+	assignments, err := gateway.FindAssignments(videoId)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	profileId, source, found := assignments.Resolve()
+	if !found {
+		return nil, 0, nil
+	}
+
+	aProfile, err := gateway.FindById(profileId)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return aProfile, source, nil
+}
+
+func checkProfile(gateway encoding.ProfileGateway, profileId *int64) error {
+	if profileId == nil {
+		return nil
+	}
+
+	_, err := gateway.FindById(*profileId)
+	return err
+}
@@ -0,0 +1,111 @@
+package encoding_usecase_test
+
+import (
+	"testing"
+
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/category"
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/encoding"
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/video"
+	encoding_usecase "github.com.br/gibranct/admin_do_catalogo/internal/usecases/encoding"
+	"github.com.br/gibranct/admin_do_catalogo/pkg/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func storedProfile(id int64) *encoding.Profile {
+	aProfile := encoding.NewProfile("default", []encoding.ProfileRendition{
+		*encoding.NewProfileRendition(640, 360, 800_000, "h264", "fmp4"),
+	})
+	aProfile.ID = id
+	return aProfile
+}
+
+func TestAssignVideoProfile(t *testing.T) {
+	gateway := new(mocks.ProfileGatewayMock)
+	videoGateway := new(mocks.VideoGatewayMock)
+	sut := encoding_usecase.DefaultAssignVideoProfileUseCase{Gateway: gateway, VideoGateway: videoGateway}
+	profileId := int64(3)
+
+	videoGateway.On("FindById", int64(7)).Return(&video.Video{ID: 7}, nil)
+	gateway.On("FindById", profileId).Return(storedProfile(profileId), nil)
+	gateway.On("AssignToVideo", int64(7), &profileId).Return(nil)
+
+	noti := sut.Execute(encoding_usecase.AssignVideoProfileCommand{VideoId: 7, ProfileId: &profileId})
+
+	assert.Nil(t, noti)
+	gateway.AssertExpectations(t)
+}
+
+func TestAssignMissingVideoProfile(t *testing.T) {
+	gateway := new(mocks.ProfileGatewayMock)
+	videoGateway := new(mocks.VideoGatewayMock)
+	sut := encoding_usecase.DefaultAssignVideoProfileUseCase{Gateway: gateway, VideoGateway: videoGateway}
+	profileId := int64(3)
+
+	videoGateway.On("FindById", int64(7)).Return(&video.Video{ID: 7}, nil)
+	gateway.On("FindById", profileId).Return((*encoding.Profile)(nil), encoding.ErrProfileNotFound)
+
+	noti := sut.Execute(encoding_usecase.AssignVideoProfileCommand{VideoId: 7, ProfileId: &profileId})
+
+	assert.ErrorIs(t, noti.GetErrors()[0], encoding.ErrProfileNotFound)
+	gateway.AssertNotCalled(t, "AssignToVideo", mock.Anything, mock.Anything)
+}
+
+func TestUnassignCategoryProfile(t *testing.T) {
+	gateway := new(mocks.ProfileGatewayMock)
+	categoryGateway := new(mocks.CategoryGatewayMock)
+	sut := encoding_usecase.DefaultAssignCategoryProfileUseCase{Gateway: gateway, CategoryGateway: categoryGateway}
+
+	categoryGateway.On("ExistsByIds", []int64{2}).Return([]int64{2}, nil)
+	gateway.On("AssignToCategory", int64(2), (*int64)(nil)).Return(nil)
+
+	noti := sut.Execute(encoding_usecase.AssignCategoryProfileCommand{CategoryId: 2})
+
+	assert.Nil(t, noti)
+	gateway.AssertExpectations(t)
+}
+
+func TestAssignProfileToMissingCategory(t *testing.T) {
+	gateway := new(mocks.ProfileGatewayMock)
+	categoryGateway := new(mocks.CategoryGatewayMock)
+	sut := encoding_usecase.DefaultAssignCategoryProfileUseCase{Gateway: gateway, CategoryGateway: categoryGateway}
+
+	categoryGateway.On("ExistsByIds", []int64{2}).Return([]int64{}, nil)
+
+	noti := sut.Execute(encoding_usecase.AssignCategoryProfileCommand{CategoryId: 2})
+
+	assert.ErrorIs(t, noti.GetErrors()[0], category.ErrCategoryNotFound)
+	assert.EqualError(t, noti.GetErrors()[0], "category not found: 2")
+}
+
+func TestGetVideoProfileFallsBackToCategoryDefault(t *testing.T) {
+	gateway := new(mocks.ProfileGatewayMock)
+	videoGateway := new(mocks.VideoGatewayMock)
+	sut := encoding_usecase.DefaultGetVideoProfileUseCase{Gateway: gateway, VideoGateway: videoGateway}
+
+	videoGateway.On("FindById", int64(7)).Return(&video.Video{ID: 7}, nil)
+	gateway.On("FindAssignments", int64(7)).Return(&encoding.Assignments{
+		CategoryDefaults: []encoding.CategoryDefault{{CategoryId: 5, ProfileId: 4}, {CategoryId: 2, ProfileId: 3}},
+	}, nil)
+	gateway.On("FindById", int64(3)).Return(storedProfile(3), nil)
+
+	output, err := sut.Execute(7)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "CATEGORY", output.Source)
+	assert.Equal(t, int64(3), output.Profile.ID)
+	assert.Equal(t, "640x360", output.Profile.Renditions[0].Resolution)
+}
+
+func TestGetVideoProfileWithoutAssignments(t *testing.T) {
+	gateway := new(mocks.ProfileGatewayMock)
+	videoGateway := new(mocks.VideoGatewayMock)
+	sut := encoding_usecase.DefaultGetVideoProfileUseCase{Gateway: gateway, VideoGateway: videoGateway}
+
+	videoGateway.On("FindById", int64(7)).Return(&video.Video{ID: 7}, nil)
+	gateway.On("FindAssignments", int64(7)).Return(&encoding.Assignments{}, nil)
+
+	_, err := sut.Execute(7)
+
+	assert.ErrorIs(t, err, encoding.ErrProfileNotFound)
+}
@@ -0,0 +1,59 @@
+package encoding_usecase
+
+import (
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/encoding"
+	"github.com.br/gibranct/admin_do_catalogo/pkg/notification"
+)
+
+type RenditionCommand struct {
+	Width     int
+	Height    int
+	Bitrate   int64
+	Codec     string
+	Container string
+}
+
+type CreateProfileOutput struct {
+	ID int64
+}
+
+type CreateProfileCommand struct {
+	Name       string
+	Renditions []RenditionCommand
+}
+
+type CreateProfileUseCase interface {
+	Execute(c CreateProfileCommand) (*notification.Notification, *CreateProfileOutput)
+}
+
+type DefaultCreateProfileUseCase struct {
+	Gateway encoding.ProfileGateway
+}
+
+func (useCase DefaultCreateProfileUseCase) Execute(
+	command CreateProfileCommand,
+) (*notification.Notification, *CreateProfileOutput) {
+	n := notification.CreateNotification()
+
+	aProfile := encoding.NewProfile(command.Name, toRenditions(command.Renditions))
+	aProfile.Validate(n)
+
+	if n.HasErrors() {
+		return n, nil
+	}
+
+	if err := useCase.Gateway.Create(aProfile); err != nil {
+		n.Add(err)
+		return n, nil
+	}
+
+	return nil, &CreateProfileOutput{ID: aProfile.ID}
+}
+
+func toRenditions(commands []RenditionCommand) []encoding.ProfileRendition {
+	renditions := []encoding.ProfileRendition{}
+	for _, c := range commands {
+		renditions = append(renditions, encoding.ProfileRendition(c))
+	}
+	return renditions
+}
@@ -0,0 +1,59 @@
+package encoding_usecase_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/encoding"
+	encoding_usecase "github.com.br/gibranct/admin_do_catalogo/internal/usecases/encoding"
+	"github.com.br/gibranct/admin_do_catalogo/pkg/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func renditionCommands() []encoding_usecase.RenditionCommand {
+	return []encoding_usecase.RenditionCommand{
+		{Width: 1920, Height: 1080, Bitrate: 5_000_000, Codec: "h264", Container: "fmp4"},
+		{Width: 640, Height: 360, Bitrate: 800_000, Codec: "h264", Container: "fmp4"},
+	}
+}
+
+func TestCreateProfile(t *testing.T) {
+	gateway := new(mocks.ProfileGatewayMock)
+	sut := encoding_usecase.DefaultCreateProfileUseCase{Gateway: gateway}
+
+	gateway.On("Create", mock.MatchedBy(func(p *encoding.Profile) bool {
+		p.ID = 3
+		return p.Name == "default" && len(p.Renditions) == 2
+	})).Return(nil)
+
+	noti, output := sut.Execute(encoding_usecase.CreateProfileCommand{Name: "default", Renditions: renditionCommands()})
+
+	assert.Nil(t, noti)
+	assert.Equal(t, int64(3), output.ID)
+	gateway.AssertExpectations(t)
+}
+
+func TestCreateInvalidProfile(t *testing.T) {
+	gateway := new(mocks.ProfileGatewayMock)
+	sut := encoding_usecase.DefaultCreateProfileUseCase{Gateway: gateway}
+
+	noti, output := sut.Execute(encoding_usecase.CreateProfileCommand{Name: "default"})
+
+	assert.Nil(t, output)
+	assert.Len(t, noti.GetErrors(), 1)
+	assert.EqualError(t, noti.GetErrors()[0], "'renditions' should not be empty")
+	gateway.AssertNotCalled(t, "Create", mock.Anything)
+}
+
+func TestCreateProfileWhenGatewayFails(t *testing.T) {
+	gateway := new(mocks.ProfileGatewayMock)
+	sut := encoding_usecase.DefaultCreateProfileUseCase{Gateway: gateway}
+
+	gateway.On("Create", mock.Anything).Return(errors.New("duplicate name"))
+
+	noti, output := sut.Execute(encoding_usecase.CreateProfileCommand{Name: "default", Renditions: renditionCommands()})
+
+	assert.Nil(t, output)
+	assert.EqualError(t, noti.GetErrors()[0], "duplicate name")
+}
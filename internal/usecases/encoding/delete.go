@@ -0,0 +1,17 @@
+package encoding_usecase
+
+import (
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/encoding"
+)
+
+type DeleteProfileUseCase interface {
+	Execute(profileId int64) error
+}
+
+type DefaultDeleteProfileUseCase struct {
+	Gateway encoding.ProfileGateway
+}
+
+func (useCase DefaultDeleteProfileUseCase) Execute(profileId int64) error {
+	return useCase.Gateway.DeleteById(profileId)
+}
@@ -0,0 +1,42 @@
+package encoding_usecase
+
+import (
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain"
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/encoding"
+)
+
+var sortableColumns = []string{"name", "created_at"}
+
+type ListProfilesUseCase interface {
+	Execute(query domain.SearchQuery) (*domain.Pagination[ProfileOutput], error)
+}
+
+type DefaultListProfilesUseCase struct {
+	Gateway encoding.ProfileGateway
+}
+
+func (useCase DefaultListProfilesUseCase) Execute(query domain.SearchQuery) (*domain.Pagination[ProfileOutput], error) {
+	if err := query.ValidateSortableBy(sortableColumns); err != nil {
+		return nil, err
+	}
+
+	page, err := useCase.Gateway.FindAll(query)
+
+	if err != nil {
+		return nil, err
+	}
+
+	outputs := []*ProfileOutput{}
+
+	for _, item := range page.Items {
+		outputs = append(outputs, toProfileOutput(*item))
+	}
+
+	return &domain.Pagination[ProfileOutput]{
+		Items:       outputs,
+		CurrentPage: page.CurrentPage,
+		PerPage:     page.PerPage,
+		Total:       page.Total,
+		IsLast:      page.IsLast,
+	}, nil
+}
@@ -0,0 +1,64 @@
+package encoding_usecase
+
+import (
+	"time"
+
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/encoding"
+)
+
+type RenditionOutput struct {
+	Width      int    `json:"width"`
+	Height     int    `json:"height"`
+	Resolution string `json:"resolution"`
+	Bitrate    int64  `json:"bitrate"`
+	Codec      string `json:"codec"`
+	Container  string `json:"container"`
+}
+
+type ProfileOutput struct {
+	ID         int64             `json:"id"`
+	Name       string            `json:"name"`
+	Renditions []RenditionOutput `json:"renditions"`
+	CreatedAt  time.Time         `json:"createdAt"`
+	UpdatedAt  time.Time         `json:"updatedAt"`
+}
+
+type GetProfileByIdUseCase interface {
+	Execute(profileId int64) (*ProfileOutput, error)
+}
+
+type DefaultGetProfileByIdUseCase struct {
+	Gateway encoding.ProfileGateway
+}
+
+func (useCase DefaultGetProfileByIdUseCase) Execute(profileId int64) (*ProfileOutput, error) {
+	aProfile, err := useCase.Gateway.FindById(profileId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return toProfileOutput(*aProfile), nil
+}
+
+func toProfileOutput(aProfile encoding.Profile) *ProfileOutput {
+	renditions := []RenditionOutput{}
+	for _, r := range aProfile.Renditions {
+		renditions = append(renditions, RenditionOutput{
+			Width:      r.Width,
+			Height:     r.Height,
+			Resolution: r.Resolution(),
+			Bitrate:    r.Bitrate,
+			Codec:      r.Codec,
+			Container:  r.Container,
+		})
+	}
+
+	return &ProfileOutput{
+		ID:         aProfile.ID,
+		Name:       aProfile.Name,
+		Renditions: renditions,
+		CreatedAt:  aProfile.CreatedAt,
+		UpdatedAt:  aProfile.UpdatedAt,
+	}
+}
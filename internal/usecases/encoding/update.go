@@ -0,0 +1,45 @@
+package encoding_usecase
+
+import (
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/encoding"
+	"github.com.br/gibranct/admin_do_catalogo/pkg/notification"
+)
+
+type UpdateProfileCommand struct {
+	ID         int64
+	Name       string
+	Renditions []RenditionCommand
+}
+
+type UpdateProfileUseCase interface {
+	Execute(c UpdateProfileCommand) *notification.Notification
+}
+
+type DefaultUpdateProfileUseCase struct {
+	Gateway encoding.ProfileGateway
+}
+
+func (useCase DefaultUpdateProfileUseCase) Execute(command UpdateProfileCommand) *notification.Notification {
+	n := notification.CreateNotification()
+
+	aProfile, err := useCase.Gateway.FindById(command.ID)
+
+	if err != nil {
+		n.Add(err)
+		return n
+	}
+
+	aProfile.Update(command.Name, toRenditions(command.Renditions))
+	aProfile.Validate(n)
+
+	if n.HasErrors() {
+		return n
+	}
+
+	if err = useCase.Gateway.Update(*aProfile); err != nil {
+		n.Add(err)
+		return n
+	}
+
+	return nil
+}
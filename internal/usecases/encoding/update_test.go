@@ -0,0 +1,40 @@
+package encoding_usecase_test
+
+import (
+	"testing"
+
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/encoding"
+	encoding_usecase "github.com.br/gibranct/admin_do_catalogo/internal/usecases/encoding"
+	"github.com.br/gibranct/admin_do_catalogo/pkg/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestUpdateProfile(t *testing.T) {
+	gateway := new(mocks.ProfileGatewayMock)
+	sut := encoding_usecase.DefaultUpdateProfileUseCase{Gateway: gateway}
+	aProfile := encoding.NewProfile("default", nil)
+	aProfile.ID = 3
+
+	gateway.On("FindById", int64(3)).Return(aProfile, nil)
+	gateway.On("Update", mock.MatchedBy(func(p encoding.Profile) bool {
+		return p.ID == 3 && p.Name == "mobile" && len(p.Renditions) == 1
+	})).Return(nil)
+
+	noti := sut.Execute(encoding_usecase.UpdateProfileCommand{ID: 3, Name: "mobile", Renditions: renditionCommands()[1:]})
+
+	assert.Nil(t, noti)
+	gateway.AssertExpectations(t)
+}
+
+func TestUpdateProfileWhenItIsNotFound(t *testing.T) {
+	gateway := new(mocks.ProfileGatewayMock)
+	sut := encoding_usecase.DefaultUpdateProfileUseCase{Gateway: gateway}
+
+	gateway.On("FindById", int64(3)).Return((*encoding.Profile)(nil), encoding.ErrProfileNotFound)
+
+	noti := sut.Execute(encoding_usecase.UpdateProfileCommand{ID: 3, Name: "mobile", Renditions: renditionCommands()})
+
+	assert.ErrorIs(t, noti.GetErrors()[0], encoding.ErrProfileNotFound)
+	gateway.AssertNotCalled(t, "Update", mock.Anything)
+}
@@ -0,0 +1,89 @@
+package series_usecase
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/category"
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/genre"
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/series"
+	"github.com.br/gibranct/admin_do_catalogo/pkg/notification"
+)
+
+type CreateSeriesOutput struct {
+	ID int64
+}
+
+type CreateSeriesCommand struct {
+	Title       string
+	Description string
+	CategoryIds []int64
+	GenreIds    []int64
+}
+
+type CreateSeriesUseCase interface {
+	Execute(c CreateSeriesCommand) (*notification.Notification, *CreateSeriesOutput)
+}
+
+type DefaultCreateSeriesUseCase struct {
+	Gateway         series.SeriesGateway
+	CategoryGateway category.CategoryGateway
+	GenreGateway    genre.GenreGateway
+}
+
+func (useCase DefaultCreateSeriesUseCase) Execute(
+	command CreateSeriesCommand,
+) (*notification.Notification, *CreateSeriesOutput) {
+	n := notification.CreateNotification()
+
+	aSeries := series.NewSeries(command.Title, command.Description, command.CategoryIds, command.GenreIds)
+	aSeries.Validate(n)
+
+	if n.HasErrors() {
+		return n, nil
+	}
+
+	n.Append(validateAggregate("categories", command.CategoryIds, useCase.CategoryGateway.ExistsByIds))
+	n.Append(validateAggregate("genres", command.GenreIds, useCase.GenreGateway.ExistsByIds))
+
+	if n.HasErrors() {
+		return n, nil
+	}
+
+	if err := useCase.Gateway.Create(aSeries); err != nil {
+		n.Add(err)
+		return n, nil
+	}
+
+	return nil, &CreateSeriesOutput{ID: aSeries.ID}
+}
+
+func validateAggregate(aggregate string, ids []int64, fn func(ids []int64) ([]int64, error)) *notification.Notification {
+	n := notification.CreateNotification()
+	if len(ids) == 0 {
+		return n
+	}
+
+	retrievedIds, err := fn(ids)
+
+	if err != nil {
+		n.Add(err)
+		return n
+	}
+
+	var missingIds []string
+
+	for _, id := range ids {
+		if !slices.Contains(retrievedIds, id) {
+			missingIds = append(missingIds, strconv.FormatInt(id, 10))
+		}
+	}
+
+	if len(missingIds) != 0 {
+		n.Add(fmt.Errorf("missing %s ids: %s", aggregate, strings.Join(missingIds, ",")))
+	}
+
+	return n
+}
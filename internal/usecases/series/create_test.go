@@ -0,0 +1,68 @@
+package series_usecase_test
+
+import (
+	"testing"
+
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/series"
+	series_usecase "github.com.br/gibranct/admin_do_catalogo/internal/usecases/series"
+	"github.com.br/gibranct/admin_do_catalogo/pkg/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCreateSeries(t *testing.T) {
+	seriesGateway := new(mocks.SeriesGatewayMock)
+	categoryGateway := new(mocks.CategoryGatewayMock)
+	genreGateway := new(mocks.GenreGatewayMock)
+	sut := series_usecase.DefaultCreateSeriesUseCase{
+		Gateway:         seriesGateway,
+		CategoryGateway: categoryGateway,
+		GenreGateway:    genreGateway,
+	}
+	command := series_usecase.CreateSeriesCommand{
+		Title:       "Dark",
+		Description: "time travel",
+		CategoryIds: []int64{1},
+		GenreIds:    []int64{2},
+	}
+
+	categoryGateway.On("ExistsByIds", command.CategoryIds).Return(command.CategoryIds, nil)
+	genreGateway.On("ExistsByIds", command.GenreIds).Return(command.GenreIds, nil)
+	seriesGateway.On("Create", mock.MatchedBy(func(s *series.Series) bool {
+		s.ID = 7
+		return s.Title == command.Title
+	})).Return(nil)
+
+	noti, output := sut.Execute(command)
+
+	assert.Nil(t, noti)
+	assert.Equal(t, int64(7), output.ID)
+	seriesGateway.AssertExpectations(t)
+}
+
+func TestCreateSeriesWithMissingRelations(t *testing.T) {
+	seriesGateway := new(mocks.SeriesGatewayMock)
+	categoryGateway := new(mocks.CategoryGatewayMock)
+	genreGateway := new(mocks.GenreGatewayMock)
+	sut := series_usecase.DefaultCreateSeriesUseCase{
+		Gateway:         seriesGateway,
+		CategoryGateway: categoryGateway,
+		GenreGateway:    genreGateway,
+	}
+	command := series_usecase.CreateSeriesCommand{
+		Title:       "Dark",
+		CategoryIds: []int64{1, 3},
+		GenreIds:    []int64{2},
+	}
+
+	categoryGateway.On("ExistsByIds", command.CategoryIds).Return([]int64{1}, nil)
+	genreGateway.On("ExistsByIds", command.GenreIds).Return([]int64{}, nil)
+
+	noti, output := sut.Execute(command)
+
+	assert.Nil(t, output)
+	assert.Len(t, noti.GetErrors(), 2)
+	assert.EqualError(t, noti.GetErrors()[0], "missing categories ids: 3")
+	assert.EqualError(t, noti.GetErrors()[1], "missing genres ids: 2")
+	seriesGateway.AssertNotCalled(t, "Create", mock.Anything)
+}
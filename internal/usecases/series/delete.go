@@ -0,0 +1,17 @@
+package series_usecase
+
+import (
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/series"
+)
+
+type DeleteSeriesUseCase interface {
+	Execute(seriesId int64) error
+}
+
+type DefaultDeleteSeriesUseCase struct {
+	Gateway series.SeriesGateway
+}
+
+func (useCase DefaultDeleteSeriesUseCase) Execute(seriesId int64) error {
+	return useCase.Gateway.DeleteById(seriesId)
+}
@@ -0,0 +1,62 @@
+package series_usecase
+
+import (
+	"time"
+
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain"
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/series"
+)
+
+var sortableColumns = []string{"title", "created_at"}
+
+type ListSeriesOutput struct {
+	ID          int64     `json:"id"`
+	Title       string    `json:"title"`
+	Description string    `json:"description"`
+	CreatedAt   time.Time `json:"createdAt"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+}
+
+type ListSeriesUseCase interface {
+	Execute(query domain.SearchQuery) (*domain.Pagination[ListSeriesOutput], error)
+}
+
+type DefaultListSeriesUseCase struct {
+	Gateway series.SeriesGateway
+}
+
+func (useCase DefaultListSeriesUseCase) Execute(query domain.SearchQuery) (*domain.Pagination[ListSeriesOutput], error) {
+	if err := query.ValidateSortableBy(sortableColumns); err != nil {
+		return nil, err
+	}
+
+	if query.Sort == "" {
+		query.Sort = "title"
+	}
+
+	page, err := useCase.Gateway.FindAll(query)
+
+	if err != nil {
+		return nil, err
+	}
+
+	outputs := []*ListSeriesOutput{}
+
+	for _, item := range page.Items {
+		outputs = append(outputs, &ListSeriesOutput{
+			ID:          item.ID,
+			Title:       item.Title,
+			Description: item.Description,
+			CreatedAt:   item.CreatedAt,
+			UpdatedAt:   item.UpdatedAt,
+		})
+	}
+
+	return &domain.Pagination[ListSeriesOutput]{
+		Items:       outputs,
+		CurrentPage: page.CurrentPage,
+		PerPage:     page.PerPage,
+		Total:       page.Total,
+		IsLast:      page.IsLast,
+	}, nil
+}
@@ -0,0 +1,98 @@
+package series_usecase
+
+import (
+	"time"
+
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/series"
+)
+
+type EpisodeOutput struct {
+	VideoId            int64   `json:"videoId"`
+	Number             int     `json:"number"`
+	Title              string  `json:"title"`
+	CategoryIds        []int64 `json:"categoryIds"`
+	GenreIds           []int64 `json:"genreIds"`
+	InheritsCategories bool    `json:"inheritsCategories"`
+	InheritsGenres     bool    `json:"inheritsGenres"`
+}
+
+type SeasonOutput struct {
+	ID       int64           `json:"id"`
+	Number   int             `json:"number"`
+	Title    string          `json:"title"`
+	Episodes []EpisodeOutput `json:"episodes"`
+}
+
+type SeriesOutput struct {
+	ID          int64          `json:"id"`
+	Title       string         `json:"title"`
+	Description string         `json:"description"`
+	CategoryIds []int64        `json:"categoryIds"`
+	GenreIds    []int64        `json:"genreIds"`
+	Seasons     []SeasonOutput `json:"seasons"`
+	CreatedAt   time.Time      `json:"createdAt"`
+	UpdatedAt   time.Time      `json:"updatedAt"`
+}
+
+type GetSeriesByIdUseCase interface {
+	Execute(seriesId int64) (*SeriesOutput, error)
+}
+
+type DefaultGetSeriesByIdUseCase struct {
+	Gateway       series.SeriesGateway
+	SeasonGateway series.SeasonGateway
+}
+
+func (useCase DefaultGetSeriesByIdUseCase) Execute(seriesId int64) (*SeriesOutput, error) {
+	aSeries, err := useCase.Gateway.FindById(seriesId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	seasons, err := useCase.SeasonGateway.FindBySeriesId(seriesId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	seasonOutputs := []SeasonOutput{}
+
+	for _, aSeason := range seasons {
+		seasonOutputs = append(seasonOutputs, toSeasonOutput(*aSeries, *aSeason))
+	}
+
+	return &SeriesOutput{
+		ID:          aSeries.ID,
+		Title:       aSeries.Title,
+		Description: aSeries.Description,
+		CategoryIds: aSeries.CategoryIds,
+		GenreIds:    aSeries.GenreIds,
+		Seasons:     seasonOutputs,
+		CreatedAt:   aSeries.CreatedAt,
+		UpdatedAt:   aSeries.UpdatedAt,
+	}, nil
+}
+
+func toSeasonOutput(aSeries series.Series, aSeason series.Season) SeasonOutput {
+	episodes := []EpisodeOutput{}
+
+	for _, episode := range aSeason.Episodes {
+		episodes = append(episodes, EpisodeOutput{
+			VideoId:            episode.VideoId,
+			Number:             episode.Number,
+			Title:              episode.Title,
+			CategoryIds:        episode.EffectiveCategoryIds(aSeries),
+			GenreIds:           episode.EffectiveGenreIds(aSeries),
+			InheritsCategories: episode.InheritsCategories(),
+			InheritsGenres:     episode.InheritsGenres(),
+		})
+	}
+
+	return SeasonOutput{
+		ID:       aSeason.ID,
+		Number:   aSeason.Number,
+		Title:    aSeason.Title,
+		Episodes: episodes,
+	}
+}
@@ -0,0 +1,35 @@
+package series_usecase_test
+
+import (
+	"testing"
+
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/series"
+	series_usecase "github.com.br/gibranct/admin_do_catalogo/internal/usecases/series"
+	"github.com.br/gibranct/admin_do_catalogo/pkg/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetSeriesWithInheritedEpisodeRelations(t *testing.T) {
+	seriesGateway := new(mocks.SeriesGatewayMock)
+	seasonGateway := new(mocks.SeasonGatewayMock)
+	sut := series_usecase.DefaultGetSeriesByIdUseCase{Gateway: seriesGateway, SeasonGateway: seasonGateway}
+	aSeries := series.NewSeries("Dark", "time travel", []int64{1}, []int64{2})
+	aSeries.ID = 3
+	aSeason := series.NewSeason(aSeries.ID, 1, "Season 1")
+	aSeason.ReplaceEpisodes([]series.Episode{
+		series.NewEpisode(10, 1, "Secrets"),
+		{VideoId: 11, Number: 2, Title: "Lies", GenreIds: []int64{8}},
+	})
+
+	seriesGateway.On("FindById", aSeries.ID).Return(aSeries, nil)
+	seasonGateway.On("FindBySeriesId", aSeries.ID).Return([]*series.Season{aSeason}, nil)
+
+	output, err := sut.Execute(aSeries.ID)
+
+	assert.Nil(t, err)
+	assert.Len(t, output.Seasons, 1)
+	assert.Equal(t, []series_usecase.EpisodeOutput{
+		{VideoId: 10, Number: 1, Title: "Secrets", CategoryIds: []int64{1}, GenreIds: []int64{2}, InheritsCategories: true, InheritsGenres: true},
+		{VideoId: 11, Number: 2, Title: "Lies", CategoryIds: []int64{1}, GenreIds: []int64{8}, InheritsCategories: true, InheritsGenres: false},
+	}, output.Seasons[0].Episodes)
+}
@@ -0,0 +1,198 @@
+package series_usecase
+
+import (
+	"fmt"
+
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/series"
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/video"
+	"github.com.br/gibranct/admin_do_catalogo/pkg/notification"
+)
+
+type EpisodeCommand struct {
+	VideoId int64
+	Number  int
+	Title   string
+}
+
+type CreateSeasonCommand struct {
+	SeriesId int64
+	Number   int
+	Title    string
+	Episodes []EpisodeCommand
+}
+
+type UpdateSeasonCommand struct {
+	SeriesId int64
+	SeasonId int64
+	Number   int
+	Title    string
+	Episodes []EpisodeCommand
+}
+
+type DeleteSeasonCommand struct {
+	SeriesId int64
+	SeasonId int64
+}
+
+type CreateSeasonOutput struct {
+	ID int64
+}
+
+type CreateSeasonUseCase interface {
+	Execute(c CreateSeasonCommand) (*notification.Notification, *CreateSeasonOutput)
+}
+
+type UpdateSeasonUseCase interface {
+	Execute(c UpdateSeasonCommand) *notification.Notification
+}
+
+type DeleteSeasonUseCase interface {
+	Execute(c DeleteSeasonCommand) error
+}
+
+type SeasonValidator struct {
+	Gateway      series.SeasonGateway
+	VideoGateway video.VideoGateway
+}
+
+type DefaultCreateSeasonUseCase struct {
+	SeasonValidator
+	SeriesGateway series.SeriesGateway
+}
+
+func (useCase DefaultCreateSeasonUseCase) Execute(
+	command CreateSeasonCommand,
+) (*notification.Notification, *CreateSeasonOutput) {
+	n := notification.CreateNotification()
+
+	if _, err := useCase.SeriesGateway.FindById(command.SeriesId); err != nil {
+		n.Add(err)
+		return n, nil
+	}
+
+	aSeason := series.NewSeason(command.SeriesId, command.Number, command.Title)
+	aSeason.ReplaceEpisodes(toEpisodes(command.Episodes))
+
+	n.Append(useCase.validate(*aSeason))
+
+	if n.HasErrors() {
+		return n, nil
+	}
+
+	if err := useCase.Gateway.Create(aSeason); err != nil {
+		n.Add(err)
+		return n, nil
+	}
+
+	return nil, &CreateSeasonOutput{ID: aSeason.ID}
+}
+
+type DefaultUpdateSeasonUseCase struct {
+	SeasonValidator
+}
+
+func (useCase DefaultUpdateSeasonUseCase) Execute(command UpdateSeasonCommand) *notification.Notification {
+	n := notification.CreateNotification()
+
+	aSeason, err := findSeason(useCase.Gateway, command.SeriesId, command.SeasonId)
+
+	if err != nil {
+		n.Add(err)
+		return n
+	}
+
+	aSeason.Update(command.Number, command.Title)
+	aSeason.ReplaceEpisodes(toEpisodes(command.Episodes))
+
+	n.Append(useCase.validate(*aSeason))
+
+	if n.HasErrors() {
+		return n
+	}
+
+	if err = useCase.Gateway.Update(*aSeason); err != nil {
+		n.Add(err)
+		return n
+	}
+
+	return nil
+}
+
+type DefaultDeleteSeasonUseCase struct {
+	Gateway series.SeasonGateway
+}
+
+func (useCase DefaultDeleteSeasonUseCase) Execute(command DeleteSeasonCommand) error {
+	if _, err := findSeason(useCase.Gateway, command.SeriesId, command.SeasonId); err != nil {
+		return err
+	}
+
+	return useCase.Gateway.DeleteById(command.SeasonId)
+}
+
+func findSeason(gateway series.SeasonGateway, seriesId, seasonId int64) (*series.Season, error) {
+	aSeason, err := gateway.FindById(seasonId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if aSeason.SeriesId != seriesId {
+		return nil, series.ErrSeasonNotFound
+	}
+
+	return aSeason, nil
+}
+
+func (sv SeasonValidator) validate(aSeason series.Season) *notification.Notification {
+	n := notification.CreateNotification()
+
+	aSeason.Validate(n)
+
+	if n.HasErrors() {
+		return n
+	}
+
+	seasons, err := sv.Gateway.FindBySeriesId(aSeason.SeriesId)
+	if err != nil {
+		n.Add(err)
+		return n
+	}
+
+	for _, other := range seasons {
+		if other.ID != aSeason.ID && other.Number == aSeason.Number {
+			n.Add(fmt.Errorf("season number %d already exists in this series", aSeason.Number))
+		}
+	}
+
+	missing := validateAggregate("videos", aSeason.VideoIds(), sv.VideoGateway.ExistsByIds)
+
+	if missing.HasErrors() {
+		n.Append(missing)
+		return n
+	}
+
+	episodeSeasons, err := sv.Gateway.FindEpisodeSeasons(aSeason.VideoIds())
+	if err != nil {
+		n.Add(err)
+		return n
+	}
+
+	for _, videoId := range aSeason.VideoIds() {
+		if seasonId, ok := episodeSeasons[videoId]; ok && seasonId != aSeason.ID {
+			n.Add(fmt.Errorf("video %d is already an episode of another season", videoId))
+		}
+	}
+
+	return n
+}
+
+func toEpisodes(commands []EpisodeCommand) []series.Episode {
+	episodes := []series.Episode{}
+
+	for _, command := range commands {
+		episodes = append(episodes, series.NewEpisode(command.VideoId, command.Number, command.Title))
+	}
+
+	return episodes
+}
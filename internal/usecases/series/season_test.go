@@ -0,0 +1,142 @@
+package series_usecase_test
+
+import (
+	"testing"
+
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/series"
+	series_usecase "github.com.br/gibranct/admin_do_catalogo/internal/usecases/series"
+	"github.com.br/gibranct/admin_do_catalogo/pkg/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type seasonMocks struct {
+	seasons *mocks.SeasonGatewayMock
+	series  *mocks.SeriesGatewayMock
+	videos  *mocks.VideoGatewayMock
+}
+
+func newSeasonMocks() seasonMocks {
+	return seasonMocks{
+		seasons: new(mocks.SeasonGatewayMock),
+		series:  new(mocks.SeriesGatewayMock),
+		videos:  new(mocks.VideoGatewayMock),
+	}
+}
+
+func (m seasonMocks) validator() series_usecase.SeasonValidator {
+	return series_usecase.SeasonValidator{
+		Gateway:      m.seasons,
+		VideoGateway: m.videos,
+	}
+}
+
+func TestCreateSeason(t *testing.T) {
+	m := newSeasonMocks()
+	sut := series_usecase.DefaultCreateSeasonUseCase{SeasonValidator: m.validator(), SeriesGateway: m.series}
+	aSeries := series.NewSeries("Dark", "time travel", []int64{1}, []int64{2})
+	aSeries.ID = 3
+	command := series_usecase.CreateSeasonCommand{
+		SeriesId: aSeries.ID,
+		Number:   1,
+		Title:    "Season 1",
+		Episodes: []series_usecase.EpisodeCommand{
+			{VideoId: 11, Number: 2, Title: "Lies"},
+			{VideoId: 10, Number: 1, Title: "Secrets"},
+		},
+	}
+
+	m.series.On("FindById", aSeries.ID).Return(aSeries, nil)
+	m.seasons.On("FindBySeriesId", aSeries.ID).Return([]*series.Season{}, nil)
+	m.videos.On("ExistsByIds", []int64{10, 11}).Return([]int64{10, 11}, nil)
+	m.seasons.On("FindEpisodeSeasons", []int64{10, 11}).Return(map[int64]int64{}, nil)
+	m.seasons.On("Create", mock.MatchedBy(func(s *series.Season) bool {
+		s.ID = 9
+		return s.Episodes[0].VideoId == 10 && s.Episodes[1].VideoId == 11
+	})).Return(nil)
+
+	noti, output := sut.Execute(command)
+
+	assert.Nil(t, noti)
+	assert.Equal(t, int64(9), output.ID)
+	m.seasons.AssertExpectations(t)
+}
+
+func TestCreateSeasonWithConflicts(t *testing.T) {
+	m := newSeasonMocks()
+	sut := series_usecase.DefaultCreateSeasonUseCase{SeasonValidator: m.validator(), SeriesGateway: m.series}
+	aSeries := series.NewSeries("Dark", "time travel", nil, nil)
+	aSeries.ID = 3
+	existing := series.NewSeason(aSeries.ID, 1, "Season 1")
+	existing.ID = 4
+	command := series_usecase.CreateSeasonCommand{
+		SeriesId: aSeries.ID,
+		Number:   1,
+		Episodes: []series_usecase.EpisodeCommand{{VideoId: 10, Number: 1}},
+	}
+
+	m.series.On("FindById", aSeries.ID).Return(aSeries, nil)
+	m.seasons.On("FindBySeriesId", aSeries.ID).Return([]*series.Season{existing}, nil)
+	m.videos.On("ExistsByIds", []int64{10}).Return([]int64{10}, nil)
+	m.seasons.On("FindEpisodeSeasons", []int64{10}).Return(map[int64]int64{10: existing.ID}, nil)
+
+	noti, output := sut.Execute(command)
+
+	assert.Nil(t, output)
+	assert.Len(t, noti.GetErrors(), 2)
+	assert.EqualError(t, noti.GetErrors()[0], "season number 1 already exists in this series")
+	assert.EqualError(t, noti.GetErrors()[1], "video 10 is already an episode of another season")
+	m.seasons.AssertNotCalled(t, "Create", mock.Anything)
+}
+
+func TestCreateSeasonForMissingSeries(t *testing.T) {
+	m := newSeasonMocks()
+	sut := series_usecase.DefaultCreateSeasonUseCase{SeasonValidator: m.validator(), SeriesGateway: m.series}
+
+	m.series.On("FindById", int64(3)).Return((*series.Series)(nil), series.ErrSeriesNotFound)
+
+	noti, output := sut.Execute(series_usecase.CreateSeasonCommand{SeriesId: 3, Number: 1})
+
+	assert.Nil(t, output)
+	assert.ErrorIs(t, noti.GetErrors()[0], series.ErrSeriesNotFound)
+}
+
+func TestUpdateSeasonKeepsItsOwnEpisodes(t *testing.T) {
+	m := newSeasonMocks()
+	sut := series_usecase.DefaultUpdateSeasonUseCase{SeasonValidator: m.validator()}
+	aSeason := series.NewSeason(3, 1, "Season 1")
+	aSeason.ID = 4
+
+	m.seasons.On("FindById", aSeason.ID).Return(aSeason, nil)
+	m.seasons.On("FindBySeriesId", int64(3)).Return([]*series.Season{aSeason}, nil)
+	m.videos.On("ExistsByIds", []int64{10}).Return([]int64{10}, nil)
+	m.seasons.On("FindEpisodeSeasons", []int64{10}).Return(map[int64]int64{10: aSeason.ID}, nil)
+	m.seasons.On("Update", mock.MatchedBy(func(s series.Season) bool {
+		return s.Title == "Origins" && len(s.Episodes) == 1
+	})).Return(nil)
+
+	noti := sut.Execute(series_usecase.UpdateSeasonCommand{
+		SeriesId: 3,
+		SeasonId: aSeason.ID,
+		Number:   1,
+		Title:    "Origins",
+		Episodes: []series_usecase.EpisodeCommand{{VideoId: 10, Number: 1}},
+	})
+
+	assert.Nil(t, noti)
+	m.seasons.AssertExpectations(t)
+}
+
+func TestDeleteSeasonOfAnotherSeries(t *testing.T) {
+	seasonGateway := new(mocks.SeasonGatewayMock)
+	sut := series_usecase.DefaultDeleteSeasonUseCase{Gateway: seasonGateway}
+	aSeason := series.NewSeason(3, 1, "Season 1")
+	aSeason.ID = 4
+
+	seasonGateway.On("FindById", aSeason.ID).Return(aSeason, nil)
+
+	err := sut.Execute(series_usecase.DeleteSeasonCommand{SeriesId: 5, SeasonId: aSeason.ID})
+
+	assert.ErrorIs(t, err, series.ErrSeasonNotFound)
+	seasonGateway.AssertNotCalled(t, "DeleteById", mock.Anything)
+}
@@ -0,0 +1,58 @@
+package series_usecase
+
+import (
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/category"
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/genre"
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/series"
+	"github.com.br/gibranct/admin_do_catalogo/pkg/notification"
+)
+
+type UpdateSeriesCommand struct {
+	ID          int64
+	Title       string
+	Description string
+	CategoryIds []int64
+	GenreIds    []int64
+}
+
+type UpdateSeriesUseCase interface {
+	Execute(c UpdateSeriesCommand) *notification.Notification
+}
+
+type DefaultUpdateSeriesUseCase struct {
+	Gateway         series.SeriesGateway
+	CategoryGateway category.CategoryGateway
+	GenreGateway    genre.GenreGateway
+}
+
+func (useCase DefaultUpdateSeriesUseCase) Execute(command UpdateSeriesCommand) *notification.Notification {
+	n := notification.CreateNotification()
+
+	aSeries, err := useCase.Gateway.FindById(command.ID)
+
+	if err != nil {
+		n.Add(err)
+		return n
+	}
+
+	aSeries.Update(command.Title, command.Description, command.CategoryIds, command.GenreIds)
+	aSeries.Validate(n)
+
+	if n.HasErrors() {
+		return n
+	}
+
+	n.Append(validateAggregate("categories", command.CategoryIds, useCase.CategoryGateway.ExistsByIds))
+	n.Append(validateAggregate("genres", command.GenreIds, useCase.GenreGateway.ExistsByIds))
+
+	if n.HasErrors() {
+		return n
+	}
+
+	if err = useCase.Gateway.Update(*aSeries); err != nil {
+		n.Add(err)
+		return n
+	}
+
+	return nil
+}
@@ -0,0 +1,117 @@
+package upload_usecase
+
+import (
+	"errors"
+	"io"
+
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/upload"
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/video"
+	video_usecase "github.com.br/gibranct/admin_do_catalogo/internal/usecases/video"
+)
+
+type AppendChunkCommand struct {
+	VideoId  int64
+	UploadId string
+	Offset   int64
+	Chunk    io.Reader
+}
+
+type AppendChunkUseCase interface {
+	Execute(c AppendChunkCommand) (*UploadOutput, error)
+}
+
+type DefaultAppendChunkUseCase struct {
+	Gateway     upload.UploadGateway
+	Storage     upload.ChunkStorage
+	UploadMedia video_usecase.UploadMediaUseCase
+}
+
+func (useCase DefaultAppendChunkUseCase) Execute(command AppendChunkCommand) (*UploadOutput, error) {
+	anUpload, err := findUpload(useCase.Gateway, command.VideoId, command.UploadId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if command.Offset != anUpload.Offset {
+		return nil, upload.ErrOffsetMismatch
+	}
+
+	// the chunks are only removed once the media is stored, so while they are
+	// still around a finalization that failed earlier can be retried
+	if anUpload.IsCompleted() {
+		// repeating the last request of a finalized upload, e.g. after a lost response, changes nothing
+		if anUpload.IsFinalized() {
+			return toUploadOutput(anUpload), nil
+		}
+
+		err = useCase.complete(anUpload)
+
+		if errors.Is(err, upload.ErrUploadNotFound) {
+			return nil, upload.ErrOffsetMismatch
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		return toUploadOutput(anUpload), nil
+	}
+
+	previousOffset := anUpload.Offset
+	written, err := useCase.Storage.Append(anUpload.ID, anUpload.Offset, io.LimitReader(command.Chunk, anUpload.Remaining()))
+
+	// whatever reached the storage is kept so the client can resume from there
+	if written > 0 {
+		if advanceErr := anUpload.Advance(written); advanceErr != nil {
+			return nil, advanceErr
+		}
+
+		if updateErr := useCase.Gateway.UpdateOffset(*anUpload, previousOffset); updateErr != nil {
+			return nil, updateErr
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if anUpload.IsCompleted() {
+		if err = useCase.complete(anUpload); err != nil {
+			return nil, err
+		}
+	}
+
+	return toUploadOutput(anUpload), nil
+}
+
+func (useCase DefaultAppendChunkUseCase) complete(anUpload *upload.Upload) error {
+	content, err := useCase.Storage.Open(anUpload.ID)
+
+	if err != nil {
+		return err
+	}
+
+	defer content.Close()
+
+	_, err = useCase.UploadMedia.Execute(video_usecase.UploadMediaCommand{
+		VideoId: anUpload.VideoId,
+		Type:    anUpload.MediaType,
+		Resource: video.Resource{
+			Stream:   content,
+			Checksum: anUpload.Checksum,
+			Name:     anUpload.FileName,
+		},
+	})
+
+	if err != nil {
+		return err
+	}
+
+	anUpload.Finalize()
+	if err = useCase.Gateway.Finalize(*anUpload); err != nil {
+		return err
+	}
+
+	return useCase.Storage.Remove(anUpload.ID)
+}
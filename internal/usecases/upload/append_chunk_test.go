@@ -0,0 +1,296 @@
+package upload_usecase_test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/upload"
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/video"
+	upload_usecase "github.com.br/gibranct/admin_do_catalogo/internal/usecases/upload"
+	video_usecase "github.com.br/gibranct/admin_do_catalogo/internal/usecases/video"
+	"github.com.br/gibranct/admin_do_catalogo/pkg/mocks"
+	"github.com.br/gibranct/admin_do_catalogo/pkg/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type appendChunkFixture struct {
+	uploadGateway *mocks.UploadGatewayMock
+	storage       *mocks.ChunkStorageMock
+	videoGateway  *mocks.VideoGatewayMock
+	mediaGateway  *mocks.MediaResourceGatewayMock
+	sut           upload_usecase.DefaultAppendChunkUseCase
+}
+
+func newAppendChunkFixture() appendChunkFixture {
+	f := appendChunkFixture{
+		uploadGateway: new(mocks.UploadGatewayMock),
+		storage:       new(mocks.ChunkStorageMock),
+		videoGateway:  new(mocks.VideoGatewayMock),
+		mediaGateway:  new(mocks.MediaResourceGatewayMock),
+	}
+	f.sut = upload_usecase.DefaultAppendChunkUseCase{
+		Gateway: f.uploadGateway,
+		Storage: f.storage,
+		UploadMedia: video_usecase.DefaultUploadMediaUseCase{
+			Gateway:      f.videoGateway,
+			MediaGateway: f.mediaGateway,
+		},
+	}
+	return f
+}
+
+func TestAppendChunk(t *testing.T) {
+	f := newAppendChunkFixture()
+	anUpload := upload.NewUpload(7, video.VIDEO, 10, "movie.mp4", "")
+
+	f.uploadGateway.On("FindById", anUpload.ID).Return(anUpload, nil)
+	f.storage.On("Append", anUpload.ID, int64(0), mock.Anything).Return(int64(4), nil)
+	f.uploadGateway.On("UpdateOffset", mock.MatchedBy(func(u upload.Upload) bool {
+		return u.Offset == 4
+	}), int64(0)).Return(nil)
+
+	output, err := f.sut.Execute(upload_usecase.AppendChunkCommand{
+		VideoId:  7,
+		UploadId: anUpload.ID,
+		Offset:   0,
+		Chunk:    strings.NewReader("0123"),
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, int64(4), output.Offset)
+	assert.False(t, output.Completed)
+	f.uploadGateway.AssertExpectations(t)
+	f.storage.AssertExpectations(t)
+	f.mediaGateway.AssertNumberOfCalls(t, "StoreAudioVideo", 0)
+}
+
+func TestAppendLastChunkStoresMedia(t *testing.T) {
+	f := newAppendChunkFixture()
+	anUpload := upload.NewUpload(7, video.TRAILER, 10, "trailer.mp4", "sum")
+	anUpload.Offset = 6
+	aVideo := video.NewVideo("title", "desc", 2024, 120.0, true, video.L, nil, nil, nil)
+	aVideo.ID = 7
+	status := video.PENDING
+	media := video.NewAudioVideoMediaWith(0, &status, "sum", "trailer.mp4", "/videos/7/trailer/trailer.mp4", "")
+	content := io.NopCloser(bytes.NewReader(test.DummyMP4("56789")))
+
+	f.uploadGateway.On("FindById", anUpload.ID).Return(anUpload, nil)
+	f.storage.On("Append", anUpload.ID, int64(6), mock.Anything).Return(int64(4), nil)
+	f.uploadGateway.On("UpdateOffset", mock.Anything, int64(6)).Return(nil)
+	f.storage.On("Open", anUpload.ID).Return(content, nil)
+	f.videoGateway.On("FindById", int64(7)).Return(aVideo, nil)
+	f.mediaGateway.On("StoreAudioVideo", int64(7), mock.MatchedBy(func(r video.VideoResource) bool {
+		return r.Type == video.TRAILER && r.Resource.Stream != nil &&
+			r.Resource.Checksum == "sum" && r.Resource.Name == "trailer.mp4"
+	})).Return(media, nil)
+	f.videoGateway.On("Update", mock.MatchedBy(func(v video.Video) bool {
+		return v.Trailer == media && *v.Trailer.Status == video.PENDING
+	})).Return(aVideo, nil)
+	f.mediaGateway.On("Commit", "/videos/7/trailer/trailer.mp4", "sum").Return(nil)
+	f.uploadGateway.On("Finalize", mock.MatchedBy(func(u upload.Upload) bool {
+		return u.ID == anUpload.ID && u.IsFinalized()
+	})).Return(nil)
+	f.storage.On("Remove", anUpload.ID).Return(nil)
+
+	output, err := f.sut.Execute(upload_usecase.AppendChunkCommand{
+		VideoId:  7,
+		UploadId: anUpload.ID,
+		Offset:   6,
+		Chunk:    strings.NewReader("6789"),
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, int64(10), output.Offset)
+	assert.True(t, output.Completed)
+	f.uploadGateway.AssertExpectations(t)
+	f.storage.AssertExpectations(t)
+	f.videoGateway.AssertExpectations(t)
+	f.mediaGateway.AssertExpectations(t)
+}
+
+func TestAppendChunkWithWrongOffset(t *testing.T) {
+	f := newAppendChunkFixture()
+	anUpload := upload.NewUpload(7, video.VIDEO, 10, "movie.mp4", "")
+	anUpload.Offset = 4
+
+	f.uploadGateway.On("FindById", anUpload.ID).Return(anUpload, nil)
+
+	output, err := f.sut.Execute(upload_usecase.AppendChunkCommand{
+		VideoId:  7,
+		UploadId: anUpload.ID,
+		Offset:   0,
+		Chunk:    strings.NewReader("0123"),
+	})
+
+	assert.Nil(t, output)
+	assert.ErrorIs(t, err, upload.ErrOffsetMismatch)
+	f.storage.AssertNumberOfCalls(t, "Append", 0)
+}
+
+func TestAppendChunkForAnotherVideo(t *testing.T) {
+	f := newAppendChunkFixture()
+	anUpload := upload.NewUpload(7, video.VIDEO, 10, "movie.mp4", "")
+
+	f.uploadGateway.On("FindById", anUpload.ID).Return(anUpload, nil)
+
+	output, err := f.sut.Execute(upload_usecase.AppendChunkCommand{
+		VideoId:  8,
+		UploadId: anUpload.ID,
+		Offset:   0,
+		Chunk:    strings.NewReader("0123"),
+	})
+
+	assert.Nil(t, output)
+	assert.ErrorIs(t, err, upload.ErrUploadNotFound)
+}
+
+func TestAppendChunkKeepsPartialProgress(t *testing.T) {
+	f := newAppendChunkFixture()
+	anUpload := upload.NewUpload(7, video.VIDEO, 10, "movie.mp4", "")
+	expectedErr := errors.New("unexpected EOF")
+
+	f.uploadGateway.On("FindById", anUpload.ID).Return(anUpload, nil)
+	f.storage.On("Append", anUpload.ID, int64(0), mock.Anything).Return(int64(2), expectedErr)
+	f.uploadGateway.On("UpdateOffset", mock.MatchedBy(func(u upload.Upload) bool {
+		return u.Offset == 2
+	}), int64(0)).Return(nil)
+
+	output, err := f.sut.Execute(upload_usecase.AppendChunkCommand{
+		VideoId:  7,
+		UploadId: anUpload.ID,
+		Offset:   0,
+		Chunk:    strings.NewReader("0123"),
+	})
+
+	assert.Nil(t, output)
+	assert.Equal(t, expectedErr, err)
+	f.uploadGateway.AssertExpectations(t)
+}
+
+func TestRetryFinalizationThatFailed(t *testing.T) {
+	f := newAppendChunkFixture()
+	anUpload := upload.NewUpload(7, video.TRAILER, 10, "trailer.mp4", "sum")
+	anUpload.Offset = 6
+	aVideo := video.NewVideo("title", "desc", 2024, 120.0, true, video.L, nil, nil, nil)
+	aVideo.ID = 7
+	status := video.PENDING
+	media := video.NewAudioVideoMediaWith(0, &status, "sum", "trailer.mp4", "/videos/7/trailer/trailer.mp4", "")
+	expectedErr := errors.New("disk is full")
+
+	f.uploadGateway.On("FindById", anUpload.ID).Return(anUpload, nil)
+	f.storage.On("Append", anUpload.ID, int64(6), mock.Anything).Return(int64(4), nil).Once()
+	f.uploadGateway.On("UpdateOffset", mock.Anything, int64(6)).Return(nil).Once()
+	f.storage.On("Open", anUpload.ID).Return(io.NopCloser(bytes.NewReader(test.DummyMP4("56789"))), nil).Once()
+	f.videoGateway.On("FindById", int64(7)).Return(aVideo, nil)
+	f.mediaGateway.On("StoreAudioVideo", int64(7), mock.Anything).Return((*video.AudioVideoMedia)(nil), expectedErr).Once()
+
+	output, err := f.sut.Execute(upload_usecase.AppendChunkCommand{
+		VideoId:  7,
+		UploadId: anUpload.ID,
+		Offset:   6,
+		Chunk:    strings.NewReader("6789"),
+	})
+
+	assert.Nil(t, output)
+	assert.ErrorIs(t, err, expectedErr)
+	f.storage.AssertNumberOfCalls(t, "Remove", 0)
+
+	f.storage.On("Open", anUpload.ID).Return(io.NopCloser(bytes.NewReader(test.DummyMP4("56789"))), nil).Once()
+	f.mediaGateway.On("StoreAudioVideo", int64(7), mock.Anything).Return(media, nil).Once()
+	f.videoGateway.On("Update", mock.Anything).Return(aVideo, nil)
+	f.mediaGateway.On("Commit", "/videos/7/trailer/trailer.mp4", "sum").Return(nil)
+	f.uploadGateway.On("Finalize", mock.Anything).Return(nil)
+	f.storage.On("Remove", anUpload.ID).Return(nil)
+
+	output, err = f.sut.Execute(upload_usecase.AppendChunkCommand{
+		VideoId:  7,
+		UploadId: anUpload.ID,
+		Offset:   10,
+		Chunk:    strings.NewReader(""),
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, int64(10), output.Offset)
+	assert.True(t, output.Completed)
+	f.storage.AssertNumberOfCalls(t, "Append", 1)
+	f.uploadGateway.AssertNumberOfCalls(t, "UpdateOffset", 1)
+	f.mediaGateway.AssertNumberOfCalls(t, "StoreAudioVideo", 2)
+	f.storage.AssertExpectations(t)
+}
+
+func TestAppendChunkToAnUploadAlreadyStored(t *testing.T) {
+	f := newAppendChunkFixture()
+	anUpload := upload.NewUpload(7, video.VIDEO, 10, "movie.mp4", "")
+	anUpload.Offset = 10
+
+	f.uploadGateway.On("FindById", anUpload.ID).Return(anUpload, nil)
+	f.storage.On("Open", anUpload.ID).Return(io.NopCloser(strings.NewReader("")), upload.ErrUploadNotFound)
+
+	output, err := f.sut.Execute(upload_usecase.AppendChunkCommand{
+		VideoId:  7,
+		UploadId: anUpload.ID,
+		Offset:   10,
+		Chunk:    strings.NewReader(""),
+	})
+
+	assert.Nil(t, output)
+	assert.ErrorIs(t, err, upload.ErrOffsetMismatch)
+	f.mediaGateway.AssertNumberOfCalls(t, "StoreAudioVideo", 0)
+}
+
+func TestRepeatCompletionOfAFinalizedUpload(t *testing.T) {
+	f := newAppendChunkFixture()
+	anUpload := upload.NewUpload(7, video.VIDEO, 10, "movie.mp4", "")
+	anUpload.Offset = 10
+	anUpload.Finalize()
+
+	f.uploadGateway.On("FindById", anUpload.ID).Return(anUpload, nil)
+
+	output, err := f.sut.Execute(upload_usecase.AppendChunkCommand{
+		VideoId:  7,
+		UploadId: anUpload.ID,
+		Offset:   10,
+		Chunk:    strings.NewReader(""),
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, int64(10), output.Offset)
+	assert.True(t, output.Completed)
+	f.storage.AssertNumberOfCalls(t, "Open", 0)
+	f.storage.AssertNumberOfCalls(t, "Remove", 0)
+	f.mediaGateway.AssertNumberOfCalls(t, "StoreAudioVideo", 0)
+}
+
+func TestAppendLastChunkWhenFinalizeFails(t *testing.T) {
+	f := newAppendChunkFixture()
+	anUpload := upload.NewUpload(7, video.TRAILER, 10, "trailer.mp4", "sum")
+	anUpload.Offset = 10
+	aVideo := video.NewVideo("title", "desc", 2024, 120.0, true, video.L, nil, nil, nil)
+	aVideo.ID = 7
+	status := video.PENDING
+	media := video.NewAudioVideoMediaWith(0, &status, "sum", "trailer.mp4", "/videos/7/trailer/trailer.mp4", "")
+	expectedErr := errors.New("connection reset")
+
+	f.uploadGateway.On("FindById", anUpload.ID).Return(anUpload, nil)
+	f.storage.On("Open", anUpload.ID).Return(io.NopCloser(bytes.NewReader(test.DummyMP4("56789"))), nil)
+	f.videoGateway.On("FindById", int64(7)).Return(aVideo, nil)
+	f.mediaGateway.On("StoreAudioVideo", int64(7), mock.Anything).Return(media, nil)
+	f.videoGateway.On("Update", mock.Anything).Return(aVideo, nil)
+	f.mediaGateway.On("Commit", "/videos/7/trailer/trailer.mp4", "sum").Return(nil)
+	f.uploadGateway.On("Finalize", mock.Anything).Return(expectedErr)
+
+	output, err := f.sut.Execute(upload_usecase.AppendChunkCommand{
+		VideoId:  7,
+		UploadId: anUpload.ID,
+		Offset:   10,
+		Chunk:    strings.NewReader(""),
+	})
+
+	assert.Nil(t, output)
+	assert.ErrorIs(t, err, expectedErr)
+	f.storage.AssertNumberOfCalls(t, "Remove", 0)
+}
@@ -0,0 +1,90 @@
+package upload_usecase
+
+import (
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/upload"
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/video"
+	video_usecase "github.com.br/gibranct/admin_do_catalogo/internal/usecases/video"
+	"github.com.br/gibranct/admin_do_catalogo/pkg/notification"
+)
+
+type CreateUploadCommand struct {
+	VideoId   int64
+	MediaType video.VideoMediaType
+	Length    int64
+	FileName  string
+	Checksum  string
+}
+
+type CreateUploadUseCase interface {
+	Execute(c CreateUploadCommand) (*notification.Notification, *UploadOutput)
+}
+
+type DefaultCreateUploadUseCase struct {
+	Gateway      upload.UploadGateway
+	VideoGateway video.VideoGateway
+	MediaGateway video.MediaResourceGateway
+	UploadMedia  video_usecase.UploadMediaUseCase
+}
+
+func (useCase DefaultCreateUploadUseCase) Execute(
+	command CreateUploadCommand,
+) (*notification.Notification, *UploadOutput) {
+	n := notification.CreateNotification()
+
+	anUpload := upload.NewUpload(
+		command.VideoId,
+		command.MediaType,
+		command.Length,
+		command.FileName,
+		command.Checksum,
+	)
+
+	anUpload.Validate(n)
+
+	if n.HasErrors() {
+		return n, nil
+	}
+
+	if _, err := useCase.VideoGateway.FindById(command.VideoId); err != nil {
+		n.Add(err)
+		return n, nil
+	}
+
+	if err := useCase.completeKnownContent(anUpload); err != nil {
+		n.Add(err)
+		return n, nil
+	}
+
+	if err := useCase.Gateway.Create(anUpload); err != nil {
+		n.Add(err)
+		return n, nil
+	}
+
+	return n, toUploadOutput(anUpload)
+}
+
+func (useCase DefaultCreateUploadUseCase) completeKnownContent(anUpload *upload.Upload) error {
+	if anUpload.Checksum == "" {
+		return nil
+	}
+
+	exists, err := useCase.MediaGateway.Exists(anUpload.Checksum)
+	if err != nil || !exists {
+		return err
+	}
+
+	_, err = useCase.UploadMedia.Execute(video_usecase.UploadMediaCommand{
+		VideoId: anUpload.VideoId,
+		Type:    anUpload.MediaType,
+		Resource: video.Resource{
+			Checksum: anUpload.Checksum,
+			Name:     anUpload.FileName,
+		},
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return anUpload.Advance(anUpload.Remaining())
+}
@@ -0,0 +1,144 @@
+package upload_usecase_test
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/upload"
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/video"
+	upload_usecase "github.com.br/gibranct/admin_do_catalogo/internal/usecases/upload"
+	video_usecase "github.com.br/gibranct/admin_do_catalogo/internal/usecases/video"
+	"github.com.br/gibranct/admin_do_catalogo/pkg/mocks"
+	"github.com.br/gibranct/admin_do_catalogo/pkg/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCreateUpload(t *testing.T) {
+	uploadGateway := new(mocks.UploadGatewayMock)
+	videoGateway := new(mocks.VideoGatewayMock)
+	mediaGateway := new(mocks.MediaResourceGatewayMock)
+	sut := upload_usecase.DefaultCreateUploadUseCase{
+		Gateway:      uploadGateway,
+		VideoGateway: videoGateway,
+		MediaGateway: mediaGateway,
+	}
+	aVideo := video.NewVideo("title", "desc", 2024, 120.0, true, video.L, nil, nil, nil)
+	aVideo.ID = 7
+	command := upload_usecase.CreateUploadCommand{
+		VideoId:   aVideo.ID,
+		MediaType: video.TRAILER,
+		Length:    1024,
+		FileName:  "trailer.mp4",
+		Checksum:  "sum",
+	}
+
+	videoGateway.On("FindById", aVideo.ID).Return(aVideo, nil)
+	mediaGateway.On("Exists", "sum").Return(false, nil)
+	uploadGateway.On("Create", mock.MatchedBy(func(u *upload.Upload) bool {
+		return u.VideoId == aVideo.ID && u.MediaType == video.TRAILER && u.Length == 1024 &&
+			u.FileName == "trailer.mp4" && u.Checksum == "sum"
+	})).Return(nil)
+
+	n, output := sut.Execute(command)
+
+	assert.False(t, n.HasErrors())
+	assert.NotEmpty(t, output.ID)
+	assert.Equal(t, aVideo.ID, output.VideoId)
+	assert.Equal(t, "Trailer", output.MediaType)
+	assert.Equal(t, int64(0), output.Offset)
+	assert.Equal(t, int64(1024), output.Length)
+	videoGateway.AssertExpectations(t)
+	uploadGateway.AssertExpectations(t)
+}
+
+func TestCreateUploadWithInvalidCommand(t *testing.T) {
+	uploadGateway := new(mocks.UploadGatewayMock)
+	videoGateway := new(mocks.VideoGatewayMock)
+	sut := upload_usecase.DefaultCreateUploadUseCase{
+		Gateway:      uploadGateway,
+		VideoGateway: videoGateway,
+	}
+
+	n, output := sut.Execute(upload_usecase.CreateUploadCommand{
+		VideoId:   7,
+		MediaType: video.BANNER,
+		Length:    10,
+		FileName:  "banner.png",
+	})
+
+	assert.Nil(t, output)
+	assert.Equal(t, "'mediaType' must be Video or Trailer", n.GetErrors()[0].Error())
+	videoGateway.AssertNumberOfCalls(t, "FindById", 0)
+	uploadGateway.AssertNumberOfCalls(t, "Create", 0)
+}
+
+func TestCreateUploadForMissingVideo(t *testing.T) {
+	uploadGateway := new(mocks.UploadGatewayMock)
+	videoGateway := new(mocks.VideoGatewayMock)
+	sut := upload_usecase.DefaultCreateUploadUseCase{
+		Gateway:      uploadGateway,
+		VideoGateway: videoGateway,
+	}
+
+	videoGateway.On("FindById", int64(7)).Return((*video.Video)(nil), video.ErrVideoNotFound)
+
+	n, output := sut.Execute(upload_usecase.CreateUploadCommand{
+		VideoId:   7,
+		MediaType: video.VIDEO,
+		Length:    10,
+		FileName:  "movie.mp4",
+	})
+
+	assert.Nil(t, output)
+	assert.True(t, errors.Is(n.GetErrors()[0], video.ErrVideoNotFound))
+	uploadGateway.AssertNumberOfCalls(t, "Create", 0)
+}
+
+func TestCreateUploadWithKnownContentCompletesImmediately(t *testing.T) {
+	uploadGateway := new(mocks.UploadGatewayMock)
+	videoGateway := new(mocks.VideoGatewayMock)
+	mediaGateway := new(mocks.MediaResourceGatewayMock)
+	sut := upload_usecase.DefaultCreateUploadUseCase{
+		Gateway:      uploadGateway,
+		VideoGateway: videoGateway,
+		MediaGateway: mediaGateway,
+		UploadMedia: video_usecase.DefaultUploadMediaUseCase{
+			Gateway:      videoGateway,
+			MediaGateway: mediaGateway,
+		},
+	}
+	aVideo := video.NewVideo("title", "desc", 2024, 120.0, true, video.L, nil, nil, nil)
+	aVideo.ID = 7
+	status := video.PENDING
+	media := video.NewAudioVideoMediaWith(0, &status, "sum", "movie.mp4", "/videos/7/video/movie.mp4", "")
+
+	videoGateway.On("FindById", aVideo.ID).Return(aVideo, nil)
+	mediaGateway.On("Exists", "sum").Return(true, nil)
+	mediaGateway.On("GetBlob", "sum").Return(&video.Resource{Stream: bytes.NewReader(test.DummyMP4("video"))}, nil)
+	mediaGateway.On("StoreAudioVideo", aVideo.ID, video.VideoResource{
+		Type:     video.VIDEO,
+		Resource: video.Resource{Checksum: "sum", Name: "movie.mp4"},
+	}).Return(media, nil)
+	videoGateway.On("Update", mock.Anything).Return(aVideo, nil)
+	mediaGateway.On("Commit", "/videos/7/video/movie.mp4", "sum").Return(nil)
+	uploadGateway.On("Create", mock.MatchedBy(func(u *upload.Upload) bool {
+		return u.Offset == u.Length
+	})).Return(nil)
+
+	n, output := sut.Execute(upload_usecase.CreateUploadCommand{
+		VideoId:   aVideo.ID,
+		MediaType: video.VIDEO,
+		Length:    4096,
+		FileName:  "movie.mp4",
+		Checksum:  "sum",
+	})
+
+	assert.False(t, n.HasErrors())
+	assert.Equal(t, int64(4096), output.Offset)
+	assert.True(t, output.Completed)
+	uploadGateway.AssertExpectations(t)
+	mediaGateway.AssertExpectations(t)
+	videoGateway.AssertExpectations(t)
+}
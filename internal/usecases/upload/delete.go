@@ -0,0 +1,33 @@
+package upload_usecase
+
+import (
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/upload"
+)
+
+type TerminateUploadCommand struct {
+	VideoId  int64
+	UploadId string
+}
+
+type TerminateUploadUseCase interface {
+	Execute(c TerminateUploadCommand) error
+}
+
+type DefaultTerminateUploadUseCase struct {
+	Gateway upload.UploadGateway
+	Storage upload.ChunkStorage
+}
+
+func (useCase DefaultTerminateUploadUseCase) Execute(command TerminateUploadCommand) error {
+	anUpload, err := findUpload(useCase.Gateway, command.VideoId, command.UploadId)
+
+	if err != nil {
+		return err
+	}
+
+	if err = useCase.Storage.Remove(anUpload.ID); err != nil {
+		return err
+	}
+
+	return useCase.Gateway.DeleteById(anUpload.ID)
+}
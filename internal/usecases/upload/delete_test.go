@@ -0,0 +1,48 @@
+package upload_usecase_test
+
+import (
+	"testing"
+
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/upload"
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/video"
+	upload_usecase "github.com.br/gibranct/admin_do_catalogo/internal/usecases/upload"
+	"github.com.br/gibranct/admin_do_catalogo/pkg/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTerminateUpload(t *testing.T) {
+	uploadGateway := new(mocks.UploadGatewayMock)
+	storage := new(mocks.ChunkStorageMock)
+	sut := upload_usecase.DefaultTerminateUploadUseCase{
+		Gateway: uploadGateway,
+		Storage: storage,
+	}
+	anUpload := upload.NewUpload(7, video.VIDEO, 10, "movie.mp4", "")
+
+	uploadGateway.On("FindById", anUpload.ID).Return(anUpload, nil)
+	storage.On("Remove", anUpload.ID).Return(nil)
+	uploadGateway.On("DeleteById", anUpload.ID).Return(nil)
+
+	err := sut.Execute(upload_usecase.TerminateUploadCommand{VideoId: 7, UploadId: anUpload.ID})
+
+	assert.Nil(t, err)
+	uploadGateway.AssertExpectations(t)
+	storage.AssertExpectations(t)
+}
+
+func TestTerminateMissingUpload(t *testing.T) {
+	uploadGateway := new(mocks.UploadGatewayMock)
+	storage := new(mocks.ChunkStorageMock)
+	sut := upload_usecase.DefaultTerminateUploadUseCase{
+		Gateway: uploadGateway,
+		Storage: storage,
+	}
+
+	uploadGateway.On("FindById", "missing").Return((*upload.Upload)(nil), upload.ErrUploadNotFound)
+
+	err := sut.Execute(upload_usecase.TerminateUploadCommand{VideoId: 7, UploadId: "missing"})
+
+	assert.ErrorIs(t, err, upload.ErrUploadNotFound)
+	storage.AssertNumberOfCalls(t, "Remove", 0)
+	uploadGateway.AssertNumberOfCalls(t, "DeleteById", 0)
+}
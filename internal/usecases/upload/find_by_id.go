@@ -0,0 +1,62 @@
+package upload_usecase
+
+import (
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/upload"
+)
+
+type UploadOutput struct {
+	ID        string `json:"id"`
+	VideoId   int64  `json:"videoId"`
+	MediaType string `json:"mediaType"`
+	Length    int64  `json:"length"`
+	Offset    int64  `json:"offset"`
+	Completed bool   `json:"completed"`
+}
+
+type GetUploadCommand struct {
+	VideoId  int64
+	UploadId string
+}
+
+type GetUploadUseCase interface {
+	Execute(c GetUploadCommand) (*UploadOutput, error)
+}
+
+type DefaultGetUploadUseCase struct {
+	Gateway upload.UploadGateway
+}
+
+func (useCase DefaultGetUploadUseCase) Execute(command GetUploadCommand) (*UploadOutput, error) {
+	anUpload, err := findUpload(useCase.Gateway, command.VideoId, command.UploadId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return toUploadOutput(anUpload), nil
+}
+
+func findUpload(gateway upload.UploadGateway, videoId int64, uploadId string) (*upload.Upload, error) {
+	anUpload, err := gateway.FindById(uploadId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if anUpload.VideoId != videoId {
+		return nil, upload.ErrUploadNotFound
+	}
+
+	return anUpload, nil
+}
+
+func toUploadOutput(anUpload *upload.Upload) *UploadOutput {
+	return &UploadOutput{
+		ID:        anUpload.ID,
+		VideoId:   anUpload.VideoId,
+		MediaType: anUpload.MediaType.String(),
+		Length:    anUpload.Length,
+		Offset:    anUpload.Offset,
+		Completed: anUpload.IsCompleted(),
+	}
+}
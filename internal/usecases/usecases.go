@@ -2,14 +2,24 @@ package usecase
 
 import (
 	"database/sql"
+	"path/filepath"
 
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/video"
 	castmember "github.com.br/gibranct/admin_do_catalogo/internal/infra/castmember"
 	gateway "github.com.br/gibranct/admin_do_catalogo/internal/infra/category"
+	infra_encoding "github.com.br/gibranct/admin_do_catalogo/internal/infra/encoding"
 	infra_genre "github.com.br/gibranct/admin_do_catalogo/internal/infra/genre"
+	infra_media "github.com.br/gibranct/admin_do_catalogo/internal/infra/media"
+	infra_messaging "github.com.br/gibranct/admin_do_catalogo/internal/infra/messaging"
+	infra_series "github.com.br/gibranct/admin_do_catalogo/internal/infra/series"
+	infra_upload "github.com.br/gibranct/admin_do_catalogo/internal/infra/upload"
 	infra_video "github.com.br/gibranct/admin_do_catalogo/internal/infra/video"
 	castmemberUsecase "github.com.br/gibranct/admin_do_catalogo/internal/usecases/castmember"
 	categoryUsecase "github.com.br/gibranct/admin_do_catalogo/internal/usecases/category"
+	encoding_usecase "github.com.br/gibranct/admin_do_catalogo/internal/usecases/encoding"
 	genre_usecase "github.com.br/gibranct/admin_do_catalogo/internal/usecases/genre"
+	series_usecase "github.com.br/gibranct/admin_do_catalogo/internal/usecases/series"
+	upload_usecase "github.com.br/gibranct/admin_do_catalogo/internal/usecases/upload"
 	video_usecase "github.com.br/gibranct/admin_do_catalogo/internal/usecases/video"
 )
 
@@ -35,21 +45,112 @@ type GenreUseCase struct {
 }
 
 type VideoUseCase struct {
-	Create video_usecase.CreateVideoUseCase
+	Create            video_usecase.CreateVideoUseCase
+	FindOne           video_usecase.GetVideoByIdUseCase
+	FindAll           video_usecase.ListVideosUseCase
+	Update            video_usecase.UpdateVideoUseCase
+	DeleteById        video_usecase.DeleteVideoUseCase
+	UploadMedia       video_usecase.UploadMediaUseCase
+	GetMedia          video_usecase.GetMediaUseCase
+	UpdateMediaStatus video_usecase.UpdateMediaStatusUseCase
+	RetryMedia        video_usecase.RetryMediaUseCase
+	Submit            video_usecase.SubmitVideoUseCase
+	Publish           video_usecase.PublishVideoUseCase
+	Reject            video_usecase.RejectVideoUseCase
+	Unpublish         video_usecase.UnpublishVideoUseCase
+	PublishDue        video_usecase.PublishDueVideosUseCase
+	Readiness         video_usecase.GetReadinessUseCase
+	RatingEquivalents video_usecase.GetRatingEquivalentsUseCase
+	UploadTextTrack   video_usecase.UploadTextTrackUseCase
+	ListTextTracks    video_usecase.ListTextTracksUseCase
+	GetTextTrack      video_usecase.GetTextTrackUseCase
+	UploadAudioTrack  video_usecase.UploadAudioTrackUseCase
+	ListAudioTracks   video_usecase.ListAudioTracksUseCase
+	DeleteAudioTrack  video_usecase.DeleteAudioTrackUseCase
+	CreateExtra       video_usecase.CreateExtraUseCase
+	UpdateExtra       video_usecase.UpdateExtraUseCase
+	ListExtras        video_usecase.ListExtrasUseCase
+	DeleteExtra       video_usecase.DeleteExtraUseCase
+	ReorderExtras     video_usecase.ReorderExtrasUseCase
+	PromoteExtra      video_usecase.PromoteExtraUseCase
+	UploadExtraMedia  video_usecase.UploadExtraMediaUseCase
+	GetExtraMedia     video_usecase.GetExtraMediaUseCase
+	UploadArtwork     video_usecase.UploadArtworkUseCase
+	ListArtwork       video_usecase.ListArtworkUseCase
+	GetBestArtwork    video_usecase.GetBestArtworkUseCase
+	GetArtworkImage   video_usecase.GetArtworkImageUseCase
+	DeleteArtwork     video_usecase.DeleteArtworkUseCase
+	GetManifest       video_usecase.GetManifestUseCase
+}
+
+type SeriesUseCase struct {
+	Create       series_usecase.CreateSeriesUseCase
+	FindOne      series_usecase.GetSeriesByIdUseCase
+	FindAll      series_usecase.ListSeriesUseCase
+	Update       series_usecase.UpdateSeriesUseCase
+	DeleteById   series_usecase.DeleteSeriesUseCase
+	CreateSeason series_usecase.CreateSeasonUseCase
+	UpdateSeason series_usecase.UpdateSeasonUseCase
+	DeleteSeason series_usecase.DeleteSeasonUseCase
+}
+
+type UploadUseCase struct {
+	Create      upload_usecase.CreateUploadUseCase
+	FindOne     upload_usecase.GetUploadUseCase
+	AppendChunk upload_usecase.AppendChunkUseCase
+	Terminate   upload_usecase.TerminateUploadUseCase
+}
+
+type EncodingProfileUseCase struct {
+	Create          encoding_usecase.CreateProfileUseCase
+	FindOne         encoding_usecase.GetProfileByIdUseCase
+	FindAll         encoding_usecase.ListProfilesUseCase
+	Update          encoding_usecase.UpdateProfileUseCase
+	DeleteById      encoding_usecase.DeleteProfileUseCase
+	AssignVideo     encoding_usecase.AssignVideoProfileUseCase
+	AssignCategory  encoding_usecase.AssignCategoryProfileUseCase
+	GetVideoProfile encoding_usecase.GetVideoProfileUseCase
 }
 
 type UseCases struct {
-	Category   CategoryUseCase
-	CastMember CastMemberUseCase
-	Genre      GenreUseCase
-	Video      VideoUseCase
+	Category        CategoryUseCase
+	CastMember      CastMemberUseCase
+	Genre           GenreUseCase
+	Video           VideoUseCase
+	Series          SeriesUseCase
+	Upload          UploadUseCase
+	EncodingProfile EncodingProfileUseCase
 }
 
-func NewUseCases(db *sql.DB) UseCases {
+// a nil encoderJobs publisher leaves the media PENDING for the encoder to pick up on its own
+func NewUseCases(db *sql.DB, mediaRootDir, encodedDir string, readinessRules []video.ReadinessRule, encoderJobs infra_messaging.Publisher) UseCases {
 	cGateway := gateway.NewCategoryGateway(db)
 	cmGateway := castmember.NewCastMemberGateway(db)
 	gGateway := infra_genre.NewGenreGateway(db)
 	vg := infra_video.NewVideoGateway(db)
+	sg := infra_series.NewSeriesGateway(db)
+	seasonGateway := infra_series.NewSeasonGateway(db)
+	seasonValidator := series_usecase.SeasonValidator{
+		Gateway:      seasonGateway,
+		VideoGateway: vg,
+	}
+	mg := infra_media.NewLocalMediaResourceGateway(mediaRootDir, encodedDir, vg)
+	thumbnails := infra_media.NewImageThumbnailGenerator()
+	metadataExtractor := infra_media.NewISOBMFFMetadataExtractor()
+	ug := infra_upload.NewUploadGateway(db)
+	cs := infra_upload.NewLocalChunkStorage(filepath.Join(mediaRootDir, "uploads"))
+	pg := infra_encoding.NewProfileGateway(db)
+	encodingJobs := video_usecase.EncodingJobs{Profiles: pg}
+	if encoderJobs != nil {
+		encodingJobs.Publisher = infra_encoding.NewJobPublisher(encoderJobs)
+	}
+	uploadMedia := video_usecase.DefaultUploadMediaUseCase{
+		Gateway:           vg,
+		MediaGateway:      mg,
+		Thumbnails:        thumbnails,
+		MetadataExtractor: metadataExtractor,
+		Encoding:          encodingJobs,
+	}
 	return UseCases{
 		Category: CategoryUseCase{
 			Create: categoryUsecase.DefaultCreateCategoryUseCase{
@@ -100,7 +201,130 @@ func NewUseCases(db *sql.DB) UseCases {
 				CategoryGateway:   cGateway,
 				GenreGateway:      gGateway,
 				CastMemberGateway: cmGateway,
+				MediaGateway:      mg,
+				Thumbnails:        thumbnails,
+				MetadataExtractor: metadataExtractor,
+				Encoding:          encodingJobs,
+			},
+			FindOne: video_usecase.DefaultGetVideoByIdUseCase{
+				Gateway:       vg,
+				SeriesGateway: sg,
+			},
+			FindAll: video_usecase.DefaultListVideosUseCase{
+				Gateway: vg,
+			},
+			Update: video_usecase.DefaultUpdateVideoUseCase{
+				Gateway:           vg,
+				CategoryGateway:   cGateway,
+				GenreGateway:      gGateway,
+				CastMemberGateway: cmGateway,
+			},
+			DeleteById: video_usecase.DefaultDeleteVideoUseCase{
+				Gateway:      vg,
+				MediaGateway: mg,
+			},
+			UploadMedia: uploadMedia,
+			GetMedia: video_usecase.DefaultGetMediaUseCase{
+				Gateway:      vg,
+				MediaGateway: mg,
+			},
+			UpdateMediaStatus: video_usecase.DefaultUpdateMediaStatusUseCase{
+				Gateway:  vg,
+				Profiles: pg,
 			},
+			RetryMedia: video_usecase.DefaultRetryMediaUseCase{
+				Gateway:  vg,
+				Encoding: encodingJobs,
+			},
+			Submit:            video_usecase.DefaultSubmitVideoUseCase{Gateway: vg},
+			Publish:           video_usecase.DefaultPublishVideoUseCase{Gateway: vg, SeriesGateway: sg, Rules: readinessRules},
+			Reject:            video_usecase.DefaultRejectVideoUseCase{Gateway: vg},
+			Unpublish:         video_usecase.DefaultUnpublishVideoUseCase{Gateway: vg},
+			PublishDue:        video_usecase.DefaultPublishDueVideosUseCase{Gateway: vg, SeriesGateway: sg, Rules: readinessRules},
+			Readiness:         video_usecase.DefaultGetReadinessUseCase{Gateway: vg, SeriesGateway: sg, Rules: readinessRules},
+			RatingEquivalents: video_usecase.DefaultGetRatingEquivalentsUseCase{},
+			UploadTextTrack:   video_usecase.DefaultUploadTextTrackUseCase{Gateway: vg, MediaGateway: mg},
+			ListTextTracks:    video_usecase.DefaultListTextTracksUseCase{Gateway: vg},
+			GetTextTrack:      video_usecase.DefaultGetTextTrackUseCase{Gateway: vg, MediaGateway: mg},
+			UploadAudioTrack:  video_usecase.DefaultUploadAudioTrackUseCase{Gateway: vg, MediaGateway: mg},
+			ListAudioTracks:   video_usecase.DefaultListAudioTracksUseCase{Gateway: vg},
+			DeleteAudioTrack:  video_usecase.DefaultDeleteAudioTrackUseCase{Gateway: vg, MediaGateway: mg},
+			CreateExtra:       video_usecase.DefaultCreateExtraUseCase{Gateway: vg},
+			UpdateExtra:       video_usecase.DefaultUpdateExtraUseCase{Gateway: vg},
+			ListExtras:        video_usecase.DefaultListExtrasUseCase{Gateway: vg},
+			DeleteExtra:       video_usecase.DefaultDeleteExtraUseCase{Gateway: vg, MediaGateway: mg},
+			ReorderExtras:     video_usecase.DefaultReorderExtrasUseCase{Gateway: vg},
+			PromoteExtra:      video_usecase.DefaultPromoteExtraUseCase{Gateway: vg, MediaGateway: mg},
+			UploadExtraMedia:  video_usecase.DefaultUploadExtraMediaUseCase{Gateway: vg, MediaGateway: mg},
+			GetExtraMedia:     video_usecase.DefaultGetExtraMediaUseCase{Gateway: vg, MediaGateway: mg},
+			UploadArtwork:     video_usecase.DefaultUploadArtworkUseCase{Gateway: vg, MediaGateway: mg},
+			ListArtwork:       video_usecase.DefaultListArtworkUseCase{Gateway: vg},
+			GetBestArtwork:    video_usecase.DefaultGetBestArtworkUseCase{Gateway: vg},
+			GetArtworkImage:   video_usecase.DefaultGetArtworkImageUseCase{Gateway: vg, MediaGateway: mg},
+			DeleteArtwork:     video_usecase.DefaultDeleteArtworkUseCase{Gateway: vg, MediaGateway: mg},
+			GetManifest:       video_usecase.DefaultGetManifestUseCase{Gateway: vg, Manifests: infra_media.NewAdaptiveManifestGenerator()},
+		},
+		Series: SeriesUseCase{
+			Create: series_usecase.DefaultCreateSeriesUseCase{
+				Gateway:         sg,
+				CategoryGateway: cGateway,
+				GenreGateway:    gGateway,
+			},
+			FindOne: series_usecase.DefaultGetSeriesByIdUseCase{
+				Gateway:       sg,
+				SeasonGateway: seasonGateway,
+			},
+			FindAll: series_usecase.DefaultListSeriesUseCase{
+				Gateway: sg,
+			},
+			Update: series_usecase.DefaultUpdateSeriesUseCase{
+				Gateway:         sg,
+				CategoryGateway: cGateway,
+				GenreGateway:    gGateway,
+			},
+			DeleteById: series_usecase.DefaultDeleteSeriesUseCase{
+				Gateway: sg,
+			},
+			CreateSeason: series_usecase.DefaultCreateSeasonUseCase{
+				SeasonValidator: seasonValidator,
+				SeriesGateway:   sg,
+			},
+			UpdateSeason: series_usecase.DefaultUpdateSeasonUseCase{
+				SeasonValidator: seasonValidator,
+			},
+			DeleteSeason: series_usecase.DefaultDeleteSeasonUseCase{
+				Gateway: seasonGateway,
+			},
+		},
+		Upload: UploadUseCase{
+			Create: upload_usecase.DefaultCreateUploadUseCase{
+				Gateway:      ug,
+				VideoGateway: vg,
+				MediaGateway: mg,
+				UploadMedia:  uploadMedia,
+			},
+			FindOne: upload_usecase.DefaultGetUploadUseCase{
+				Gateway: ug,
+			},
+			AppendChunk: upload_usecase.DefaultAppendChunkUseCase{
+				Gateway:     ug,
+				Storage:     cs,
+				UploadMedia: uploadMedia,
+			},
+			Terminate: upload_usecase.DefaultTerminateUploadUseCase{
+				Gateway: ug,
+				Storage: cs,
+			},
+		},
+		EncodingProfile: EncodingProfileUseCase{
+			Create:          encoding_usecase.DefaultCreateProfileUseCase{Gateway: pg},
+			FindOne:         encoding_usecase.DefaultGetProfileByIdUseCase{Gateway: pg},
+			FindAll:         encoding_usecase.DefaultListProfilesUseCase{Gateway: pg},
+			Update:          encoding_usecase.DefaultUpdateProfileUseCase{Gateway: pg},
+			DeleteById:      encoding_usecase.DefaultDeleteProfileUseCase{Gateway: pg},
+			AssignVideo:     encoding_usecase.DefaultAssignVideoProfileUseCase{Gateway: pg, VideoGateway: vg},
+			AssignCategory:  encoding_usecase.DefaultAssignCategoryProfileUseCase{Gateway: pg, CategoryGateway: cGateway},
+			GetVideoProfile: encoding_usecase.DefaultGetVideoProfileUseCase{Gateway: pg, VideoGateway: vg},
 		},
 	}
 }
@@ -0,0 +1,254 @@
+package video_usecase
+
+import (
+	"errors"
+
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/video"
+	"github.com.br/gibranct/admin_do_catalogo/pkg/notification"
+)
+
+type UploadArtworkCommand struct {
+	VideoId  int64
+	Role     string
+	Language string
+	Resource video.Resource
+}
+
+type ArtworkCommand struct {
+	VideoId   int64
+	ArtworkId int64
+}
+
+type BestArtworkCommand struct {
+	VideoId  int64
+	Role     string
+	Language string
+	Width    int
+}
+
+type ArtworkOutput struct {
+	ID          int64             `json:"id"`
+	Role        string            `json:"role"`
+	Language    string            `json:"language"`
+	AspectRatio string            `json:"aspectRatio"`
+	Width       int               `json:"width"`
+	Height      int               `json:"height"`
+	Image       *ImageMediaOutput `json:"image"`
+	Warnings    []string          `json:"warnings,omitempty"`
+}
+
+type UploadArtworkUseCase interface {
+	Execute(c UploadArtworkCommand) (*ArtworkOutput, error)
+}
+
+type ListArtworkUseCase interface {
+	Execute(videoId int64) ([]ArtworkOutput, error)
+}
+
+type GetBestArtworkUseCase interface {
+	Execute(c BestArtworkCommand) (*notification.Notification, *ArtworkOutput)
+}
+
+type GetArtworkImageUseCase interface {
+	Execute(c ArtworkCommand) (*video.Resource, error)
+}
+
+type DeleteArtworkUseCase interface {
+	Execute(c ArtworkCommand) error
+}
+
+type DefaultUploadArtworkUseCase struct {
+	Gateway      video.VideoGateway
+	MediaGateway video.MediaResourceGateway
+}
+
+type DefaultListArtworkUseCase struct {
+	Gateway video.VideoGateway
+}
+
+type DefaultGetBestArtworkUseCase struct {
+	Gateway video.VideoGateway
+}
+
+type DefaultGetArtworkImageUseCase struct {
+	Gateway      video.VideoGateway
+	MediaGateway video.MediaResourceGateway
+}
+
+type DefaultDeleteArtworkUseCase struct {
+	Gateway      video.VideoGateway
+	MediaGateway video.MediaResourceGateway
+}
+
+func (useCase DefaultUploadArtworkUseCase) Execute(command UploadArtworkCommand) (*ArtworkOutput, error) {
+	aVideo, err := useCase.Gateway.FindById(command.VideoId)
+	if err != nil {
+		return nil, err
+	}
+
+	n := notification.CreateNotification()
+
+	role, err := video.StringToArtworkRole(command.Role)
+	if err != nil {
+		n.Add(err)
+	}
+
+	head, err := peekHead(&command.Resource, useCase.MediaGateway)
+	if err != nil {
+		return nil, err
+	}
+
+	video.NewMediaValidator(video.ARTWORK, command.Resource.ContentType, head, n).Validate()
+
+	// the validator already reports images whose dimensions can't be read
+	width, height, err := video.ImageSize(head)
+	artwork := video.NewArtwork(role, command.Language, width, height, nil)
+	if err == nil {
+		artwork.Validate(n)
+	}
+
+	if n.HasErrors() {
+		return nil, InvalidMediaError{Notification: n}
+	}
+
+	image, err := useCase.MediaGateway.StoreArtwork(aVideo.ID, *artwork, command.Resource)
+	if err != nil {
+		return nil, err
+	}
+
+	staged := newStagedMedia(useCase.MediaGateway)
+	staged.add(image.Location, image.Checksum)
+
+	previous, replaced := aVideo.FindArtworkVariant(*artwork)
+	var previousChecksum string
+	if replaced {
+		image.ID = previous.Image.ID
+		previousChecksum = previous.Image.Checksum
+	}
+
+	artwork.Image = image
+
+	saved, err := useCase.Gateway.SaveArtwork(aVideo.ID, *artwork)
+	if err != nil {
+		return nil, staged.discard(err)
+	}
+
+	if err = staged.commit(); err != nil {
+		return nil, err
+	}
+
+	output := toArtworkOutput(*saved)
+	if replaced && previousChecksum != saved.Image.Checksum {
+		output.Warnings = releaseMedia(useCase.MediaGateway, previousChecksum)
+	}
+
+	return &output, nil
+}
+
+func (useCase DefaultListArtworkUseCase) Execute(videoId int64) ([]ArtworkOutput, error) {
+	aVideo, err := useCase.Gateway.FindById(videoId)
+	if err != nil {
+		return nil, err
+	}
+
+	return toArtworkOutputs(aVideo.Artworks), nil
+}
+
+func (useCase DefaultGetBestArtworkUseCase) Execute(command BestArtworkCommand) (*notification.Notification, *ArtworkOutput) {
+	n := notification.CreateNotification()
+
+	role, err := video.StringToArtworkRole(command.Role)
+	if err != nil {
+		n.Add(err)
+	}
+
+	if command.Width < 0 {
+		n.Add(errors.New("'width' must not be negative"))
+	}
+
+	if n.HasErrors() {
+		return n, nil
+	}
+
+	aVideo, err := useCase.Gateway.FindById(command.VideoId)
+	if err != nil {
+		n.Add(err)
+		return n, nil
+	}
+
+	artwork, found := aVideo.BestArtwork(role, command.Language, command.Width)
+	if !found {
+		n.Add(video.ErrArtworkNotFound)
+		return n, nil
+	}
+
+	output := toArtworkOutput(*artwork)
+	return nil, &output
+}
+
+func (useCase DefaultGetArtworkImageUseCase) Execute(command ArtworkCommand) (*video.Resource, error) {
+	aVideo, artwork, err := findArtwork(useCase.Gateway, command.VideoId, command.ArtworkId)
+	if err != nil {
+		return nil, err
+	}
+
+	resource, err := useCase.MediaGateway.GetArtwork(aVideo.ID, *artwork)
+	if err != nil {
+		return nil, err
+	}
+
+	resource.Checksum = artwork.Image.Checksum
+
+	return resource, nil
+}
+
+func (useCase DefaultDeleteArtworkUseCase) Execute(command ArtworkCommand) error {
+	aVideo, artwork, err := findArtwork(useCase.Gateway, command.VideoId, command.ArtworkId)
+	if err != nil {
+		return err
+	}
+
+	if err = useCase.Gateway.DeleteArtwork(aVideo.ID, artwork.ID); err != nil {
+		return err
+	}
+
+	if err = useCase.MediaGateway.RemoveArtwork(aVideo.ID, *artwork); err != nil {
+		return err
+	}
+
+	return useCase.MediaGateway.Release(artwork.Image.Checksum)
+}
+
+func findArtwork(gateway video.VideoGateway, videoId, artworkId int64) (*video.Video, *video.Artwork, error) {
+	aVideo, err := gateway.FindById(videoId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	artwork, found := aVideo.FindArtwork(artworkId)
+	if !found {
+		return nil, nil, video.ErrArtworkNotFound
+	}
+
+	return aVideo, artwork, nil
+}
+
+func toArtworkOutput(artwork video.Artwork) ArtworkOutput {
+	return ArtworkOutput{
+		ID:          artwork.ID,
+		Role:        artwork.Role.String(),
+		Language:    artwork.Language,
+		AspectRatio: artwork.AspectRatio(),
+		Width:       artwork.Width,
+		Height:      artwork.Height,
+		Image:       toImageMediaOutput(artwork.Image),
+	}
+}
+
+func toArtworkOutputs(artworks []video.Artwork) []ArtworkOutput {
+	outputs := []ArtworkOutput{}
+	for _, artwork := range artworks {
+		outputs = append(outputs, toArtworkOutput(artwork))
+	}
+	return outputs
+}
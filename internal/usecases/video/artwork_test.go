@@ -0,0 +1,188 @@
+package video_usecase_test
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/video"
+	video_usecase "github.com.br/gibranct/admin_do_catalogo/internal/usecases/video"
+	"github.com.br/gibranct/admin_do_catalogo/pkg/mocks"
+	"github.com.br/gibranct/admin_do_catalogo/pkg/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func videoWithArtwork() *video.Video {
+	aVideo := videoWithMedias()
+	aVideo.Artworks = []video.Artwork{
+		{ID: 1, Role: video.POSTER, Language: "", Width: 600, Height: 900, Image: video.NewImageMediaWithId(50, "neutral-sum", "poster.png", "/poster.png")},
+		{ID: 2, Role: video.POSTER, Language: "pt-BR", Width: 600, Height: 900, Image: video.NewImageMediaWithId(51, "pt-sum", "poster.pt.png", "/poster.pt.png")},
+		{ID: 3, Role: video.POSTER, Language: "pt-BR", Width: 1200, Height: 1800, Image: video.NewImageMediaWithId(52, "pt-large-sum", "poster.pt.png", "/poster.pt.png")},
+	}
+	return aVideo
+}
+
+func TestUploadArtworkReplacesTheSameVariant(t *testing.T) {
+	videoGateway := new(mocks.VideoGatewayMock)
+	mediaGateway := new(mocks.MediaResourceGatewayMock)
+	sut := video_usecase.DefaultUploadArtworkUseCase{Gateway: videoGateway, MediaGateway: mediaGateway}
+	aVideo := videoWithArtwork()
+	content := test.DummyPNG(600, 900)
+	var stored []byte
+
+	videoGateway.On("FindById", aVideo.ID).Return(aVideo, nil)
+	mediaGateway.On("StoreArtwork", aVideo.ID, mock.MatchedBy(func(artwork video.Artwork) bool {
+		return artwork.Role == video.POSTER && artwork.Language == "pt-BR" && artwork.Width == 600 && artwork.Height == 900
+	}), mock.Anything).
+		Run(func(args mock.Arguments) {
+			stored, _ = io.ReadAll(args.Get(2).(video.Resource).Stream)
+		}).
+		Return(video.NewImageMediaWithoutId("new-sum", "poster.pt.png", "/artwork/poster/pt-BR/600/poster.pt.png"), nil)
+	videoGateway.On("SaveArtwork", aVideo.ID, mock.MatchedBy(func(artwork video.Artwork) bool {
+		return artwork.Image.ID == 51 && artwork.Image.Checksum == "new-sum"
+	})).Return(&video.Artwork{
+		ID: 2, Role: video.POSTER, Language: "pt-BR", Width: 600, Height: 900,
+		Image: video.NewImageMediaWithId(51, "new-sum", "poster.pt.png", "/artwork/poster/pt-BR/600/poster.pt.png"),
+	}, nil)
+	mediaGateway.On("Commit", "/artwork/poster/pt-BR/600/poster.pt.png", "new-sum").Return(nil)
+	mediaGateway.On("Release", "pt-sum").Return(nil)
+
+	output, err := sut.Execute(video_usecase.UploadArtworkCommand{
+		VideoId:  aVideo.ID,
+		Role:     "POSTER",
+		Language: "pt-BR",
+		Resource: video.Resource{Stream: bytes.NewReader(content), Name: "poster.pt.png"},
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), output.ID)
+	assert.Equal(t, "2:3", output.AspectRatio)
+	assert.Equal(t, "new-sum", output.Image.Checksum)
+	assert.Equal(t, content, stored)
+	videoGateway.AssertExpectations(t)
+	mediaGateway.AssertExpectations(t)
+}
+
+func TestUploadInvalidArtwork(t *testing.T) {
+	videoGateway := new(mocks.VideoGatewayMock)
+	mediaGateway := new(mocks.MediaResourceGatewayMock)
+	sut := video_usecase.DefaultUploadArtworkUseCase{Gateway: videoGateway, MediaGateway: mediaGateway}
+	aVideo := videoWithArtwork()
+
+	videoGateway.On("FindById", aVideo.ID).Return(aVideo, nil)
+
+	output, err := sut.Execute(video_usecase.UploadArtworkCommand{
+		VideoId:  aVideo.ID,
+		Role:     "BACKDROP",
+		Language: "en",
+		Resource: video.Resource{Content: test.DummyPNG(600, 900), Name: "backdrop.png"},
+	})
+
+	var invalidErr video_usecase.InvalidMediaError
+	assert.Nil(t, output)
+	assert.ErrorAs(t, err, &invalidErr)
+	assert.Len(t, invalidErr.Notification.GetErrors(), 2)
+	assert.EqualError(t, invalidErr.Notification.GetErrors()[0], "'BACKDROP' artwork must be at least 1280x720 but got 600x900")
+	assert.EqualError(t, invalidErr.Notification.GetErrors()[1], "'BACKDROP' artwork aspect ratio must be 16:9 but got 600x900")
+	mediaGateway.AssertNumberOfCalls(t, "StoreArtwork", 0)
+}
+
+func TestUploadArtworkThatIsNotAnImage(t *testing.T) {
+	videoGateway := new(mocks.VideoGatewayMock)
+	mediaGateway := new(mocks.MediaResourceGatewayMock)
+	sut := video_usecase.DefaultUploadArtworkUseCase{Gateway: videoGateway, MediaGateway: mediaGateway}
+	aVideo := videoWithArtwork()
+
+	videoGateway.On("FindById", aVideo.ID).Return(aVideo, nil)
+
+	_, err := sut.Execute(video_usecase.UploadArtworkCommand{
+		VideoId:  aVideo.ID,
+		Role:     "CHARACTER",
+		Resource: video.Resource{Content: test.DummyMP4("movie"), Name: "poster.mp4"},
+	})
+
+	var invalidErr video_usecase.InvalidMediaError
+	assert.ErrorAs(t, err, &invalidErr)
+	assert.Len(t, invalidErr.Notification.GetErrors(), 2)
+	assert.EqualError(t, invalidErr.Notification.GetErrors()[0], "unknown artwork role 'CHARACTER'")
+	assert.EqualError(t, invalidErr.Notification.GetErrors()[1], "'Artwork' must be image content but got video/mp4")
+}
+
+func TestGetBestArtwork(t *testing.T) {
+	videoGateway := new(mocks.VideoGatewayMock)
+	sut := video_usecase.DefaultGetBestArtworkUseCase{Gateway: videoGateway}
+	aVideo := videoWithArtwork()
+
+	videoGateway.On("FindById", aVideo.ID).Return(aVideo, nil)
+
+	noti, output := sut.Execute(video_usecase.BestArtworkCommand{VideoId: aVideo.ID, Role: "POSTER", Language: "pt-BR", Width: 800})
+
+	assert.Nil(t, noti)
+	assert.Equal(t, int64(3), output.ID)
+
+	noti, output = sut.Execute(video_usecase.BestArtworkCommand{VideoId: aVideo.ID, Role: "POSTER", Language: "ja", Width: 800})
+
+	assert.Nil(t, noti)
+	assert.Equal(t, int64(1), output.ID)
+
+	noti, output = sut.Execute(video_usecase.BestArtworkCommand{VideoId: aVideo.ID, Role: "LOGO", Language: "en"})
+
+	assert.Nil(t, output)
+	assert.ErrorIs(t, noti.GetErrors()[0], video.ErrArtworkNotFound)
+}
+
+func TestGetBestArtworkWithInvalidQuery(t *testing.T) {
+	videoGateway := new(mocks.VideoGatewayMock)
+	sut := video_usecase.DefaultGetBestArtworkUseCase{Gateway: videoGateway}
+
+	noti, output := sut.Execute(video_usecase.BestArtworkCommand{VideoId: 999, Role: "BANNER", Width: -1})
+
+	assert.Nil(t, output)
+	assert.Len(t, noti.GetErrors(), 2)
+	assert.EqualError(t, noti.GetErrors()[0], "unknown artwork role 'BANNER'")
+	assert.EqualError(t, noti.GetErrors()[1], "'width' must not be negative")
+	videoGateway.AssertNotCalled(t, "FindById", mock.Anything)
+}
+
+func TestDeleteArtwork(t *testing.T) {
+	videoGateway := new(mocks.VideoGatewayMock)
+	mediaGateway := new(mocks.MediaResourceGatewayMock)
+	sut := video_usecase.DefaultDeleteArtworkUseCase{Gateway: videoGateway, MediaGateway: mediaGateway}
+	aVideo := videoWithArtwork()
+
+	videoGateway.On("FindById", aVideo.ID).Return(aVideo, nil)
+	videoGateway.On("DeleteArtwork", aVideo.ID, int64(2)).Return(nil)
+	mediaGateway.On("RemoveArtwork", aVideo.ID, aVideo.Artworks[1]).Return(nil)
+	mediaGateway.On("Release", "pt-sum").Return(nil)
+
+	err := sut.Execute(video_usecase.ArtworkCommand{VideoId: aVideo.ID, ArtworkId: 2})
+
+	assert.Nil(t, err)
+	videoGateway.AssertExpectations(t)
+	mediaGateway.AssertExpectations(t)
+
+	err = sut.Execute(video_usecase.ArtworkCommand{VideoId: aVideo.ID, ArtworkId: 9})
+
+	assert.ErrorIs(t, err, video.ErrArtworkNotFound)
+}
+
+func TestUploadArtworkAsPlainMedia(t *testing.T) {
+	videoGateway := new(mocks.VideoGatewayMock)
+	mediaGateway := new(mocks.MediaResourceGatewayMock)
+	sut := video_usecase.DefaultUploadMediaUseCase{Gateway: videoGateway, MediaGateway: mediaGateway}
+	aVideo := videoWithMedias()
+
+	videoGateway.On("FindById", aVideo.ID).Return(aVideo, nil)
+
+	_, err := sut.Execute(video_usecase.UploadMediaCommand{
+		VideoId:  aVideo.ID,
+		Type:     video.ARTWORK,
+		Resource: video.Resource{Content: test.DummyPNG(600, 900), Name: "poster.png"},
+	})
+
+	var invalidErr video_usecase.InvalidMediaError
+	assert.ErrorAs(t, err, &invalidErr)
+	assert.Len(t, invalidErr.Notification.GetErrors(), 1)
+	assert.EqualError(t, invalidErr.Notification.GetErrors()[0], "'Artwork' media must be uploaded as an artwork with a role and language")
+}
@@ -0,0 +1,157 @@
+package video_usecase
+
+import (
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/video"
+	"github.com.br/gibranct/admin_do_catalogo/pkg/notification"
+)
+
+type UploadAudioTrackCommand struct {
+	VideoId  int64
+	Language string
+	Role     string
+	Resource video.Resource
+}
+
+type DeleteAudioTrackCommand struct {
+	VideoId  int64
+	Language string
+}
+
+type AudioTrackOutput struct {
+	Language string                 `json:"language"`
+	Role     string                 `json:"role"`
+	Media    *AudioVideoMediaOutput `json:"media"`
+	Warnings []string               `json:"warnings,omitempty"`
+}
+
+type UploadAudioTrackUseCase interface {
+	Execute(c UploadAudioTrackCommand) (*AudioTrackOutput, error)
+}
+
+type ListAudioTracksUseCase interface {
+	Execute(videoId int64) ([]AudioTrackOutput, error)
+}
+
+type DeleteAudioTrackUseCase interface {
+	Execute(c DeleteAudioTrackCommand) error
+}
+
+type DefaultUploadAudioTrackUseCase struct {
+	Gateway      video.VideoGateway
+	MediaGateway video.MediaResourceGateway
+}
+
+type DefaultListAudioTracksUseCase struct {
+	Gateway video.VideoGateway
+}
+
+type DefaultDeleteAudioTrackUseCase struct {
+	Gateway      video.VideoGateway
+	MediaGateway video.MediaResourceGateway
+}
+
+func (useCase DefaultUploadAudioTrackUseCase) Execute(command UploadAudioTrackCommand) (*AudioTrackOutput, error) {
+	aVideo, err := useCase.Gateway.FindById(command.VideoId)
+	if err != nil {
+		return nil, err
+	}
+
+	n := notification.CreateNotification()
+
+	role, err := video.StringToAudioTrackRole(command.Role)
+	if err != nil {
+		n.Add(err)
+	}
+
+	aVideo.ValidateAudioTrack(*video.NewAudioTrack(command.Language, role, nil), n)
+
+	if err = validateMedia(video.AUDIO, &command.Resource, useCase.MediaGateway, n); err != nil {
+		return nil, err
+	}
+
+	if n.HasErrors() {
+		return nil, InvalidMediaError{Notification: n}
+	}
+
+	media, err := useCase.MediaGateway.StoreAudioTrack(aVideo.ID, command.Language, command.Resource)
+	if err != nil {
+		return nil, err
+	}
+
+	staged := newStagedMedia(useCase.MediaGateway)
+	staged.add(media.RawLocation, media.Checksum)
+
+	previous, replaced := aVideo.FindAudioTrack(command.Language)
+	var previousChecksum string
+	if replaced && previous.Media != nil {
+		media.ID = previous.Media.ID
+		previousChecksum = previous.Media.Checksum
+	}
+
+	track, err := useCase.Gateway.SaveAudioTrack(aVideo.ID, *video.NewAudioTrack(command.Language, role, media))
+	if err != nil {
+		return nil, staged.discard(err)
+	}
+
+	if err = staged.commit(); err != nil {
+		return nil, err
+	}
+
+	output := toAudioTrackOutput(*track)
+	if replaced && previousChecksum != track.Media.Checksum {
+		output.Warnings = releaseMedia(useCase.MediaGateway, previousChecksum)
+	}
+
+	return &output, nil
+}
+
+func (useCase DefaultListAudioTracksUseCase) Execute(videoId int64) ([]AudioTrackOutput, error) {
+	aVideo, err := useCase.Gateway.FindById(videoId)
+	if err != nil {
+		return nil, err
+	}
+
+	return toAudioTrackOutputs(aVideo.AudioTracks), nil
+}
+
+func (useCase DefaultDeleteAudioTrackUseCase) Execute(command DeleteAudioTrackCommand) error {
+	aVideo, err := useCase.Gateway.FindById(command.VideoId)
+	if err != nil {
+		return err
+	}
+
+	track, found := aVideo.RemoveAudioTrack(command.Language)
+	if !found {
+		return video.ErrResourceNotFound
+	}
+
+	if err = useCase.Gateway.DeleteAudioTrack(aVideo.ID, command.Language); err != nil {
+		return err
+	}
+
+	if err = useCase.MediaGateway.RemoveAudioTrack(aVideo.ID, command.Language); err != nil {
+		return err
+	}
+
+	if track.Media != nil {
+		return useCase.MediaGateway.Release(track.Media.Checksum)
+	}
+
+	return nil
+}
+
+func toAudioTrackOutput(track video.AudioTrack) AudioTrackOutput {
+	return AudioTrackOutput{
+		Language: track.Language,
+		Role:     track.Role.String(),
+		Media:    toAudioVideoMediaOutput(track.Media),
+	}
+}
+
+func toAudioTrackOutputs(tracks []video.AudioTrack) []AudioTrackOutput {
+	outputs := []AudioTrackOutput{}
+	for _, track := range tracks {
+		outputs = append(outputs, toAudioTrackOutput(track))
+	}
+	return outputs
+}
@@ -0,0 +1,170 @@
+package video_usecase_test
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/video"
+	video_usecase "github.com.br/gibranct/admin_do_catalogo/internal/usecases/video"
+	"github.com.br/gibranct/admin_do_catalogo/pkg/mocks"
+	"github.com.br/gibranct/admin_do_catalogo/pkg/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestUploadAudioTrackReplacesTheSameLanguage(t *testing.T) {
+	videoGateway := new(mocks.VideoGatewayMock)
+	mediaGateway := new(mocks.MediaResourceGatewayMock)
+	sut := video_usecase.DefaultUploadAudioTrackUseCase{Gateway: videoGateway, MediaGateway: mediaGateway}
+	aVideo := videoWithMedias()
+	failed := video.FAILED
+	aVideo.UpdateAudioTrack(*video.NewAudioTrack("es", video.DUB, video.NewAudioVideoMediaWith(30, &failed, "old-sum", "es.mp4", "/es.mp4", "")))
+	pending := video.PENDING
+	stored := video.NewAudioVideoMediaWith(0, &pending, "new-sum", "es.mp4", "/audio/es/es.mp4", "")
+
+	videoGateway.On("FindById", aVideo.ID).Return(aVideo, nil)
+	mediaGateway.On("StoreAudioTrack", aVideo.ID, "es", mock.Anything).Return(stored, nil)
+	videoGateway.On("SaveAudioTrack", aVideo.ID, mock.MatchedBy(func(track video.AudioTrack) bool {
+		return track.Language == "es" && track.Role == video.DUB && track.Media.ID == 30
+	})).Return(video.NewAudioTrack("es", video.DUB, stored), nil)
+	mediaGateway.On("Commit", "/audio/es/es.mp4", "new-sum").Return(nil)
+	mediaGateway.On("Release", "old-sum").Return(nil)
+
+	output, err := sut.Execute(video_usecase.UploadAudioTrackCommand{
+		VideoId:  aVideo.ID,
+		Language: "es",
+		Role:     "DUB",
+		Resource: video.Resource{Stream: bytes.NewReader(test.DummyMP4("spanish")), Name: "es.mp4"},
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, "es", output.Language)
+	assert.Equal(t, "DUB", output.Role)
+	assert.Equal(t, "PENDING", output.Media.Status)
+	videoGateway.AssertExpectations(t)
+	mediaGateway.AssertExpectations(t)
+}
+
+func TestUploadAudioTrackDiscardsTheStoredMediaWhenItIsNotSaved(t *testing.T) {
+	videoGateway := new(mocks.VideoGatewayMock)
+	mediaGateway := new(mocks.MediaResourceGatewayMock)
+	sut := video_usecase.DefaultUploadAudioTrackUseCase{Gateway: videoGateway, MediaGateway: mediaGateway}
+	aVideo := videoWithMedias()
+	pending := video.PENDING
+	stored := video.NewAudioVideoMediaWith(0, &pending, "new-sum", "es.mp4", "/audio/es/es.mp4", "")
+	expectedErr := errors.New("connection reset")
+
+	videoGateway.On("FindById", aVideo.ID).Return(aVideo, nil)
+	mediaGateway.On("StoreAudioTrack", aVideo.ID, "es", mock.Anything).Return(stored, nil)
+	videoGateway.On("SaveAudioTrack", aVideo.ID, mock.Anything).Return((*video.AudioTrack)(nil), expectedErr)
+	mediaGateway.On("Discard", "new-sum").Return(errors.New("permission denied"))
+
+	output, err := sut.Execute(video_usecase.UploadAudioTrackCommand{
+		VideoId:  aVideo.ID,
+		Language: "es",
+		Role:     "DUB",
+		Resource: video.Resource{Stream: bytes.NewReader(test.DummyMP4("spanish")), Name: "es.mp4"},
+	})
+
+	assert.Nil(t, output)
+	assert.ErrorIs(t, err, expectedErr)
+	assert.ErrorContains(t, err, "could not discard stored media: permission denied")
+	mediaGateway.AssertNotCalled(t, "Commit", mock.Anything, mock.Anything)
+	mediaGateway.AssertExpectations(t)
+}
+
+func TestUploadAudioTrackWarnsWhenThePreviousMediaIsNotReleased(t *testing.T) {
+	videoGateway := new(mocks.VideoGatewayMock)
+	mediaGateway := new(mocks.MediaResourceGatewayMock)
+	sut := video_usecase.DefaultUploadAudioTrackUseCase{Gateway: videoGateway, MediaGateway: mediaGateway}
+	aVideo := videoWithMedias()
+	failed := video.FAILED
+	aVideo.UpdateAudioTrack(*video.NewAudioTrack("es", video.DUB, video.NewAudioVideoMediaWith(30, &failed, "old-sum", "es.mp4", "/es.mp4", "")))
+	pending := video.PENDING
+	stored := video.NewAudioVideoMediaWith(0, &pending, "new-sum", "es.mp4", "/audio/es/es.mp4", "")
+
+	videoGateway.On("FindById", aVideo.ID).Return(aVideo, nil)
+	mediaGateway.On("StoreAudioTrack", aVideo.ID, "es", mock.Anything).Return(stored, nil)
+	videoGateway.On("SaveAudioTrack", aVideo.ID, mock.Anything).Return(video.NewAudioTrack("es", video.DUB, stored), nil)
+	mediaGateway.On("Commit", "/audio/es/es.mp4", "new-sum").Return(nil)
+	mediaGateway.On("Release", "old-sum").Return(errors.New("permission denied"))
+
+	output, err := sut.Execute(video_usecase.UploadAudioTrackCommand{
+		VideoId:  aVideo.ID,
+		Language: "es",
+		Role:     "DUB",
+		Resource: video.Resource{Stream: bytes.NewReader(test.DummyMP4("spanish")), Name: "es.mp4"},
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"could not release previous media old-sum: permission denied"}, output.Warnings)
+	mediaGateway.AssertExpectations(t)
+}
+
+func TestUploadInvalidAudioTrack(t *testing.T) {
+	videoGateway := new(mocks.VideoGatewayMock)
+	mediaGateway := new(mocks.MediaResourceGatewayMock)
+	sut := video_usecase.DefaultUploadAudioTrackUseCase{Gateway: videoGateway, MediaGateway: mediaGateway}
+	aVideo := videoWithMedias()
+	aVideo.UpdateAudioTrack(*video.NewAudioTrack("en", video.ORIGINAL, nil))
+
+	videoGateway.On("FindById", aVideo.ID).Return(aVideo, nil)
+
+	output, err := sut.Execute(video_usecase.UploadAudioTrackCommand{
+		VideoId:  aVideo.ID,
+		Language: "pt-BR",
+		Role:     "ORIGINAL",
+		Resource: video.Resource{Content: test.DummyPNG(10, 10), Name: "pt.png"},
+	})
+
+	var invalidErr video_usecase.InvalidMediaError
+	assert.Nil(t, output)
+	assert.ErrorAs(t, err, &invalidErr)
+	assert.Len(t, invalidErr.Notification.GetErrors(), 2)
+	assert.EqualError(t, invalidErr.Notification.GetErrors()[0], "'en' is already the ORIGINAL audio track")
+	assert.EqualError(t, invalidErr.Notification.GetErrors()[1], "'Audio' must be audio content but got image/png")
+	mediaGateway.AssertNumberOfCalls(t, "StoreAudioTrack", 0)
+}
+
+func TestListAudioTracks(t *testing.T) {
+	videoGateway := new(mocks.VideoGatewayMock)
+	sut := video_usecase.DefaultListAudioTracksUseCase{Gateway: videoGateway}
+	aVideo := videoWithMedias()
+	status := video.COMPLETED
+	aVideo.UpdateAudioTrack(*video.NewAudioTrack("en", video.ORIGINAL, video.NewAudioVideoMediaWith(30, &status, "sum", "en.mp4", "/en.mp4", "/encoded/en")))
+
+	videoGateway.On("FindById", aVideo.ID).Return(aVideo, nil)
+
+	outputs, err := sut.Execute(aVideo.ID)
+
+	assert.Nil(t, err)
+	assert.Len(t, outputs, 1)
+	assert.Equal(t, "ORIGINAL", outputs[0].Role)
+	assert.Equal(t, int64(30), outputs[0].Media.ID)
+	assert.Equal(t, "/encoded/en", outputs[0].Media.EncodedLocation)
+}
+
+func TestDeleteAudioTrack(t *testing.T) {
+	videoGateway := new(mocks.VideoGatewayMock)
+	mediaGateway := new(mocks.MediaResourceGatewayMock)
+	sut := video_usecase.DefaultDeleteAudioTrackUseCase{Gateway: videoGateway, MediaGateway: mediaGateway}
+	aVideo := videoWithMedias()
+	status := video.COMPLETED
+	aVideo.UpdateAudioTrack(*video.NewAudioTrack("en", video.ORIGINAL, video.NewAudioVideoMediaWith(30, &status, "sum", "en.mp4", "/en.mp4", "")))
+
+	videoGateway.On("FindById", aVideo.ID).Return(aVideo, nil)
+	videoGateway.On("DeleteAudioTrack", aVideo.ID, "en").Return(nil)
+	mediaGateway.On("RemoveAudioTrack", aVideo.ID, "en").Return(nil)
+	mediaGateway.On("Release", "sum").Return(nil)
+
+	err := sut.Execute(video_usecase.DeleteAudioTrackCommand{VideoId: aVideo.ID, Language: "en"})
+
+	assert.Nil(t, err)
+	videoGateway.AssertExpectations(t)
+	mediaGateway.AssertExpectations(t)
+
+	err = sut.Execute(video_usecase.DeleteAudioTrackCommand{VideoId: aVideo.ID, Language: "es"})
+
+	assert.ErrorIs(t, err, video.ErrResourceNotFound)
+}
@@ -1,6 +1,7 @@
 package video_usecase
 
 import (
+	"errors"
 	"fmt"
 	"slices"
 	"strconv"
@@ -23,11 +24,12 @@ type CreateVideoCommand struct {
 	LaunchedAt    int
 	Duration      float64
 	Opened        bool
-	Published     bool
 	Rating        string
 	CategoryIds   []int64
 	GenreIds      []int64
 	MemberIds     []int64
+	Ratings       []RatingCommand
+	Descriptors   []string
 	Video         *video.Resource
 	Trailer       *video.Resource
 	Banner        *video.Resource
@@ -44,6 +46,10 @@ type DefaultCreateVideoUseCase struct {
 	CategoryGateway   category.CategoryGateway
 	GenreGateway      genre.GenreGateway
 	CastMemberGateway castmember.CastMemberGateway
+	MediaGateway      video.MediaResourceGateway
+	Thumbnails        video.ThumbnailGenerator
+	MetadataExtractor video.MediaMetadataExtractor
+	Encoding          EncodingJobs
 }
 
 func NewDefaultCreateVideoUseCase(
@@ -51,12 +57,14 @@ func NewDefaultCreateVideoUseCase(
 	cg category.CategoryGateway,
 	gg genre.GenreGateway,
 	ccg castmember.CastMemberGateway,
+	mg video.MediaResourceGateway,
 ) *DefaultCreateVideoUseCase {
 	return &DefaultCreateVideoUseCase{
 		Gateway:           vg,
 		CategoryGateway:   cg,
 		GenreGateway:      gg,
 		CastMemberGateway: ccg,
+		MediaGateway:      mg,
 	}
 }
 
@@ -79,14 +87,16 @@ func (useCase DefaultCreateVideoUseCase) Execute(
 		command.LaunchedAt,
 		command.Duration,
 		command.Opened,
-		command.Published,
 		rating,
 		command.CategoryIds,
 		command.GenreIds,
 		command.MemberIds,
 	)
+	video.UpdateRatings(toTerritoryRatings(command.Ratings, n))
+	video.UpdateDescriptors(toContentDescriptors(command.Descriptors, n))
 
 	video.Validate(n)
+	n.Append(validateResources(command, useCase.MediaGateway))
 
 	if n.HasErrors() {
 		return n, nil
@@ -107,11 +117,137 @@ func (useCase DefaultCreateVideoUseCase) Execute(
 		return n, nil
 	}
 
+	if hasResources(command) {
+		videoId := savedVideo.ID
+		staged := newStagedMedia(useCase.MediaGateway)
+		savedVideo, err = useCase.storeResources(savedVideo, command, staged)
+
+		if err == nil {
+			err = staged.commit()
+		}
+
+		if err != nil {
+			n.Add(useCase.rollback(videoId, staged, err))
+			return n, nil
+		}
+
+		// the video is kept, a media that could not be handed to the encoder is left FAILED to be retried
+		useCase.Encoding.request(useCase.Gateway, savedVideo)
+	}
+
 	return nil, &CreateVideoOutput{
 		ID: savedVideo.ID,
 	}
 }
 
+func (useCase DefaultCreateVideoUseCase) storeResources(
+	aVideo *video.Video,
+	command CreateVideoCommand,
+	staged *stagedMedia,
+) (*video.Video, error) {
+	audioVideoResources := []struct {
+		aType    video.VideoMediaType
+		resource *video.Resource
+		update   func(*video.AudioVideoMedia) *video.Video
+	}{
+		{video.VIDEO, command.Video, aVideo.UpdateVideoMedia},
+		{video.TRAILER, command.Trailer, aVideo.UpdateTrailerMedia},
+	}
+
+	for _, r := range audioVideoResources {
+		if r.resource == nil {
+			continue
+		}
+		media, err := useCase.MediaGateway.StoreAudioVideo(aVideo.ID, video.VideoResource{Type: r.aType, Resource: *r.resource})
+		if err != nil {
+			return nil, err
+		}
+		staged.add(media.RawLocation, media.Checksum)
+		if useCase.MetadataExtractor != nil {
+			media.Metadata, err = extractMetadata(media.Checksum, useCase.MediaGateway, useCase.MetadataExtractor)
+			if err != nil {
+				return nil, err
+			}
+		}
+		r.update(media)
+	}
+
+	imageResources := []struct {
+		aType    video.VideoMediaType
+		resource *video.Resource
+		update   func(*video.ImageMedia) *video.Video
+	}{
+		{video.BANNER, command.Banner, aVideo.UpdateBannerMedia},
+		{video.THUMBNAIL, command.Thumbnail, aVideo.UpdateThumbnailMedia},
+		{video.THUMBNAIL_HALF, command.ThumbnailHalf, aVideo.UpdateThumbnailHalfMedia},
+	}
+
+	for _, r := range imageResources {
+		if r.resource == nil {
+			continue
+		}
+		media, err := useCase.MediaGateway.StoreImage(aVideo.ID, video.VideoResource{Type: r.aType, Resource: *r.resource})
+		if err != nil {
+			return nil, err
+		}
+		staged.add(media.Location, media.Checksum)
+		r.update(media)
+	}
+
+	if command.Thumbnail != nil && command.ThumbnailHalf == nil && useCase.Thumbnails != nil {
+		if err := generateThumbnailHalf(aVideo, useCase.MediaGateway, useCase.Thumbnails, staged); err != nil {
+			return nil, err
+		}
+	}
+
+	return useCase.Gateway.Update(*aVideo)
+}
+
+func validateResources(command CreateVideoCommand, mediaGateway video.MediaResourceGateway) *notification.Notification {
+	n := notification.CreateNotification()
+	resources := []struct {
+		aType    video.VideoMediaType
+		resource *video.Resource
+	}{
+		{video.VIDEO, command.Video},
+		{video.TRAILER, command.Trailer},
+		{video.BANNER, command.Banner},
+		{video.THUMBNAIL, command.Thumbnail},
+		{video.THUMBNAIL_HALF, command.ThumbnailHalf},
+	}
+
+	for _, r := range resources {
+		if r.resource == nil {
+			continue
+		}
+		if err := validateMedia(r.aType, r.resource, mediaGateway, n); err != nil {
+			n.Add(err)
+		}
+	}
+
+	return n
+}
+
+// the video is removed again before its staged content is discarded, so nothing references
+// that content anymore, a rollback that fails is reported along with its cause
+func (useCase DefaultCreateVideoUseCase) rollback(videoId int64, staged *stagedMedia, cause error) error {
+	err := errors.Join(useCase.Gateway.DeleteById(videoId), useCase.MediaGateway.ClearResources(videoId))
+	cause = staged.discard(cause)
+	if err == nil {
+		return cause
+	}
+
+	return errors.Join(cause, fmt.Errorf("could not roll back video %d: %w", videoId, err))
+}
+
+func hasResources(command CreateVideoCommand) bool {
+	return command.Video != nil ||
+		command.Trailer != nil ||
+		command.Banner != nil ||
+		command.Thumbnail != nil ||
+		command.ThumbnailHalf != nil
+}
+
 func (useCase DefaultCreateVideoUseCase) ValidateCategories(ids []int64) *notification.Notification {
 	return validateAggregate("categories", ids, useCase.CategoryGateway.ExistsByIds)
 }
@@ -8,6 +8,7 @@ import (
 	"github.com.br/gibranct/admin_do_catalogo/internal/domain/video"
 	video_usecase "github.com.br/gibranct/admin_do_catalogo/internal/usecases/video"
 	"github.com.br/gibranct/admin_do_catalogo/pkg/mocks"
+	"github.com.br/gibranct/admin_do_catalogo/pkg/test"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -19,7 +20,6 @@ func dummyCreateVideoCommand() video_usecase.CreateVideoCommand {
 		LaunchedAt:  2024,
 		Duration:    120.0,
 		Opened:      true,
-		Published:   true,
 		Rating:      "Livre",
 		CategoryIds: []int64{78, 45},
 		GenreIds:    []int64{39},
@@ -32,8 +32,9 @@ func TestCreateVideo(t *testing.T) {
 	categoryGateway := new(mocks.CategoryGatewayMock)
 	genreGateway := new(mocks.GenreGatewayMock)
 	castGateway := new(mocks.CastMemberGatewayMock)
+	mediaGateway := new(mocks.MediaResourceGatewayMock)
 	sut := video_usecase.NewDefaultCreateVideoUseCase(
-		videoGateway, categoryGateway, genreGateway, castGateway,
+		videoGateway, categoryGateway, genreGateway, castGateway, mediaGateway,
 	)
 	video := video.Video{
 		ID: 999,
@@ -73,8 +74,9 @@ func TestCreateVideoWithInvalidRating(t *testing.T) {
 	categoryGateway := new(mocks.CategoryGatewayMock)
 	genreGateway := new(mocks.GenreGatewayMock)
 	castGateway := new(mocks.CastMemberGatewayMock)
+	mediaGateway := new(mocks.MediaResourceGatewayMock)
 	sut := video_usecase.NewDefaultCreateVideoUseCase(
-		videoGateway, categoryGateway, genreGateway, castGateway,
+		videoGateway, categoryGateway, genreGateway, castGateway, mediaGateway,
 	)
 	command := dummyCreateVideoCommand()
 	command.Rating = "dummy"
@@ -92,8 +94,9 @@ func TestCreateVideoWithInvalidTitleAndDescription(t *testing.T) {
 	categoryGateway := new(mocks.CategoryGatewayMock)
 	genreGateway := new(mocks.GenreGatewayMock)
 	castGateway := new(mocks.CastMemberGatewayMock)
+	mediaGateway := new(mocks.MediaResourceGatewayMock)
 	sut := video_usecase.NewDefaultCreateVideoUseCase(
-		videoGateway, categoryGateway, genreGateway, castGateway,
+		videoGateway, categoryGateway, genreGateway, castGateway, mediaGateway,
 	)
 
 	tests := []struct {
@@ -136,3 +139,212 @@ func TestCreateVideoWithInvalidTitleAndDescription(t *testing.T) {
 		assert.Equal(t, test.err.Error(), noti.GetErrors()[0].Error())
 	}
 }
+
+func TestCreateVideoWithResources(t *testing.T) {
+	videoGateway := new(mocks.VideoGatewayMock)
+	categoryGateway := new(mocks.CategoryGatewayMock)
+	genreGateway := new(mocks.GenreGatewayMock)
+	castGateway := new(mocks.CastMemberGatewayMock)
+	mediaGateway := new(mocks.MediaResourceGatewayMock)
+	sut := video_usecase.NewDefaultCreateVideoUseCase(
+		videoGateway, categoryGateway, genreGateway, castGateway, mediaGateway,
+	)
+	savedVideo := &video.Video{ID: 999}
+	status := video.PENDING
+	videoMedia := video.NewAudioVideoMediaWith(0, &status, "checksum", "movie.mp4", "/videos/999/video/movie.mp4", "")
+	bannerMedia := video.NewImageMediaWithoutId("checksum", "banner.png", "/videos/999/banner/banner.png")
+
+	command := dummyCreateVideoCommand()
+	command.CategoryIds = nil
+	command.GenreIds = nil
+	command.MemberIds = nil
+	command.Video = &video.Resource{Name: "movie.mp4", Content: test.DummyMP4("video")}
+	command.Banner = &video.Resource{Name: "banner.png", Content: test.DummyPNG(1280, 720)}
+
+	videoGateway.On("Create", mock.Anything).Return(savedVideo, nil)
+	mediaGateway.On("StoreAudioVideo", savedVideo.ID, video.VideoResource{Type: video.VIDEO, Resource: *command.Video}).
+		Return(videoMedia, nil)
+	mediaGateway.On("StoreImage", savedVideo.ID, video.VideoResource{Type: video.BANNER, Resource: *command.Banner}).
+		Return(bannerMedia, nil)
+	videoGateway.On("Update", mock.MatchedBy(func(v video.Video) bool {
+		return v.ID == savedVideo.ID && v.Video == videoMedia && v.Banner == bannerMedia
+	})).Return(savedVideo, nil)
+	mediaGateway.On("Commit", "/videos/999/video/movie.mp4", "checksum").Return(nil)
+	mediaGateway.On("Commit", "/videos/999/banner/banner.png", "checksum").Return(nil)
+
+	noti, output := sut.Execute(command)
+
+	assert.Nil(t, noti)
+	assert.Equal(t, savedVideo.ID, output.ID)
+	videoGateway.AssertExpectations(t)
+	mediaGateway.AssertExpectations(t)
+	mediaGateway.AssertNumberOfCalls(t, "StoreAudioVideo", 1)
+	mediaGateway.AssertNumberOfCalls(t, "StoreImage", 1)
+}
+
+func TestCreateVideoGeneratesThumbnailHalf(t *testing.T) {
+	videoGateway := new(mocks.VideoGatewayMock)
+	mediaGateway := new(mocks.MediaResourceGatewayMock)
+	thumbnails := new(mocks.ThumbnailGeneratorMock)
+	sut := video_usecase.NewDefaultCreateVideoUseCase(
+		videoGateway, new(mocks.CategoryGatewayMock), new(mocks.GenreGatewayMock), new(mocks.CastMemberGatewayMock), mediaGateway,
+	)
+	sut.Thumbnails = thumbnails
+	savedVideo := &video.Video{ID: 999}
+	thumbnailMedia := video.NewImageMediaWithoutId("checksum", "cover.png", "/videos/999/thumbnail/cover.png")
+	halfMedia := video.NewImageMediaWithoutId("half", "cover_half.png", "/videos/999/thumbnail_half/cover_half.png")
+	stored := &video.Resource{Name: "cover.png", Content: test.DummyPNG(640, 360)}
+	half := &video.Resource{Name: "cover_half.png", Content: test.DummyPNG(320, 180)}
+
+	command := dummyCreateVideoCommand()
+	command.CategoryIds = nil
+	command.GenreIds = nil
+	command.MemberIds = nil
+	command.Thumbnail = &video.Resource{Name: "cover.png", Content: test.DummyPNG(640, 360)}
+
+	videoGateway.On("Create", mock.Anything).Return(savedVideo, nil)
+	mediaGateway.On("StoreImage", savedVideo.ID, video.VideoResource{Type: video.THUMBNAIL, Resource: *command.Thumbnail}).
+		Return(thumbnailMedia, nil)
+	mediaGateway.On("GetBlob", "checksum").Return(stored, nil)
+	thumbnails.On("GenerateHalf", *stored).Return(half, nil)
+	mediaGateway.On("StoreImage", savedVideo.ID, video.VideoResource{Type: video.THUMBNAIL_HALF, Resource: *half}).
+		Return(halfMedia, nil)
+	videoGateway.On("Update", mock.MatchedBy(func(v video.Video) bool {
+		return v.ThumbNail == thumbnailMedia && v.ThumbNailHalf == halfMedia
+	})).Return(savedVideo, nil)
+	mediaGateway.On("Commit", "/videos/999/thumbnail/cover.png", "checksum").Return(nil)
+	mediaGateway.On("Commit", "/videos/999/thumbnail_half/cover_half.png", "half").Return(nil)
+
+	noti, output := sut.Execute(command)
+
+	assert.Nil(t, noti)
+	assert.Equal(t, savedVideo.ID, output.ID)
+	videoGateway.AssertExpectations(t)
+	mediaGateway.AssertExpectations(t)
+	thumbnails.AssertExpectations(t)
+}
+
+func TestCreateVideoWithInvalidResources(t *testing.T) {
+	videoGateway := new(mocks.VideoGatewayMock)
+	mediaGateway := new(mocks.MediaResourceGatewayMock)
+	sut := video_usecase.NewDefaultCreateVideoUseCase(
+		videoGateway, new(mocks.CategoryGatewayMock), new(mocks.GenreGatewayMock), new(mocks.CastMemberGatewayMock), mediaGateway,
+	)
+
+	command := dummyCreateVideoCommand()
+	command.CategoryIds = nil
+	command.GenreIds = nil
+	command.MemberIds = nil
+	command.Video = &video.Resource{Name: "banner.png", Content: test.DummyPNG(1280, 720)}
+	command.Banner = &video.Resource{Name: "banner.png", Content: test.DummyPNG(640, 480)}
+
+	noti, output := sut.Execute(command)
+
+	assert.Nil(t, output)
+	assert.Len(t, noti.GetErrors(), 3)
+	assert.Equal(t, "'Video' must be video content but got image/png", noti.GetErrors()[0].Error())
+	assert.Equal(t, "'Banner' must be at least 1280x720 but got 640x480", noti.GetErrors()[1].Error())
+	assert.Equal(t, "'Banner' aspect ratio must be 16:9 but got 640x480", noti.GetErrors()[2].Error())
+	videoGateway.AssertNotCalled(t, "Create", mock.Anything)
+	mediaGateway.AssertNotCalled(t, "StoreImage", mock.Anything, mock.Anything)
+}
+
+func TestCreateVideoWhenStoringResourceFails(t *testing.T) {
+	videoGateway := new(mocks.VideoGatewayMock)
+	categoryGateway := new(mocks.CategoryGatewayMock)
+	genreGateway := new(mocks.GenreGatewayMock)
+	castGateway := new(mocks.CastMemberGatewayMock)
+	mediaGateway := new(mocks.MediaResourceGatewayMock)
+	sut := video_usecase.NewDefaultCreateVideoUseCase(
+		videoGateway, categoryGateway, genreGateway, castGateway, mediaGateway,
+	)
+	savedVideo := &video.Video{ID: 999}
+	expectedErr := errors.New("checksum mismatch")
+
+	command := dummyCreateVideoCommand()
+	command.CategoryIds = nil
+	command.GenreIds = nil
+	command.MemberIds = nil
+	command.Trailer = &video.Resource{Name: "trailer.mp4", Content: test.DummyMP4("video")}
+
+	videoGateway.On("Create", mock.Anything).Return(savedVideo, nil)
+	mediaGateway.On("StoreAudioVideo", savedVideo.ID, mock.Anything).Return(&video.AudioVideoMedia{}, expectedErr)
+	mediaGateway.On("ClearResources", savedVideo.ID).Return(nil)
+	videoGateway.On("DeleteById", savedVideo.ID).Return(nil)
+
+	noti, output := sut.Execute(command)
+
+	assert.Nil(t, output)
+	assert.Len(t, noti.GetErrors(), 1)
+	assert.Equal(t, expectedErr, noti.GetErrors()[0])
+	videoGateway.AssertExpectations(t)
+	mediaGateway.AssertExpectations(t)
+	videoGateway.AssertNotCalled(t, "Update", mock.Anything)
+}
+
+func TestCreateVideoDiscardsStoredResourcesWhenAnotherFails(t *testing.T) {
+	videoGateway := new(mocks.VideoGatewayMock)
+	mediaGateway := new(mocks.MediaResourceGatewayMock)
+	sut := video_usecase.NewDefaultCreateVideoUseCase(
+		videoGateway, new(mocks.CategoryGatewayMock), new(mocks.GenreGatewayMock), new(mocks.CastMemberGatewayMock), mediaGateway,
+	)
+	savedVideo := &video.Video{ID: 999}
+	status := video.PENDING
+	videoMedia := video.NewAudioVideoMediaWith(0, &status, "video-sum", "movie.mp4", "/videos/999/video/movie.mp4", "")
+	expectedErr := errors.New("disk is full")
+
+	command := dummyCreateVideoCommand()
+	command.CategoryIds = nil
+	command.GenreIds = nil
+	command.MemberIds = nil
+	command.Video = &video.Resource{Name: "movie.mp4", Content: test.DummyMP4("video")}
+	command.Banner = &video.Resource{Name: "banner.png", Content: test.DummyPNG(1280, 720)}
+
+	videoGateway.On("Create", mock.Anything).Return(savedVideo, nil)
+	mediaGateway.On("StoreAudioVideo", savedVideo.ID, mock.Anything).Return(videoMedia, nil)
+	mediaGateway.On("StoreImage", savedVideo.ID, mock.Anything).Return((*video.ImageMedia)(nil), expectedErr)
+	videoGateway.On("DeleteById", savedVideo.ID).Return(nil)
+	mediaGateway.On("ClearResources", savedVideo.ID).Return(nil)
+	mediaGateway.On("Discard", "video-sum").Return(nil)
+
+	noti, output := sut.Execute(command)
+
+	assert.Nil(t, output)
+	assert.Equal(t, expectedErr, noti.GetErrors()[0])
+	mediaGateway.AssertExpectations(t)
+	mediaGateway.AssertNotCalled(t, "Commit", mock.Anything, mock.Anything)
+}
+
+func TestCreateVideoWhenRollbackFails(t *testing.T) {
+	videoGateway := new(mocks.VideoGatewayMock)
+	categoryGateway := new(mocks.CategoryGatewayMock)
+	genreGateway := new(mocks.GenreGatewayMock)
+	castGateway := new(mocks.CastMemberGatewayMock)
+	mediaGateway := new(mocks.MediaResourceGatewayMock)
+	sut := video_usecase.NewDefaultCreateVideoUseCase(
+		videoGateway, categoryGateway, genreGateway, castGateway, mediaGateway,
+	)
+	savedVideo := &video.Video{ID: 999}
+	expectedErr := errors.New("checksum mismatch")
+
+	command := dummyCreateVideoCommand()
+	command.CategoryIds = nil
+	command.GenreIds = nil
+	command.MemberIds = nil
+	command.Trailer = &video.Resource{Name: "trailer.mp4", Content: test.DummyMP4("video")}
+
+	videoGateway.On("Create", mock.Anything).Return(savedVideo, nil)
+	mediaGateway.On("StoreAudioVideo", savedVideo.ID, mock.Anything).Return(&video.AudioVideoMedia{}, expectedErr)
+	mediaGateway.On("ClearResources", savedVideo.ID).Return(errors.New("permission denied"))
+	videoGateway.On("DeleteById", savedVideo.ID).Return(nil)
+
+	noti, output := sut.Execute(command)
+
+	assert.Nil(t, output)
+	assert.Len(t, noti.GetErrors(), 1)
+	assert.ErrorIs(t, noti.GetErrors()[0], expectedErr)
+	assert.ErrorContains(t, noti.GetErrors()[0], "could not roll back video 999: permission denied")
+	videoGateway.AssertExpectations(t)
+	mediaGateway.AssertExpectations(t)
+	videoGateway.AssertNotCalled(t, "Update", mock.Anything)
+}
@@ -0,0 +1,32 @@
+package video_usecase
+
+import (
+	"fmt"
+
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/video"
+)
+
+type DeleteVideoCommand struct {
+	VideoId int64
+}
+
+type DeleteVideoUseCase interface {
+	Execute(c DeleteVideoCommand) error
+}
+
+type DefaultDeleteVideoUseCase struct {
+	Gateway      video.VideoGateway
+	MediaGateway video.MediaResourceGateway
+}
+
+func (useCase DefaultDeleteVideoUseCase) Execute(command DeleteVideoCommand) error {
+	if err := useCase.Gateway.DeleteById(command.VideoId); err != nil {
+		return err
+	}
+
+	if err := useCase.MediaGateway.ClearResources(command.VideoId); err != nil {
+		return fmt.Errorf("video %d was deleted but its resources could not be cleared: %w", command.VideoId, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,67 @@
+package video_usecase_test
+
+import (
+	"errors"
+	"testing"
+
+	video_usecase "github.com.br/gibranct/admin_do_catalogo/internal/usecases/video"
+	"github.com.br/gibranct/admin_do_catalogo/pkg/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestDeleteVideoById(t *testing.T) {
+	videoGateway := new(mocks.VideoGatewayMock)
+	mediaGateway := new(mocks.MediaResourceGatewayMock)
+	sut := video_usecase.DefaultDeleteVideoUseCase{
+		Gateway:      videoGateway,
+		MediaGateway: mediaGateway,
+	}
+	videoId := int64(45)
+
+	videoGateway.On("DeleteById", videoId).Return(nil)
+	mediaGateway.On("ClearResources", videoId).Return(nil)
+
+	err := sut.Execute(video_usecase.DeleteVideoCommand{VideoId: videoId})
+
+	assert.Nil(t, err)
+	videoGateway.AssertExpectations(t)
+	mediaGateway.AssertExpectations(t)
+}
+
+func TestDeleteVideoByIdWhenGatewayFails(t *testing.T) {
+	videoGateway := new(mocks.VideoGatewayMock)
+	mediaGateway := new(mocks.MediaResourceGatewayMock)
+	sut := video_usecase.DefaultDeleteVideoUseCase{
+		Gateway:      videoGateway,
+		MediaGateway: mediaGateway,
+	}
+	videoId := int64(45)
+	expectedErr := errors.New("failed to delete video")
+
+	videoGateway.On("DeleteById", videoId).Return(expectedErr)
+
+	err := sut.Execute(video_usecase.DeleteVideoCommand{VideoId: videoId})
+
+	assert.Equal(t, expectedErr, err)
+	mediaGateway.AssertNotCalled(t, "ClearResources", mock.Anything)
+}
+
+func TestDeleteVideoByIdWhenClearResourcesFails(t *testing.T) {
+	videoGateway := new(mocks.VideoGatewayMock)
+	mediaGateway := new(mocks.MediaResourceGatewayMock)
+	sut := video_usecase.DefaultDeleteVideoUseCase{
+		Gateway:      videoGateway,
+		MediaGateway: mediaGateway,
+	}
+	videoId := int64(45)
+	expectedErr := errors.New("permission denied")
+
+	videoGateway.On("DeleteById", videoId).Return(nil)
+	mediaGateway.On("ClearResources", videoId).Return(expectedErr)
+
+	err := sut.Execute(video_usecase.DeleteVideoCommand{VideoId: videoId})
+
+	assert.ErrorIs(t, err, expectedErr)
+	assert.Equal(t, "video 45 was deleted but its resources could not be cleared: permission denied", err.Error())
+}
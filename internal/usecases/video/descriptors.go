@@ -0,0 +1,32 @@
+package video_usecase
+
+import (
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/video"
+	"github.com.br/gibranct/admin_do_catalogo/pkg/notification"
+)
+
+func toContentDescriptors(codes []string, n *notification.Notification) []video.ContentDescriptor {
+	descriptors := []video.ContentDescriptor{}
+
+	for _, code := range codes {
+		descriptor, err := video.StringToContentDescriptor(code)
+		if err != nil {
+			n.Add(err)
+			continue
+		}
+
+		descriptors = append(descriptors, descriptor)
+	}
+
+	return descriptors
+}
+
+func toDescriptorOutputs(descriptors []video.ContentDescriptor) []string {
+	outputs := []string{}
+
+	for _, descriptor := range descriptors {
+		outputs = append(outputs, descriptor.String())
+	}
+
+	return outputs
+}
@@ -0,0 +1,88 @@
+package video_usecase
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/encoding"
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/video"
+	encoding_usecase "github.com.br/gibranct/admin_do_catalogo/internal/usecases/encoding"
+)
+
+// hands the VIDEO media over to the encoder once it is PENDING, a nil
+// publisher leaves it waiting for the encoder to pick it up on its own
+type EncodingJobs struct {
+	Profiles  encoding.ProfileGateway
+	Publisher encoding.JobPublisher
+}
+
+// a job that cannot be published fails the media, so it can be retried
+func (jobs EncodingJobs) request(gateway video.VideoGateway, aVideo *video.Video) error {
+	if jobs.Publisher == nil || aVideo.Video == nil || !aVideo.Video.IsPendingEncode() {
+		return nil
+	}
+
+	err := jobs.publish(aVideo)
+	if err == nil {
+		return nil
+	}
+
+	if failErr := aVideo.Failed(video.VIDEO, err.Error()); failErr != nil {
+		return failErr
+	}
+
+	if updateErr := gateway.UpdateMediaStatus(*aVideo.Video); updateErr != nil {
+		return updateErr
+	}
+
+	return err
+}
+
+func (jobs EncodingJobs) publish(aVideo *video.Video) error {
+	var profile *encoding.Profile
+	var err error
+
+	if jobs.Profiles != nil {
+		if profile, _, err = encoding_usecase.ResolveProfile(jobs.Profiles, aVideo.ID); err != nil {
+			return fmt.Errorf("could not resolve the encoding profile: %w", err)
+		}
+
+		// the result is checked against the profile the job was sent with
+		if err = jobs.Profiles.SaveMediaProfile(aVideo.Video.ID, profile); err != nil {
+			return fmt.Errorf("could not save the encoding profile of the media: %w", err)
+		}
+	}
+
+	if err = jobs.Publisher.Publish(*encoding.NewJob(aVideo.ID, video.VIDEO, *aVideo.Video, profile)); err != nil {
+		return fmt.Errorf("could not publish the encoding job: %w", err)
+	}
+
+	return nil
+}
+
+// renditions the job profile asked for but the encoder did not report
+func missingRenditions(profiles encoding.ProfileGateway, mediaId int64, renditions []video.Rendition) (string, error) {
+	if profiles == nil {
+		return "", nil
+	}
+
+	profile, err := profiles.FindMediaProfile(mediaId)
+	if err != nil || profile == nil {
+		return "", err
+	}
+
+	missing := profile.MissingRenditions(renditions)
+	if len(missing) == 0 {
+		return "", nil
+	}
+
+	return fmt.Sprintf("encoder did not produce the renditions expected by profile '%s': %s", profile.Name, joinRenditions(missing)), nil
+}
+
+func joinRenditions(renditions []encoding.ProfileRendition) string {
+	names := []string{}
+	for _, r := range renditions {
+		names = append(names, r.String())
+	}
+	return strings.Join(names, ", ")
+}
@@ -0,0 +1,209 @@
+package video_usecase_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/encoding"
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/video"
+	video_usecase "github.com.br/gibranct/admin_do_catalogo/internal/usecases/video"
+	"github.com.br/gibranct/admin_do_catalogo/pkg/mocks"
+	"github.com.br/gibranct/admin_do_catalogo/pkg/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func defaultProfile() *encoding.Profile {
+	aProfile := encoding.NewProfile("default", []encoding.ProfileRendition{
+		*encoding.NewProfileRendition(1920, 1080, 5_000_000, "h264", "fmp4"),
+		*encoding.NewProfileRendition(640, 360, 800_000, "h264", "fmp4"),
+	})
+	aProfile.ID = 3
+	return aProfile
+}
+
+func categoryProfile(profiles *mocks.ProfileGatewayMock, videoId int64) *encoding.Profile {
+	aProfile := defaultProfile()
+
+	profiles.On("FindAssignments", videoId).Return(&encoding.Assignments{
+		CategoryDefaults: []encoding.CategoryDefault{{CategoryId: 1, ProfileId: 3}},
+	}, nil)
+	profiles.On("FindById", int64(3)).Return(aProfile, nil)
+
+	return aProfile
+}
+
+func TestUploadVideoMediaPublishesTheEncodingJob(t *testing.T) {
+	videoGateway := new(mocks.VideoGatewayMock)
+	mediaGateway := new(mocks.MediaResourceGatewayMock)
+	profiles := new(mocks.ProfileGatewayMock)
+	publisher := new(mocks.JobPublisherMock)
+	sut := video_usecase.DefaultUploadMediaUseCase{
+		Gateway:      videoGateway,
+		MediaGateway: mediaGateway,
+		Encoding:     video_usecase.EncodingJobs{Profiles: profiles, Publisher: publisher},
+	}
+	aVideo := video.NewVideo("title", "desc", 2024, 120.0, true, video.L, nil, nil, nil)
+	aVideo.ID = 999
+	status := video.PENDING
+	media := video.NewAudioVideoMediaWith(0, &status, "checksum", "movie.mp4", "/videos/999/video/movie.mp4", "")
+	resource := video.Resource{Name: "movie.mp4", Content: test.DummyMP4("video")}
+	aProfile := categoryProfile(profiles, aVideo.ID)
+
+	videoGateway.On("FindById", aVideo.ID).Return(aVideo, nil)
+	mediaGateway.On("StoreAudioVideo", aVideo.ID, mock.Anything).Return(media, nil)
+	videoGateway.On("Update", mock.Anything).Run(func(args mock.Arguments) {
+		aVideo.Video.ID = 15
+	}).Return(aVideo, nil)
+	mediaGateway.On("Commit", "/videos/999/video/movie.mp4", "checksum").Return(nil)
+	profiles.On("SaveMediaProfile", int64(15), aProfile).Return(nil)
+	publisher.On("Publish", mock.MatchedBy(func(job encoding.Job) bool {
+		return job.VideoId == 999 && job.Type == video.VIDEO && job.Media.ID == 15 && job.Profile == aProfile
+	})).Return(nil)
+
+	output, err := sut.Execute(video_usecase.UploadMediaCommand{VideoId: aVideo.ID, Type: video.VIDEO, Resource: resource})
+
+	assert.Nil(t, err)
+	assert.Empty(t, output.Warnings)
+	profiles.AssertExpectations(t)
+	publisher.AssertExpectations(t)
+}
+
+func TestUploadVideoMediaFailsTheMediaWhenTheJobIsNotPublished(t *testing.T) {
+	videoGateway := new(mocks.VideoGatewayMock)
+	mediaGateway := new(mocks.MediaResourceGatewayMock)
+	publisher := new(mocks.JobPublisherMock)
+	sut := video_usecase.DefaultUploadMediaUseCase{
+		Gateway:      videoGateway,
+		MediaGateway: mediaGateway,
+		Encoding:     video_usecase.EncodingJobs{Publisher: publisher},
+	}
+	aVideo := video.NewVideo("title", "desc", 2024, 120.0, true, video.L, nil, nil, nil)
+	aVideo.ID = 999
+	status := video.PENDING
+	media := video.NewAudioVideoMediaWith(15, &status, "checksum", "movie.mp4", "/videos/999/video/movie.mp4", "")
+	resource := video.Resource{Name: "movie.mp4", Content: test.DummyMP4("video")}
+
+	videoGateway.On("FindById", aVideo.ID).Return(aVideo, nil)
+	mediaGateway.On("StoreAudioVideo", aVideo.ID, mock.Anything).Return(media, nil)
+	videoGateway.On("Update", mock.Anything).Return(aVideo, nil)
+	mediaGateway.On("Commit", "/videos/999/video/movie.mp4", "checksum").Return(nil)
+	publisher.On("Publish", mock.MatchedBy(func(job encoding.Job) bool {
+		return job.Profile == nil
+	})).Return(errors.New("broker is down"))
+	videoGateway.On("UpdateMediaStatus", mock.MatchedBy(func(m video.AudioVideoMedia) bool {
+		return m.ID == 15 && *m.Status == video.FAILED && m.FailureReason == "could not publish the encoding job: broker is down"
+	})).Return(nil)
+
+	output, err := sut.Execute(video_usecase.UploadMediaCommand{VideoId: aVideo.ID, Type: video.VIDEO, Resource: resource})
+
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"could not publish the encoding job: broker is down"}, output.Warnings)
+	videoGateway.AssertExpectations(t)
+}
+
+func TestRetryVideoMediaPublishesTheEncodingJob(t *testing.T) {
+	videoGateway := new(mocks.VideoGatewayMock)
+	publisher := new(mocks.JobPublisherMock)
+	sut := video_usecase.DefaultRetryMediaUseCase{
+		Gateway:  videoGateway,
+		Encoding: video_usecase.EncodingJobs{Publisher: publisher},
+	}
+	aVideo := videoWithMedias()
+	aVideo.Failed(video.VIDEO, "corrupted stream")
+
+	videoGateway.On("FindById", aVideo.ID).Return(aVideo, nil)
+	videoGateway.On("UpdateMediaStatus", mock.Anything).Return(nil)
+	publisher.On("Publish", mock.MatchedBy(func(job encoding.Job) bool {
+		return job.Media.ID == 15 && *job.Media.Status == video.PENDING
+	})).Return(nil)
+
+	output, err := sut.Execute(video_usecase.RetryMediaCommand{VideoId: aVideo.ID, Type: video.VIDEO})
+
+	assert.Nil(t, err)
+	assert.Equal(t, "PENDING", output.Status)
+	publisher.AssertExpectations(t)
+}
+
+func TestCompletedVideoMediaIsCheckedAgainstItsJobProfile(t *testing.T) {
+	videoGateway := new(mocks.VideoGatewayMock)
+	profiles := new(mocks.ProfileGatewayMock)
+	sut := video_usecase.DefaultUpdateMediaStatusUseCase{Gateway: videoGateway, Profiles: profiles}
+	aVideo := videoWithMedias()
+
+	// the profile may have changed since, only the copy sent with the job counts
+	profiles.On("FindMediaProfile", int64(15)).Return(defaultProfile(), nil)
+
+	videoGateway.On("FindById", aVideo.ID).Return(aVideo, nil)
+	videoGateway.On("UpdateMediaStatus", mock.MatchedBy(func(m video.AudioVideoMedia) bool {
+		return m.ID == 15 && *m.Status == video.FAILED &&
+			m.FailureReason == "encoder did not produce the renditions expected by profile 'default': 640x360 h264"
+	})).Return(nil)
+
+	err := sut.Execute(video_usecase.UpdateMediaStatusCommand{
+		VideoId:     aVideo.ID,
+		ResourceId:  "15",
+		Status:      "COMPLETED",
+		EncodedPath: "/encoded/movie",
+		Renditions: []video_usecase.RenditionCommand{
+			{Bandwidth: 5_000_000, Width: 1920, Height: 1080, Codecs: []string{"avc1.640028"}, Location: "/encoded/movie/1080p", SegmentDuration: 6},
+		},
+	})
+
+	assert.Nil(t, err)
+	videoGateway.AssertExpectations(t)
+}
+
+func TestCompletedVideoMediaWithTheExpectedRenditions(t *testing.T) {
+	videoGateway := new(mocks.VideoGatewayMock)
+	profiles := new(mocks.ProfileGatewayMock)
+	sut := video_usecase.DefaultUpdateMediaStatusUseCase{Gateway: videoGateway, Profiles: profiles}
+	aVideo := videoWithMedias()
+
+	profiles.On("FindMediaProfile", int64(15)).Return(defaultProfile(), nil)
+
+	videoGateway.On("FindById", aVideo.ID).Return(aVideo, nil)
+	videoGateway.On("UpdateMediaStatus", mock.MatchedBy(func(m video.AudioVideoMedia) bool {
+		return m.ID == 15 && *m.Status == video.COMPLETED && len(m.Renditions) == 2
+	})).Return(nil)
+
+	err := sut.Execute(video_usecase.UpdateMediaStatusCommand{
+		VideoId:     aVideo.ID,
+		ResourceId:  "15",
+		Status:      "COMPLETED",
+		EncodedPath: "/encoded/movie",
+		Renditions: []video_usecase.RenditionCommand{
+			{Bandwidth: 5_000_000, Width: 1920, Height: 1080, Codecs: []string{"avc1.640028"}, Location: "/encoded/movie/1080p", SegmentDuration: 6},
+			{Bandwidth: 800_000, Width: 640, Height: 360, Codecs: []string{"avc1.42c01e"}, Location: "/encoded/movie/360p", SegmentDuration: 6},
+		},
+	})
+
+	assert.Nil(t, err)
+	videoGateway.AssertExpectations(t)
+}
+
+func TestCompletedVideoMediaSentWithoutProfile(t *testing.T) {
+	videoGateway := new(mocks.VideoGatewayMock)
+	profiles := new(mocks.ProfileGatewayMock)
+	sut := video_usecase.DefaultUpdateMediaStatusUseCase{Gateway: videoGateway, Profiles: profiles}
+	aVideo := videoWithMedias()
+
+	profiles.On("FindMediaProfile", int64(15)).Return((*encoding.Profile)(nil), nil)
+	videoGateway.On("FindById", aVideo.ID).Return(aVideo, nil)
+	videoGateway.On("UpdateMediaStatus", mock.MatchedBy(func(m video.AudioVideoMedia) bool {
+		return m.ID == 15 && *m.Status == video.COMPLETED && len(m.Renditions) == 1
+	})).Return(nil)
+
+	err := sut.Execute(video_usecase.UpdateMediaStatusCommand{
+		VideoId:     aVideo.ID,
+		ResourceId:  "15",
+		Status:      "COMPLETED",
+		EncodedPath: "/encoded/movie",
+		Renditions: []video_usecase.RenditionCommand{
+			{Bandwidth: 800_000, Width: 640, Height: 360, Codecs: []string{"avc1.42c01e"}, Location: "/encoded/movie/360p", SegmentDuration: 6},
+		},
+	})
+
+	assert.Nil(t, err)
+	videoGateway.AssertExpectations(t)
+}
@@ -0,0 +1,375 @@
+package video_usecase
+
+import (
+	"fmt"
+
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/video"
+	"github.com.br/gibranct/admin_do_catalogo/pkg/notification"
+)
+
+type CreateExtraCommand struct {
+	VideoId int64
+	Type    string
+	Title   string
+}
+
+type UpdateExtraCommand struct {
+	VideoId int64
+	ExtraId int64
+	Type    string
+	Title   string
+}
+
+type ExtraCommand struct {
+	VideoId int64
+	ExtraId int64
+}
+
+type ReorderExtrasCommand struct {
+	VideoId  int64
+	ExtraIds []int64
+}
+
+type UploadExtraMediaCommand struct {
+	VideoId  int64
+	ExtraId  int64
+	Resource video.Resource
+}
+
+type ExtraOutput struct {
+	ID       int64                  `json:"id"`
+	Type     string                 `json:"type"`
+	Title    string                 `json:"title"`
+	Position int                    `json:"position"`
+	Primary  bool                   `json:"primary"`
+	Media    *AudioVideoMediaOutput `json:"media"`
+	Warnings []string               `json:"warnings,omitempty"`
+}
+
+type CreateExtraUseCase interface {
+	Execute(c CreateExtraCommand) (*notification.Notification, *ExtraOutput)
+}
+
+type UpdateExtraUseCase interface {
+	Execute(c UpdateExtraCommand) (*notification.Notification, *ExtraOutput)
+}
+
+type ListExtrasUseCase interface {
+	Execute(videoId int64) ([]ExtraOutput, error)
+}
+
+type DeleteExtraUseCase interface {
+	Execute(c ExtraCommand) error
+}
+
+type ReorderExtrasUseCase interface {
+	Execute(c ReorderExtrasCommand) (*notification.Notification, []ExtraOutput)
+}
+
+type PromoteExtraUseCase interface {
+	Execute(c ExtraCommand) *notification.Notification
+}
+
+type UploadExtraMediaUseCase interface {
+	Execute(c UploadExtraMediaCommand) (*ExtraOutput, error)
+}
+
+type GetExtraMediaUseCase interface {
+	Execute(c ExtraCommand) (*video.Resource, error)
+}
+
+type DefaultCreateExtraUseCase struct {
+	Gateway video.VideoGateway
+}
+
+type DefaultUpdateExtraUseCase struct {
+	Gateway video.VideoGateway
+}
+
+type DefaultListExtrasUseCase struct {
+	Gateway video.VideoGateway
+}
+
+type DefaultDeleteExtraUseCase struct {
+	Gateway      video.VideoGateway
+	MediaGateway video.MediaResourceGateway
+}
+
+type DefaultReorderExtrasUseCase struct {
+	Gateway video.VideoGateway
+}
+
+type DefaultPromoteExtraUseCase struct {
+	Gateway      video.VideoGateway
+	MediaGateway video.MediaResourceGateway
+}
+
+type DefaultUploadExtraMediaUseCase struct {
+	Gateway      video.VideoGateway
+	MediaGateway video.MediaResourceGateway
+}
+
+type DefaultGetExtraMediaUseCase struct {
+	Gateway      video.VideoGateway
+	MediaGateway video.MediaResourceGateway
+}
+
+func (useCase DefaultCreateExtraUseCase) Execute(command CreateExtraCommand) (*notification.Notification, *ExtraOutput) {
+	n := notification.CreateNotification()
+
+	aVideo, err := useCase.Gateway.FindById(command.VideoId)
+	if err != nil {
+		n.Add(err)
+		return n, nil
+	}
+
+	extra := toExtra(0, command.Type, command.Title, n)
+	aVideo.ValidateExtra(extra, n)
+
+	if n.HasErrors() {
+		return n, nil
+	}
+
+	aVideo.AddExtra(extra)
+
+	saved, err := useCase.Gateway.SaveExtra(aVideo.ID, aVideo.Extras[len(aVideo.Extras)-1])
+	if err != nil {
+		n.Add(err)
+		return n, nil
+	}
+
+	output := toExtraOutput(aVideo, *saved)
+	return nil, &output
+}
+
+func (useCase DefaultUpdateExtraUseCase) Execute(command UpdateExtraCommand) (*notification.Notification, *ExtraOutput) {
+	n := notification.CreateNotification()
+
+	aVideo, current, err := findExtra(useCase.Gateway, command.VideoId, command.ExtraId)
+	if err != nil {
+		n.Add(err)
+		return n, nil
+	}
+
+	extra := toExtra(current.ID, command.Type, command.Title, n)
+	extra.Media = current.Media
+	aVideo.ValidateExtra(extra, n)
+
+	if n.HasErrors() {
+		return n, nil
+	}
+
+	aVideo.UpdateExtra(extra)
+
+	saved, err := useCase.Gateway.SaveExtra(aVideo.ID, *current)
+	if err != nil {
+		n.Add(err)
+		return n, nil
+	}
+
+	output := toExtraOutput(aVideo, *saved)
+	return nil, &output
+}
+
+func (useCase DefaultListExtrasUseCase) Execute(videoId int64) ([]ExtraOutput, error) {
+	aVideo, err := useCase.Gateway.FindById(videoId)
+	if err != nil {
+		return nil, err
+	}
+
+	return toExtraOutputs(aVideo), nil
+}
+
+func (useCase DefaultDeleteExtraUseCase) Execute(command ExtraCommand) error {
+	aVideo, err := useCase.Gateway.FindById(command.VideoId)
+	if err != nil {
+		return err
+	}
+
+	extra, found := aVideo.RemoveExtra(command.ExtraId)
+	if !found {
+		return video.ErrExtraNotFound
+	}
+
+	if err = useCase.Gateway.DeleteExtra(aVideo.ID, extra.ID); err != nil {
+		return err
+	}
+
+	if err = useCase.MediaGateway.RemoveExtra(aVideo.ID, extra.ID); err != nil {
+		return err
+	}
+
+	if extra.Media != nil {
+		return useCase.MediaGateway.Release(extra.Media.Checksum)
+	}
+
+	return nil
+}
+
+func (useCase DefaultReorderExtrasUseCase) Execute(command ReorderExtrasCommand) (*notification.Notification, []ExtraOutput) {
+	n := notification.CreateNotification()
+
+	aVideo, err := useCase.Gateway.FindById(command.VideoId)
+	if err != nil {
+		n.Add(err)
+		return n, nil
+	}
+
+	aVideo.ReorderExtras(command.ExtraIds, n)
+
+	if n.HasErrors() {
+		return n, nil
+	}
+
+	if err = useCase.Gateway.ReorderExtras(aVideo.ID, aVideo.Extras); err != nil {
+		n.Add(err)
+		return n, nil
+	}
+
+	return nil, toExtraOutputs(aVideo)
+}
+
+func (useCase DefaultPromoteExtraUseCase) Execute(command ExtraCommand) *notification.Notification {
+	n := notification.CreateNotification()
+
+	aVideo, err := useCase.Gateway.FindById(command.VideoId)
+	if err != nil {
+		n.Add(err)
+		return n
+	}
+
+	previous := aVideo.Trailer
+
+	if err = aVideo.PromoteExtra(command.ExtraId); err != nil {
+		n.Add(err)
+		return n
+	}
+
+	if err = useCase.Gateway.UpdatePrimaryTrailer(aVideo.ID, aVideo.Trailer.ID); err != nil {
+		n.Add(err)
+		return n
+	}
+
+	if previous != nil && previous.Checksum != aVideo.Trailer.Checksum {
+		if err = useCase.MediaGateway.Release(previous.Checksum); err != nil {
+			n.Add(fmt.Errorf("the extra was promoted but the previous trailer could not be released: %w", err))
+			return n
+		}
+	}
+
+	return nil
+}
+
+func (useCase DefaultUploadExtraMediaUseCase) Execute(command UploadExtraMediaCommand) (*ExtraOutput, error) {
+	aVideo, extra, err := findExtra(useCase.Gateway, command.VideoId, command.ExtraId)
+	if err != nil {
+		return nil, err
+	}
+
+	n := notification.CreateNotification()
+
+	if err = validateMedia(video.TRAILER, &command.Resource, useCase.MediaGateway, n); err != nil {
+		return nil, err
+	}
+
+	if n.HasErrors() {
+		return nil, InvalidMediaError{Notification: n}
+	}
+
+	media, err := useCase.MediaGateway.StoreExtra(aVideo.ID, extra.ID, command.Resource)
+	if err != nil {
+		return nil, err
+	}
+
+	staged := newStagedMedia(useCase.MediaGateway)
+	staged.add(media.RawLocation, media.Checksum)
+
+	// the primary trailer shares this media row, so it follows the new upload
+	var previousChecksum string
+	if extra.Media != nil {
+		media.ID = extra.Media.ID
+		previousChecksum = extra.Media.Checksum
+	}
+
+	extra.Media = media
+
+	saved, err := useCase.Gateway.SaveExtra(aVideo.ID, *extra)
+	if err != nil {
+		return nil, staged.discard(err)
+	}
+
+	if err = staged.commit(); err != nil {
+		return nil, err
+	}
+
+	output := toExtraOutput(aVideo, *saved)
+	if previousChecksum != "" && previousChecksum != saved.Media.Checksum {
+		output.Warnings = releaseMedia(useCase.MediaGateway, previousChecksum)
+	}
+
+	return &output, nil
+}
+
+func (useCase DefaultGetExtraMediaUseCase) Execute(command ExtraCommand) (*video.Resource, error) {
+	aVideo, extra, err := findExtra(useCase.Gateway, command.VideoId, command.ExtraId)
+	if err != nil {
+		return nil, err
+	}
+
+	if extra.Media == nil {
+		return nil, video.ErrResourceNotFound
+	}
+
+	resource, err := useCase.MediaGateway.GetExtra(aVideo.ID, extra.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resource.Checksum = extra.Media.Checksum
+
+	return resource, nil
+}
+
+func findExtra(gateway video.VideoGateway, videoId, extraId int64) (*video.Video, *video.Extra, error) {
+	aVideo, err := gateway.FindById(videoId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	extra, found := aVideo.FindExtra(extraId)
+	if !found {
+		return nil, nil, video.ErrExtraNotFound
+	}
+
+	return aVideo, extra, nil
+}
+
+func toExtra(id int64, aType, title string, n *notification.Notification) video.Extra {
+	extraType, err := video.StringToExtraType(aType)
+	if err != nil {
+		n.Add(err)
+	}
+
+	extra := video.NewExtra(extraType, title)
+	extra.ID = id
+	return *extra
+}
+
+func toExtraOutput(aVideo *video.Video, extra video.Extra) ExtraOutput {
+	return ExtraOutput{
+		ID:       extra.ID,
+		Type:     extra.Type.String(),
+		Title:    extra.Title,
+		Position: extra.Position,
+		Primary:  aVideo.IsPrimaryTrailer(extra),
+		Media:    toAudioVideoMediaOutput(extra.Media),
+	}
+}
+
+func toExtraOutputs(aVideo *video.Video) []ExtraOutput {
+	outputs := []ExtraOutput{}
+	for _, extra := range aVideo.Extras {
+		outputs = append(outputs, toExtraOutput(aVideo, extra))
+	}
+	return outputs
+}
@@ -0,0 +1,291 @@
+package video_usecase_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/video"
+	video_usecase "github.com.br/gibranct/admin_do_catalogo/internal/usecases/video"
+	"github.com.br/gibranct/admin_do_catalogo/pkg/mocks"
+	"github.com.br/gibranct/admin_do_catalogo/pkg/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func videoWithExtras() *video.Video {
+	aVideo := videoWithMedias()
+	status := video.COMPLETED
+	aVideo.AddExtra(video.Extra{ID: 1, Type: video.EXTRA_TRAILER, Title: "Official trailer",
+		Media: video.NewAudioVideoMediaWith(40, &status, "extra-sum", "trailer.mp4", "/extras/1/trailer.mp4", "/encoded/1")})
+	aVideo.AddExtra(video.Extra{ID: 2, Type: video.EXTRA_CLIP, Title: "Opening scene"})
+	return aVideo
+}
+
+func TestCreateExtraGoesToTheEnd(t *testing.T) {
+	videoGateway := new(mocks.VideoGatewayMock)
+	sut := video_usecase.DefaultCreateExtraUseCase{Gateway: videoGateway}
+	aVideo := videoWithExtras()
+
+	videoGateway.On("FindById", aVideo.ID).Return(aVideo, nil)
+	videoGateway.On("SaveExtra", aVideo.ID, mock.MatchedBy(func(e video.Extra) bool {
+		return e.ID == 0 && e.Type == video.EXTRA_BEHIND_THE_SCENES && e.Position == 3
+	})).Return(&video.Extra{ID: 3, Type: video.EXTRA_BEHIND_THE_SCENES, Title: "Making of", Position: 3}, nil)
+
+	noti, output := sut.Execute(video_usecase.CreateExtraCommand{
+		VideoId: aVideo.ID,
+		Type:    "BEHIND_THE_SCENES",
+		Title:   "Making of",
+	})
+
+	assert.Nil(t, noti)
+	assert.Equal(t, int64(3), output.ID)
+	assert.Equal(t, 3, output.Position)
+	assert.False(t, output.Primary)
+	videoGateway.AssertExpectations(t)
+}
+
+func TestCreateInvalidExtra(t *testing.T) {
+	videoGateway := new(mocks.VideoGatewayMock)
+	sut := video_usecase.DefaultCreateExtraUseCase{Gateway: videoGateway}
+	aVideo := videoWithExtras()
+
+	videoGateway.On("FindById", aVideo.ID).Return(aVideo, nil)
+
+	noti, output := sut.Execute(video_usecase.CreateExtraCommand{VideoId: aVideo.ID, Type: "BLOOPER"})
+
+	assert.Nil(t, output)
+	assert.Len(t, noti.GetErrors(), 2)
+	assert.EqualError(t, noti.GetErrors()[0], "unknown extra type 'BLOOPER'")
+	assert.EqualError(t, noti.GetErrors()[1], "'title' should not be null or empty")
+	videoGateway.AssertNumberOfCalls(t, "SaveExtra", 0)
+}
+
+func TestUpdatePrimaryTrailerExtraMustRemainATrailer(t *testing.T) {
+	videoGateway := new(mocks.VideoGatewayMock)
+	sut := video_usecase.DefaultUpdateExtraUseCase{Gateway: videoGateway}
+	aVideo := videoWithExtras()
+	aVideo.PromoteExtra(1)
+
+	videoGateway.On("FindById", aVideo.ID).Return(aVideo, nil)
+
+	noti, output := sut.Execute(video_usecase.UpdateExtraCommand{
+		VideoId: aVideo.ID,
+		ExtraId: 1,
+		Type:    "TEASER",
+		Title:   "Teaser",
+	})
+
+	assert.Nil(t, output)
+	assert.Len(t, noti.GetErrors(), 1)
+	assert.EqualError(t, noti.GetErrors()[0], "extra 1 is the primary trailer and must remain a TRAILER")
+}
+
+func TestUpdateExtraKeepsMediaAndPosition(t *testing.T) {
+	videoGateway := new(mocks.VideoGatewayMock)
+	sut := video_usecase.DefaultUpdateExtraUseCase{Gateway: videoGateway}
+	aVideo := videoWithExtras()
+
+	videoGateway.On("FindById", aVideo.ID).Return(aVideo, nil)
+	videoGateway.On("SaveExtra", aVideo.ID, mock.MatchedBy(func(e video.Extra) bool {
+		return e.ID == 1 && e.Title == "Final trailer" && e.Position == 1 && e.Media.ID == 40
+	})).Return(&aVideo.Extras[0], nil)
+
+	noti, output := sut.Execute(video_usecase.UpdateExtraCommand{
+		VideoId: aVideo.ID,
+		ExtraId: 1,
+		Type:    "TRAILER",
+		Title:   "Final trailer",
+	})
+
+	assert.Nil(t, noti)
+	assert.Equal(t, "Final trailer", output.Title)
+	videoGateway.AssertExpectations(t)
+}
+
+func TestDeleteExtra(t *testing.T) {
+	videoGateway := new(mocks.VideoGatewayMock)
+	mediaGateway := new(mocks.MediaResourceGatewayMock)
+	sut := video_usecase.DefaultDeleteExtraUseCase{Gateway: videoGateway, MediaGateway: mediaGateway}
+	aVideo := videoWithExtras()
+
+	videoGateway.On("FindById", aVideo.ID).Return(aVideo, nil)
+	videoGateway.On("DeleteExtra", aVideo.ID, int64(1)).Return(nil)
+	mediaGateway.On("RemoveExtra", aVideo.ID, int64(1)).Return(nil)
+	mediaGateway.On("Release", "extra-sum").Return(nil)
+
+	err := sut.Execute(video_usecase.ExtraCommand{VideoId: aVideo.ID, ExtraId: 1})
+
+	assert.Nil(t, err)
+	videoGateway.AssertExpectations(t)
+	mediaGateway.AssertExpectations(t)
+}
+
+func TestDeleteExtraWhenItDoesNotExist(t *testing.T) {
+	videoGateway := new(mocks.VideoGatewayMock)
+	mediaGateway := new(mocks.MediaResourceGatewayMock)
+	sut := video_usecase.DefaultDeleteExtraUseCase{Gateway: videoGateway, MediaGateway: mediaGateway}
+	aVideo := videoWithExtras()
+
+	videoGateway.On("FindById", aVideo.ID).Return(aVideo, nil)
+
+	err := sut.Execute(video_usecase.ExtraCommand{VideoId: aVideo.ID, ExtraId: 9})
+
+	assert.ErrorIs(t, err, video.ErrExtraNotFound)
+	videoGateway.AssertNumberOfCalls(t, "DeleteExtra", 0)
+}
+
+func TestReorderExtras(t *testing.T) {
+	videoGateway := new(mocks.VideoGatewayMock)
+	sut := video_usecase.DefaultReorderExtrasUseCase{Gateway: videoGateway}
+	aVideo := videoWithExtras()
+
+	videoGateway.On("FindById", aVideo.ID).Return(aVideo, nil)
+	videoGateway.On("ReorderExtras", aVideo.ID, mock.MatchedBy(func(extras []video.Extra) bool {
+		return extras[0].ID == 2 && extras[0].Position == 1 && extras[1].ID == 1 && extras[1].Position == 2
+	})).Return(nil)
+
+	noti, outputs := sut.Execute(video_usecase.ReorderExtrasCommand{VideoId: aVideo.ID, ExtraIds: []int64{2, 1}})
+
+	assert.Nil(t, noti)
+	assert.Equal(t, int64(2), outputs[0].ID)
+	assert.Equal(t, 2, outputs[1].Position)
+	videoGateway.AssertExpectations(t)
+}
+
+func TestPromoteExtraReleasesTheStandaloneTrailer(t *testing.T) {
+	videoGateway := new(mocks.VideoGatewayMock)
+	mediaGateway := new(mocks.MediaResourceGatewayMock)
+	sut := video_usecase.DefaultPromoteExtraUseCase{Gateway: videoGateway, MediaGateway: mediaGateway}
+	aVideo := videoWithExtras()
+	aVideo.Trailer.Checksum = "trailer-sum"
+
+	videoGateway.On("FindById", aVideo.ID).Return(aVideo, nil)
+	videoGateway.On("UpdatePrimaryTrailer", aVideo.ID, int64(40)).Return(nil)
+	mediaGateway.On("Release", "trailer-sum").Return(nil)
+
+	noti := sut.Execute(video_usecase.ExtraCommand{VideoId: aVideo.ID, ExtraId: 1})
+
+	assert.Nil(t, noti)
+	videoGateway.AssertExpectations(t)
+	mediaGateway.AssertExpectations(t)
+}
+
+func TestPromoteExtraThatIsNotATrailer(t *testing.T) {
+	videoGateway := new(mocks.VideoGatewayMock)
+	mediaGateway := new(mocks.MediaResourceGatewayMock)
+	sut := video_usecase.DefaultPromoteExtraUseCase{Gateway: videoGateway, MediaGateway: mediaGateway}
+	aVideo := videoWithExtras()
+
+	videoGateway.On("FindById", aVideo.ID).Return(aVideo, nil)
+
+	noti := sut.Execute(video_usecase.ExtraCommand{VideoId: aVideo.ID, ExtraId: 2})
+
+	assert.Len(t, noti.GetErrors(), 1)
+	assert.EqualError(t, noti.GetErrors()[0], "only TRAILER extras can be the primary trailer but extra 2 is a CLIP")
+	videoGateway.AssertNumberOfCalls(t, "UpdatePrimaryTrailer", 0)
+}
+
+func TestUploadExtraMediaReusesTheMediaRow(t *testing.T) {
+	videoGateway := new(mocks.VideoGatewayMock)
+	mediaGateway := new(mocks.MediaResourceGatewayMock)
+	sut := video_usecase.DefaultUploadExtraMediaUseCase{Gateway: videoGateway, MediaGateway: mediaGateway}
+	aVideo := videoWithExtras()
+	pending := video.PENDING
+	stored := video.NewAudioVideoMediaWith(0, &pending, "new-sum", "trailer.mp4", "/extras/1/trailer.mp4", "")
+
+	videoGateway.On("FindById", aVideo.ID).Return(aVideo, nil)
+	mediaGateway.On("StoreExtra", aVideo.ID, int64(1), mock.Anything).Return(stored, nil)
+	videoGateway.On("SaveExtra", aVideo.ID, mock.MatchedBy(func(e video.Extra) bool {
+		return e.ID == 1 && e.Media.ID == 40 && e.Media.Checksum == "new-sum"
+	})).Return(&video.Extra{ID: 1, Type: video.EXTRA_TRAILER, Title: "Official trailer", Position: 1, Media: stored}, nil)
+	mediaGateway.On("Commit", "/extras/1/trailer.mp4", "new-sum").Return(nil)
+	mediaGateway.On("Release", "extra-sum").Return(nil)
+
+	output, err := sut.Execute(video_usecase.UploadExtraMediaCommand{
+		VideoId:  aVideo.ID,
+		ExtraId:  1,
+		Resource: video.Resource{Stream: bytes.NewReader(test.DummyMP4("trailer")), Name: "trailer.mp4"},
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, "PENDING", output.Media.Status)
+	videoGateway.AssertExpectations(t)
+	mediaGateway.AssertExpectations(t)
+}
+
+func TestUploadInvalidExtraMedia(t *testing.T) {
+	videoGateway := new(mocks.VideoGatewayMock)
+	mediaGateway := new(mocks.MediaResourceGatewayMock)
+	sut := video_usecase.DefaultUploadExtraMediaUseCase{Gateway: videoGateway, MediaGateway: mediaGateway}
+	aVideo := videoWithExtras()
+
+	videoGateway.On("FindById", aVideo.ID).Return(aVideo, nil)
+
+	output, err := sut.Execute(video_usecase.UploadExtraMediaCommand{
+		VideoId:  aVideo.ID,
+		ExtraId:  2,
+		Resource: video.Resource{Content: test.DummyPNG(10, 10), Name: "clip.png"},
+	})
+
+	var invalidErr video_usecase.InvalidMediaError
+	assert.Nil(t, output)
+	assert.ErrorAs(t, err, &invalidErr)
+	mediaGateway.AssertNumberOfCalls(t, "StoreExtra", 0)
+}
+
+func TestGetExtraMediaWithoutUpload(t *testing.T) {
+	videoGateway := new(mocks.VideoGatewayMock)
+	mediaGateway := new(mocks.MediaResourceGatewayMock)
+	sut := video_usecase.DefaultGetExtraMediaUseCase{Gateway: videoGateway, MediaGateway: mediaGateway}
+	aVideo := videoWithExtras()
+
+	videoGateway.On("FindById", aVideo.ID).Return(aVideo, nil)
+
+	output, err := sut.Execute(video_usecase.ExtraCommand{VideoId: aVideo.ID, ExtraId: 2})
+
+	assert.Nil(t, output)
+	assert.ErrorIs(t, err, video.ErrResourceNotFound)
+}
+
+func TestGetPrimaryTrailerPromotedFromExtra(t *testing.T) {
+	videoGateway := new(mocks.VideoGatewayMock)
+	mediaGateway := new(mocks.MediaResourceGatewayMock)
+	sut := video_usecase.DefaultGetMediaUseCase{Gateway: videoGateway, MediaGateway: mediaGateway}
+	aVideo := videoWithExtras()
+	aVideo.PromoteExtra(1)
+	resource := &video.Resource{Stream: strings.NewReader("trailer"), ContentType: "video/mp4", Name: "trailer.mp4"}
+
+	videoGateway.On("FindById", aVideo.ID).Return(aVideo, nil)
+	mediaGateway.On("GetExtra", aVideo.ID, int64(1)).Return(resource, nil)
+
+	output, err := sut.Execute(video_usecase.GetMediaCommand{VideoId: aVideo.ID, Type: video.TRAILER})
+
+	assert.Nil(t, err)
+	assert.Equal(t, "extra-sum", output.Checksum)
+	mediaGateway.AssertNumberOfCalls(t, "GetResource", 0)
+}
+
+func TestUpdateExtraMediaStatus(t *testing.T) {
+	videoGateway := new(mocks.VideoGatewayMock)
+	sut := video_usecase.DefaultUpdateMediaStatusUseCase{Gateway: videoGateway}
+	aVideo := videoWithMedias()
+	status := video.PROCESSING
+	aVideo.AddExtra(video.Extra{ID: 1, Type: video.EXTRA_TEASER, Title: "Teaser",
+		Media: video.NewAudioVideoMediaWith(40, &status, "sum", "teaser.mp4", "/teaser.mp4", "")})
+
+	videoGateway.On("FindById", aVideo.ID).Return(aVideo, nil)
+	videoGateway.On("UpdateMediaStatus", mock.MatchedBy(func(m video.AudioVideoMedia) bool {
+		return m.ID == 40 && *m.Status == video.FAILED && m.FailureReason == "bad codec"
+	})).Return(nil)
+
+	err := sut.Execute(video_usecase.UpdateMediaStatusCommand{
+		VideoId:       aVideo.ID,
+		ResourceId:    "40",
+		Status:        "FAILED",
+		FailureReason: "bad codec",
+	})
+
+	assert.Nil(t, err)
+	videoGateway.AssertExpectations(t)
+}
@@ -0,0 +1,74 @@
+package video_usecase
+
+import (
+	"time"
+
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain"
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/video"
+)
+
+type ListVideosOutput struct {
+	ID                int64      `json:"id"`
+	Title             string     `json:"title"`
+	Description       string     `json:"description"`
+	LaunchedAt        int        `json:"yearLaunched"`
+	Duration          float64    `json:"duration"`
+	Opened            bool       `json:"opened"`
+	Published         bool       `json:"published"`
+	PublicationStatus string     `json:"publicationStatus"`
+	PublishAt         *time.Time `json:"publishAt"`
+	Rating            string     `json:"rating"`
+	CreatedAt         time.Time  `json:"createdAt"`
+	UpdatedAt         time.Time  `json:"updatedAt"`
+	Descriptors       []string   `json:"descriptors"`
+}
+
+type ListVideosUseCase interface {
+	Execute(query video.VideoSearchQuery) (*domain.Pagination[ListVideosOutput], error)
+}
+
+type DefaultListVideosUseCase struct {
+	Gateway video.VideoGateway
+}
+
+func (useCase DefaultListVideosUseCase) Execute(query video.VideoSearchQuery) (*domain.Pagination[ListVideosOutput], error) {
+	if err := query.Validate(); err != nil {
+		return nil, err
+	}
+
+	page, err := useCase.Gateway.FindAll(query)
+
+	if err != nil {
+		return nil, err
+	}
+
+	outputs := []*ListVideosOutput{}
+
+	for _, item := range page.Items {
+		output := &ListVideosOutput{
+			ID:                item.ID,
+			Title:             item.Title,
+			Description:       item.Description,
+			LaunchedAt:        item.LaunchedAt,
+			Duration:          item.Duration,
+			Opened:            item.Opened,
+			Published:         item.Published,
+			PublicationStatus: item.PublicationStatus.String(),
+			PublishAt:         item.PublishAt,
+			Rating:            item.Rating.String(),
+			CreatedAt:         item.CreatedAt,
+			UpdatedAt:         item.UpdatedAt,
+			Descriptors:       toDescriptorOutputs(item.Descriptors),
+		}
+
+		outputs = append(outputs, output)
+	}
+
+	return &domain.Pagination[ListVideosOutput]{
+		Items:       outputs,
+		CurrentPage: page.CurrentPage,
+		PerPage:     page.PerPage,
+		Total:       page.Total,
+		IsLast:      page.IsLast,
+	}, nil
+}
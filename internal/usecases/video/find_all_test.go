@@ -0,0 +1,96 @@
+package video_usecase_test
+
+import (
+	"testing"
+
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain"
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/video"
+	video_usecase "github.com.br/gibranct/admin_do_catalogo/internal/usecases/video"
+	"github.com.br/gibranct/admin_do_catalogo/pkg/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindAllVideos(t *testing.T) {
+	videoGateway := new(mocks.VideoGatewayMock)
+	sut := video_usecase.DefaultListVideosUseCase{
+		Gateway: videoGateway,
+	}
+	published := true
+	query := video.VideoSearchQuery{
+		SearchQuery: domain.SearchQuery{
+			Page:      1,
+			PerPage:   10,
+			Term:      "dummy",
+			Sort:      "title",
+			Direction: "ASC",
+		},
+		CategoryIds: []int64{78},
+		Published:   &published,
+	}
+	ids := []int64{78}
+	videos := []*video.Video{
+		video.NewVideo("Video 1", "Desc 1", 2020, 90.0, false, video.L, ids, ids, ids),
+		video.NewVideo("Video 2", "Desc 2", 2021, 95.0, false, video.AGE_16, ids, ids, ids),
+	}
+	pageVideos := &domain.Pagination[video.Video]{
+		CurrentPage: 1,
+		PerPage:     10,
+		Total:       2,
+		IsLast:      true,
+		Items:       videos,
+	}
+
+	videoGateway.On("FindAll", query).Return(pageVideos, nil)
+
+	page, err := sut.Execute(query)
+
+	assert.Nil(t, err)
+	assert.Len(t, page.Items, 2)
+	assert.Equal(t, pageVideos.CurrentPage, page.CurrentPage)
+	assert.Equal(t, pageVideos.PerPage, page.PerPage)
+	assert.Equal(t, pageVideos.Total, page.Total)
+	assert.Equal(t, pageVideos.IsLast, page.IsLast)
+	for idx, item := range page.Items {
+		assert.Equal(t, videos[idx].Title, item.Title)
+		assert.Equal(t, videos[idx].Rating.String(), item.Rating)
+	}
+	videoGateway.AssertExpectations(t)
+}
+
+func TestFindAllVideosWhenQueryIsInvalid(t *testing.T) {
+	videoGateway := new(mocks.VideoGatewayMock)
+	sut := video_usecase.DefaultListVideosUseCase{
+		Gateway: videoGateway,
+	}
+	tests := []struct {
+		expectedMsg string
+		query       video.VideoSearchQuery
+	}{
+		{
+			expectedMsg: "invalid page",
+			query: video.VideoSearchQuery{
+				SearchQuery: domain.SearchQuery{Page: 0, PerPage: 10},
+			},
+		},
+		{
+			expectedMsg: "perPage should be greater than zero",
+			query: video.VideoSearchQuery{
+				SearchQuery: domain.SearchQuery{Page: 1, PerPage: 0},
+			},
+		},
+		{
+			expectedMsg: "can only sort by 'title', 'year_launched', 'duration' and 'created_at'",
+			query: video.VideoSearchQuery{
+				SearchQuery: domain.SearchQuery{Page: 1, PerPage: 10, Sort: "name"},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		page, err := sut.Execute(test.query)
+
+		assert.Nil(t, page)
+		assert.Equal(t, test.expectedMsg, err.Error())
+	}
+	videoGateway.AssertNotCalled(t, "FindAll")
+}
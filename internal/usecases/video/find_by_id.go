@@ -0,0 +1,157 @@
+package video_usecase
+
+import (
+	"errors"
+	"time"
+
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/series"
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/video"
+)
+
+type ImageMediaOutput struct {
+	ID       int64  `json:"id"`
+	Checksum string `json:"checksum"`
+	Name     string `json:"name"`
+	Location string `json:"location"`
+}
+
+type AudioVideoMediaOutput struct {
+	ID              int64                `json:"id"`
+	Checksum        string               `json:"checksum"`
+	Name            string               `json:"name"`
+	RawLocation     string               `json:"rawLocation"`
+	EncodedLocation string               `json:"encodedLocation"`
+	Status          string               `json:"status"`
+	FailureReason   string               `json:"failureReason"`
+	Attempts        int                  `json:"attempts"`
+	Metadata        *MediaMetadataOutput `json:"metadata"`
+	Renditions      []RenditionOutput    `json:"renditions"`
+}
+
+type VideoOutput struct {
+	ID                 int64                  `json:"id"`
+	Title              string                 `json:"title"`
+	Description        string                 `json:"description"`
+	LaunchedAt         int                    `json:"yearLaunched"`
+	Duration           float64                `json:"duration"`
+	Opened             bool                   `json:"opened"`
+	Published          bool                   `json:"published"`
+	PublicationStatus  string                 `json:"publicationStatus"`
+	PublishAt          *time.Time             `json:"publishAt"`
+	Rating             string                 `json:"rating"`
+	CreatedAt          time.Time              `json:"createdAt"`
+	UpdatedAt          time.Time              `json:"updatedAt"`
+	Banner             *ImageMediaOutput      `json:"banner"`
+	Thumbnail          *ImageMediaOutput      `json:"thumbnail"`
+	ThumbnailHalf      *ImageMediaOutput      `json:"thumbnailHalf"`
+	Artwork            []ArtworkOutput        `json:"artwork"`
+	Video              *AudioVideoMediaOutput `json:"video"`
+	Trailer            *AudioVideoMediaOutput `json:"trailer"`
+	AudioTracks        []AudioTrackOutput     `json:"audioTracks"`
+	Extras             []ExtraOutput          `json:"extras"`
+	CategoryIds        []int64                `json:"categoryIds"`
+	GenreIds           []int64                `json:"genreIds"`
+	InheritsCategories bool                   `json:"inheritsCategories"`
+	InheritsGenres     bool                   `json:"inheritsGenres"`
+	MemberIds          []int64                `json:"memberIds"`
+	Ratings            []RatingOutput         `json:"ratings"`
+	Descriptors        []string               `json:"descriptors"`
+	Warnings           []string               `json:"warnings"`
+}
+
+type GetVideoByIdUseCase interface {
+	Execute(videoId int64) (*VideoOutput, error)
+}
+
+type DefaultGetVideoByIdUseCase struct {
+	Gateway       video.VideoGateway
+	SeriesGateway series.SeriesGateway
+}
+
+func (useCase DefaultGetVideoByIdUseCase) Execute(videoId int64) (*VideoOutput, error) {
+	aVideo, err := useCase.Gateway.FindById(videoId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	output := &VideoOutput{
+		ID:                aVideo.ID,
+		Title:             aVideo.Title,
+		Description:       aVideo.Description,
+		LaunchedAt:        aVideo.LaunchedAt,
+		Duration:          aVideo.Duration,
+		Opened:            aVideo.Opened,
+		Published:         aVideo.Published,
+		PublicationStatus: aVideo.PublicationStatus.String(),
+		PublishAt:         aVideo.PublishAt,
+		Rating:            aVideo.Rating.String(),
+		CreatedAt:         aVideo.CreatedAt,
+		UpdatedAt:         aVideo.UpdatedAt,
+		Banner:            toImageMediaOutput(aVideo.Banner),
+		Thumbnail:         toImageMediaOutput(aVideo.ThumbNail),
+		ThumbnailHalf:     toImageMediaOutput(aVideo.ThumbNailHalf),
+		Artwork:           toArtworkOutputs(aVideo.Artworks),
+		Video:             toAudioVideoMediaOutput(aVideo.Video),
+		Trailer:           toAudioVideoMediaOutput(aVideo.Trailer),
+		AudioTracks:       toAudioTrackOutputs(aVideo.AudioTracks),
+		Extras:            toExtraOutputs(aVideo),
+		CategoryIds:       aVideo.CategoryIds,
+		GenreIds:          aVideo.GenreIds,
+		MemberIds:         aVideo.CastMemberIds,
+		Ratings:           toRatingOutputs(aVideo.Ratings),
+		Descriptors:       toDescriptorOutputs(aVideo.Descriptors),
+		Warnings:          durationWarnings(aVideo),
+	}
+
+	aSeries, err := useCase.SeriesGateway.FindByEpisode(aVideo.ID)
+
+	if errors.Is(err, series.ErrSeriesNotFound) {
+		return output, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	// an episode without its own categories or genres inherits the ones of its series
+	episode := series.Episode{VideoId: aVideo.ID, CategoryIds: aVideo.CategoryIds, GenreIds: aVideo.GenreIds}
+	output.CategoryIds = episode.EffectiveCategoryIds(*aSeries)
+	output.GenreIds = episode.EffectiveGenreIds(*aSeries)
+	output.InheritsCategories = episode.InheritsCategories()
+	output.InheritsGenres = episode.InheritsGenres()
+
+	return output, nil
+}
+
+func toImageMediaOutput(image *video.ImageMedia) *ImageMediaOutput {
+	if image == nil {
+		return nil
+	}
+
+	return &ImageMediaOutput{
+		ID:       image.ID,
+		Checksum: image.Checksum,
+		Name:     image.Name,
+		Location: image.Location,
+	}
+}
+
+func toAudioVideoMediaOutput(media *video.AudioVideoMedia) *AudioVideoMediaOutput {
+	if media == nil {
+		return nil
+	}
+
+	return &AudioVideoMediaOutput{
+		ID:              media.ID,
+		Checksum:        media.Checksum,
+		Name:            media.Name,
+		RawLocation:     media.RawLocation,
+		EncodedLocation: media.EncodedLocation,
+		Status:          media.Status.String(),
+		FailureReason:   media.FailureReason,
+		Attempts:        media.Attempts,
+		Metadata:        toMediaMetadataOutput(media.Metadata),
+		Renditions:      toRenditionOutputs(media.Ladder()),
+	}
+}
@@ -0,0 +1,97 @@
+package video_usecase_test
+
+import (
+	"testing"
+
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/series"
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/video"
+	video_usecase "github.com.br/gibranct/admin_do_catalogo/internal/usecases/video"
+	"github.com.br/gibranct/admin_do_catalogo/pkg/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindVideoByIdUseCase(t *testing.T) {
+	videoGateway := new(mocks.VideoGatewayMock)
+	seriesGateway := new(mocks.SeriesGatewayMock)
+	sut := video_usecase.DefaultGetVideoByIdUseCase{
+		Gateway:       videoGateway,
+		SeriesGateway: seriesGateway,
+	}
+	status := video.COMPLETED
+	aVideo := video.NewVideo(
+		"dummy title", "dummy desc", 2024, 120.0, true, video.AGE_12,
+		[]int64{78}, []int64{39}, []int64{55},
+	)
+	aVideo.ID = 999
+	aVideo.UpdateVideoMedia(video.NewAudioVideoMediaWith(
+		10, &status, "checksum", "video.mp4", "/raw/video.mp4", "/encoded/video",
+	))
+	aVideo.Video.Metadata = &video.MediaMetadata{
+		Duration: 5400, Width: 1920, Height: 1080, Codecs: []string{"avc1"}, TrackCount: 1,
+	}
+	aVideo.UpdateBannerMedia(video.NewImageMediaWithId(20, "checksum", "banner.png", "/banner.png"))
+
+	videoGateway.On("FindById", aVideo.ID).Return(aVideo, nil)
+	seriesGateway.On("FindByEpisode", aVideo.ID).Return((*series.Series)(nil), series.ErrSeriesNotFound)
+
+	output, err := sut.Execute(aVideo.ID)
+
+	assert.Nil(t, err)
+	assert.Equal(t, aVideo.ID, output.ID)
+	assert.Equal(t, aVideo.Title, output.Title)
+	assert.Equal(t, "12", output.Rating)
+	assert.Equal(t, "COMPLETED", output.Video.Status)
+	assert.Equal(t, "/encoded/video", output.Video.EncodedLocation)
+	assert.Equal(t, &video_usecase.MediaMetadataOutput{
+		Duration: 5400, Width: 1920, Height: 1080, Codecs: []string{"avc1"}, TrackCount: 1,
+	}, output.Video.Metadata)
+	assert.Equal(t, []string{"'duration' is 120.00 minutes but the video file lasts 90.00 minutes"}, output.Warnings)
+	assert.Nil(t, output.Trailer)
+	assert.Equal(t, aVideo.Banner.ID, output.Banner.ID)
+	assert.Nil(t, output.Thumbnail)
+	assert.Equal(t, aVideo.CategoryIds, output.CategoryIds)
+	assert.Equal(t, aVideo.GenreIds, output.GenreIds)
+	assert.False(t, output.InheritsCategories)
+	assert.Equal(t, aVideo.CastMemberIds, output.MemberIds)
+	videoGateway.AssertExpectations(t)
+	videoGateway.AssertNumberOfCalls(t, "FindById", 1)
+}
+
+func TestFindEpisodeByIdInheritsFromItsSeries(t *testing.T) {
+	videoGateway := new(mocks.VideoGatewayMock)
+	seriesGateway := new(mocks.SeriesGatewayMock)
+	sut := video_usecase.DefaultGetVideoByIdUseCase{
+		Gateway:       videoGateway,
+		SeriesGateway: seriesGateway,
+	}
+	aVideo := video.NewVideo("Secrets", "desc", 2017, 50.0, true, video.AGE_16, []int64{}, []int64{8}, nil)
+	aVideo.ID = 10
+	aSeries := series.NewSeries("Dark", "time travel", []int64{1, 2}, []int64{3})
+
+	videoGateway.On("FindById", aVideo.ID).Return(aVideo, nil)
+	seriesGateway.On("FindByEpisode", aVideo.ID).Return(aSeries, nil)
+
+	output, err := sut.Execute(aVideo.ID)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []int64{1, 2}, output.CategoryIds)
+	assert.True(t, output.InheritsCategories)
+	assert.Equal(t, []int64{8}, output.GenreIds)
+	assert.False(t, output.InheritsGenres)
+}
+
+func TestFindVideoByIdUseCaseWhenNotFound(t *testing.T) {
+	videoGateway := new(mocks.VideoGatewayMock)
+	sut := video_usecase.DefaultGetVideoByIdUseCase{
+		Gateway: videoGateway,
+	}
+	videoId := int64(999)
+
+	videoGateway.On("FindById", videoId).Return(&video.Video{}, video.ErrVideoNotFound)
+
+	output, err := sut.Execute(videoId)
+
+	assert.Nil(t, output)
+	assert.Equal(t, video.ErrVideoNotFound, err)
+	videoGateway.AssertExpectations(t)
+}
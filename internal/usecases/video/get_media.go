@@ -0,0 +1,102 @@
+package video_usecase
+
+import (
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/video"
+)
+
+type GetMediaCommand struct {
+	VideoId int64
+	Type    video.VideoMediaType
+	Encoded bool
+}
+
+type GetMediaUseCase interface {
+	Execute(c GetMediaCommand) (*video.Resource, error)
+}
+
+type DefaultGetMediaUseCase struct {
+	Gateway      video.VideoGateway
+	MediaGateway video.MediaResourceGateway
+}
+
+func (useCase DefaultGetMediaUseCase) Execute(command GetMediaCommand) (*video.Resource, error) {
+	aVideo, err := useCase.Gateway.FindById(command.VideoId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if command.Encoded {
+		return useCase.encoded(aVideo, command.Type)
+	}
+
+	checksum, found := storedChecksum(aVideo, command.Type)
+	if !found {
+		return nil, video.ErrResourceNotFound
+	}
+
+	var resource *video.Resource
+
+	// a trailer promoted from an extra is stored with the extra
+	if extra, isExtra := primaryTrailerExtra(aVideo, command.Type); isExtra {
+		resource, err = useCase.MediaGateway.GetExtra(aVideo.ID, extra.ID)
+	} else {
+		resource, err = useCase.MediaGateway.GetResource(aVideo.ID, command.Type)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	resource.Checksum = checksum
+
+	return resource, nil
+}
+
+func (useCase DefaultGetMediaUseCase) encoded(aVideo *video.Video, aType video.VideoMediaType) (*video.Resource, error) {
+	media, err := aVideo.AudioVideoMedia(aType)
+	if err != nil {
+		return nil, err
+	}
+
+	// the encoded output only exists once the encoder reported it
+	if media.Status == nil || *media.Status != video.COMPLETED || media.EncodedLocation == "" {
+		return nil, video.ErrResourceNotFound
+	}
+
+	return useCase.MediaGateway.GetEncoded(media.EncodedLocation)
+}
+
+func storedChecksum(aVideo *video.Video, aType video.VideoMediaType) (string, bool) {
+	switch aType {
+	case video.VIDEO:
+		if aVideo.Video != nil {
+			return aVideo.Video.Checksum, true
+		}
+	case video.TRAILER:
+		if aVideo.Trailer != nil {
+			return aVideo.Trailer.Checksum, true
+		}
+	case video.BANNER:
+		if aVideo.Banner != nil {
+			return aVideo.Banner.Checksum, true
+		}
+	case video.THUMBNAIL:
+		if aVideo.ThumbNail != nil {
+			return aVideo.ThumbNail.Checksum, true
+		}
+	case video.THUMBNAIL_HALF:
+		if aVideo.ThumbNailHalf != nil {
+			return aVideo.ThumbNailHalf.Checksum, true
+		}
+	}
+
+	return "", false
+}
+
+func primaryTrailerExtra(aVideo *video.Video, aType video.VideoMediaType) (*video.Extra, bool) {
+	if aType != video.TRAILER || aVideo.Trailer == nil {
+		return nil, false
+	}
+	return aVideo.FindExtraByMediaId(aVideo.Trailer.ID)
+}
@@ -0,0 +1,127 @@
+package video_usecase_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/video"
+	video_usecase "github.com.br/gibranct/admin_do_catalogo/internal/usecases/video"
+	"github.com.br/gibranct/admin_do_catalogo/pkg/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetMedia(t *testing.T) {
+	videoGateway := new(mocks.VideoGatewayMock)
+	mediaGateway := new(mocks.MediaResourceGatewayMock)
+	sut := video_usecase.DefaultGetMediaUseCase{
+		Gateway:      videoGateway,
+		MediaGateway: mediaGateway,
+	}
+	aVideo := video.NewVideo("title", "desc", 2024, 120.0, true, video.L, nil, nil, nil)
+	aVideo.ID = 999
+	status := video.PENDING
+	aVideo.UpdateTrailerMedia(video.NewAudioVideoMediaWith(15, &status, "stored-checksum", "trailer.mp4", "/trailer.mp4", ""))
+	resource := &video.Resource{
+		Stream:      strings.NewReader("trailer"),
+		ContentType: "video/mp4",
+		Name:        "trailer.mp4",
+		Size:        7,
+	}
+
+	videoGateway.On("FindById", aVideo.ID).Return(aVideo, nil)
+	mediaGateway.On("GetResource", aVideo.ID, video.TRAILER).Return(resource, nil)
+
+	output, err := sut.Execute(video_usecase.GetMediaCommand{VideoId: aVideo.ID, Type: video.TRAILER})
+
+	assert.Nil(t, err)
+	assert.Equal(t, "stored-checksum", output.Checksum)
+	assert.Equal(t, "video/mp4", output.ContentType)
+	assert.Equal(t, int64(7), output.Size)
+	videoGateway.AssertExpectations(t)
+	mediaGateway.AssertExpectations(t)
+}
+
+func TestGetMediaWhenVideoHasNoSuchMedia(t *testing.T) {
+	videoGateway := new(mocks.VideoGatewayMock)
+	mediaGateway := new(mocks.MediaResourceGatewayMock)
+	sut := video_usecase.DefaultGetMediaUseCase{
+		Gateway:      videoGateway,
+		MediaGateway: mediaGateway,
+	}
+	aVideo := video.NewVideo("title", "desc", 2024, 120.0, true, video.L, nil, nil, nil)
+	aVideo.ID = 999
+
+	videoGateway.On("FindById", aVideo.ID).Return(aVideo, nil)
+
+	output, err := sut.Execute(video_usecase.GetMediaCommand{VideoId: aVideo.ID, Type: video.BANNER})
+
+	assert.Nil(t, output)
+	assert.ErrorIs(t, err, video.ErrResourceNotFound)
+	mediaGateway.AssertNumberOfCalls(t, "GetResource", 0)
+}
+
+func TestGetMediaWhenVideoDoesNotExist(t *testing.T) {
+	videoGateway := new(mocks.VideoGatewayMock)
+	mediaGateway := new(mocks.MediaResourceGatewayMock)
+	sut := video_usecase.DefaultGetMediaUseCase{
+		Gateway:      videoGateway,
+		MediaGateway: mediaGateway,
+	}
+
+	videoGateway.On("FindById", int64(1)).Return((*video.Video)(nil), video.ErrVideoNotFound)
+
+	output, err := sut.Execute(video_usecase.GetMediaCommand{VideoId: 1, Type: video.VIDEO})
+
+	assert.Nil(t, output)
+	assert.ErrorIs(t, err, video.ErrVideoNotFound)
+}
+
+func TestGetEncodedMedia(t *testing.T) {
+	videoGateway := new(mocks.VideoGatewayMock)
+	mediaGateway := new(mocks.MediaResourceGatewayMock)
+	sut := video_usecase.DefaultGetMediaUseCase{
+		Gateway:      videoGateway,
+		MediaGateway: mediaGateway,
+	}
+	aVideo := video.NewVideo("title", "desc", 2024, 120.0, true, video.L, nil, nil, nil)
+	aVideo.ID = 999
+	status := video.COMPLETED
+	aVideo.UpdateVideoMedia(video.NewAudioVideoMediaWith(15, &status, "stored-checksum", "movie.mp4", "/movie.mp4", "/encoded/movie.mp4"))
+	resource := &video.Resource{
+		Stream:      strings.NewReader("encoded"),
+		ContentType: "video/mp4",
+		Name:        "movie.mp4",
+		Size:        7,
+	}
+
+	videoGateway.On("FindById", aVideo.ID).Return(aVideo, nil)
+	mediaGateway.On("GetEncoded", "/encoded/movie.mp4").Return(resource, nil)
+
+	output, err := sut.Execute(video_usecase.GetMediaCommand{VideoId: aVideo.ID, Type: video.VIDEO, Encoded: true})
+
+	assert.Nil(t, err)
+	assert.Equal(t, resource, output)
+	mediaGateway.AssertNumberOfCalls(t, "GetResource", 0)
+	mediaGateway.AssertExpectations(t)
+}
+
+func TestGetEncodedMediaWhenItIsNotCompleted(t *testing.T) {
+	videoGateway := new(mocks.VideoGatewayMock)
+	mediaGateway := new(mocks.MediaResourceGatewayMock)
+	sut := video_usecase.DefaultGetMediaUseCase{
+		Gateway:      videoGateway,
+		MediaGateway: mediaGateway,
+	}
+	aVideo := video.NewVideo("title", "desc", 2024, 120.0, true, video.L, nil, nil, nil)
+	aVideo.ID = 999
+	status := video.PROCESSING
+	aVideo.UpdateVideoMedia(video.NewAudioVideoMediaWith(15, &status, "stored-checksum", "movie.mp4", "/movie.mp4", ""))
+
+	videoGateway.On("FindById", aVideo.ID).Return(aVideo, nil)
+
+	output, err := sut.Execute(video_usecase.GetMediaCommand{VideoId: aVideo.ID, Type: video.VIDEO, Encoded: true})
+
+	assert.Nil(t, output)
+	assert.ErrorIs(t, err, video.ErrResourceNotFound)
+	mediaGateway.AssertNumberOfCalls(t, "GetEncoded", 0)
+}
@@ -0,0 +1,70 @@
+package video_usecase
+
+import (
+	"errors"
+	"io"
+
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/video"
+	"github.com.br/gibranct/admin_do_catalogo/pkg/notification"
+)
+
+type MediaMetadataOutput struct {
+	Duration   float64  `json:"duration"`
+	Width      int      `json:"width"`
+	Height     int      `json:"height"`
+	Codecs     []string `json:"codecs"`
+	TrackCount int      `json:"trackCount"`
+}
+
+// the content is read from its blob, it is not served under its location before being committed
+func extractMetadata(
+	checksum string,
+	mediaGateway video.MediaResourceGateway,
+	extractor video.MediaMetadataExtractor,
+) (*video.MediaMetadata, error) {
+	resource, err := mediaGateway.GetBlob(checksum)
+	if err != nil {
+		return nil, err
+	}
+
+	if closer, ok := resource.Stream.(io.Closer); ok {
+		defer closer.Close()
+	}
+
+	content, ok := resource.Stream.(io.ReadSeeker)
+	if !ok {
+		return nil, nil
+	}
+
+	metadata, err := extractor.Extract(content)
+	if errors.Is(err, video.ErrUnsupportedMedia) {
+		return nil, nil
+	}
+
+	return metadata, err
+}
+
+func durationWarnings(aVideo *video.Video) []string {
+	n := notification.CreateNotification()
+	aVideo.ValidateDuration(n)
+
+	warnings := []string{}
+	for _, err := range n.GetErrors() {
+		warnings = append(warnings, err.Error())
+	}
+	return warnings
+}
+
+func toMediaMetadataOutput(metadata *video.MediaMetadata) *MediaMetadataOutput {
+	if metadata == nil {
+		return nil
+	}
+
+	return &MediaMetadataOutput{
+		Duration:   metadata.Duration,
+		Width:      metadata.Width,
+		Height:     metadata.Height,
+		Codecs:     metadata.Codecs,
+		TrackCount: metadata.TrackCount,
+	}
+}
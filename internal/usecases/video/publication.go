@@ -0,0 +1,136 @@
+package video_usecase
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/series"
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/video"
+	"github.com.br/gibranct/admin_do_catalogo/pkg/notification"
+)
+
+type PublicationOutput struct {
+	ID                int64      `json:"id"`
+	PublicationStatus string     `json:"publicationStatus"`
+	PublishAt         *time.Time `json:"publishAt"`
+	Published         bool       `json:"published"`
+}
+
+type VideoNotReadyError struct {
+	Notification *notification.Notification
+}
+
+func (e VideoNotReadyError) Error() string {
+	return fmt.Sprintf("video is not ready to be published: %v", errors.Join(e.Notification.GetErrors()...))
+}
+
+type PublishVideoCommand struct {
+	VideoId   int64
+	PublishAt *time.Time
+}
+
+type SubmitVideoUseCase interface {
+	Execute(videoId int64) (*PublicationOutput, error)
+}
+
+type PublishVideoUseCase interface {
+	Execute(c PublishVideoCommand) (*PublicationOutput, error)
+}
+
+type RejectVideoUseCase interface {
+	Execute(videoId int64) (*PublicationOutput, error)
+}
+
+type UnpublishVideoUseCase interface {
+	Execute(videoId int64) (*PublicationOutput, error)
+}
+
+type DefaultSubmitVideoUseCase struct {
+	Gateway video.VideoGateway
+}
+
+type DefaultPublishVideoUseCase struct {
+	Gateway       video.VideoGateway
+	SeriesGateway series.SeriesGateway
+	Rules         []video.ReadinessRule
+}
+
+type DefaultRejectVideoUseCase struct {
+	Gateway video.VideoGateway
+}
+
+type DefaultUnpublishVideoUseCase struct {
+	Gateway video.VideoGateway
+}
+
+func (useCase DefaultSubmitVideoUseCase) Execute(videoId int64) (*PublicationOutput, error) {
+	return changePublication(useCase.Gateway, videoId, (*video.Video).Submit)
+}
+
+func (useCase DefaultPublishVideoUseCase) Execute(command PublishVideoCommand) (*PublicationOutput, error) {
+	now := time.Now().UTC()
+	publishAt := now
+	if command.PublishAt != nil {
+		publishAt = *command.PublishAt
+	}
+
+	return changePublication(useCase.Gateway, command.VideoId, func(aVideo *video.Video) error {
+		if err := aVideo.Approve(publishAt, now); err != nil {
+			return err
+		}
+
+		return checkReadiness(aVideo, useCase.Rules, useCase.SeriesGateway)
+	})
+}
+
+func (useCase DefaultRejectVideoUseCase) Execute(videoId int64) (*PublicationOutput, error) {
+	return changePublication(useCase.Gateway, videoId, (*video.Video).Reject)
+}
+
+func (useCase DefaultUnpublishVideoUseCase) Execute(videoId int64) (*PublicationOutput, error) {
+	return changePublication(useCase.Gateway, videoId, (*video.Video).Unpublish)
+}
+
+func checkReadiness(aVideo *video.Video, rules []video.ReadinessRule, seriesGateway series.SeriesGateway) error {
+	effective, err := withEffectiveTaxonomy(*aVideo, seriesGateway)
+	if err != nil {
+		return err
+	}
+
+	n := notification.CreateNotification()
+	effective.ValidateReadiness(rules, n)
+
+	if n.HasErrors() {
+		return VideoNotReadyError{Notification: n}
+	}
+
+	return nil
+}
+
+func changePublication(
+	gateway video.VideoGateway,
+	videoId int64,
+	change func(aVideo *video.Video) error,
+) (*PublicationOutput, error) {
+	aVideo, err := gateway.FindById(videoId)
+	if err != nil {
+		return nil, err
+	}
+
+	previous := aVideo.PublicationStatus
+	if err = change(aVideo); err != nil {
+		return nil, err
+	}
+
+	if err = gateway.UpdatePublication(*aVideo, previous); err != nil {
+		return nil, err
+	}
+
+	return &PublicationOutput{
+		ID:                aVideo.ID,
+		PublicationStatus: aVideo.PublicationStatus.String(),
+		PublishAt:         aVideo.PublishAt,
+		Published:         aVideo.Published,
+	}, nil
+}
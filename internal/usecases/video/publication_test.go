@@ -0,0 +1,257 @@
+package video_usecase_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/series"
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/video"
+	video_usecase "github.com.br/gibranct/admin_do_catalogo/internal/usecases/video"
+	"github.com.br/gibranct/admin_do_catalogo/pkg/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func videoInReview() *video.Video {
+	aVideo := videoWithMedias()
+	aVideo.Completed(video.VIDEO, "/encoded")
+	aVideo.UpdateBannerMedia(video.NewImageMediaWithId(20, "banner", "banner.png", "/banner.png"))
+	aVideo.UpdateThumbnailMedia(video.NewImageMediaWithId(21, "thumb", "thumb.png", "/thumb.png"))
+	aVideo.CategoryIds = []int64{1}
+	aVideo.GenreIds = []int64{2}
+	aVideo.Submit()
+	return aVideo
+}
+
+func standaloneVideos() *mocks.SeriesGatewayMock {
+	seriesGateway := new(mocks.SeriesGatewayMock)
+	seriesGateway.On("FindByEpisode", mock.Anything).Return((*series.Series)(nil), series.ErrSeriesNotFound)
+	return seriesGateway
+}
+
+func TestSubmitVideo(t *testing.T) {
+	videoGateway := new(mocks.VideoGatewayMock)
+	sut := video_usecase.DefaultSubmitVideoUseCase{Gateway: videoGateway}
+	aVideo := video.NewVideo("title", "desc", 2024, 120.0, true, video.L, nil, nil, nil)
+	aVideo.ID = 999
+
+	videoGateway.On("FindById", aVideo.ID).Return(aVideo, nil)
+	videoGateway.On("UpdatePublication", mock.MatchedBy(func(v video.Video) bool {
+		return v.PublicationStatus == video.IN_REVIEW
+	}), video.DRAFT).Return(nil)
+
+	output, err := sut.Execute(aVideo.ID)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "IN_REVIEW", output.PublicationStatus)
+	assert.False(t, output.Published)
+	videoGateway.AssertExpectations(t)
+}
+
+func TestPublishVideoWithFuturePublishAt(t *testing.T) {
+	videoGateway := new(mocks.VideoGatewayMock)
+	sut := video_usecase.DefaultPublishVideoUseCase{
+		Gateway:       videoGateway,
+		SeriesGateway: standaloneVideos(),
+		Rules:         video.DefaultReadinessRules(),
+	}
+	aVideo := videoInReview()
+	publishAt := time.Now().UTC().Add(24 * time.Hour)
+
+	videoGateway.On("FindById", aVideo.ID).Return(aVideo, nil)
+	videoGateway.On("UpdatePublication", mock.MatchedBy(func(v video.Video) bool {
+		return v.PublicationStatus == video.SCHEDULED && v.PublishAt.Equal(publishAt)
+	}), video.IN_REVIEW).Return(nil)
+
+	output, err := sut.Execute(video_usecase.PublishVideoCommand{VideoId: aVideo.ID, PublishAt: &publishAt})
+
+	assert.Nil(t, err)
+	assert.Equal(t, "SCHEDULED", output.PublicationStatus)
+	assert.False(t, output.Published)
+	videoGateway.AssertExpectations(t)
+}
+
+func TestPublishVideoWithoutPublishAt(t *testing.T) {
+	videoGateway := new(mocks.VideoGatewayMock)
+	sut := video_usecase.DefaultPublishVideoUseCase{
+		Gateway:       videoGateway,
+		SeriesGateway: standaloneVideos(),
+		Rules:         video.DefaultReadinessRules(),
+	}
+	aVideo := videoInReview()
+
+	videoGateway.On("FindById", aVideo.ID).Return(aVideo, nil)
+	videoGateway.On("UpdatePublication", mock.Anything, mock.Anything).Return(nil)
+
+	output, err := sut.Execute(video_usecase.PublishVideoCommand{VideoId: aVideo.ID})
+
+	assert.Nil(t, err)
+	assert.Equal(t, "PUBLISHED", output.PublicationStatus)
+	assert.True(t, output.Published)
+	assert.NotNil(t, output.PublishAt)
+}
+
+func TestPublishVideoThatIsNotReady(t *testing.T) {
+	videoGateway := new(mocks.VideoGatewayMock)
+	sut := video_usecase.DefaultPublishVideoUseCase{
+		Gateway:       videoGateway,
+		SeriesGateway: standaloneVideos(),
+		Rules:         video.DefaultReadinessRules(),
+	}
+	aVideo := videoInReview()
+	aVideo.Banner = nil
+	aVideo.GenreIds = nil
+
+	videoGateway.On("FindById", aVideo.ID).Return(aVideo, nil)
+
+	output, err := sut.Execute(video_usecase.PublishVideoCommand{VideoId: aVideo.ID})
+
+	var notReadyErr video_usecase.VideoNotReadyError
+	assert.Nil(t, output)
+	assert.ErrorAs(t, err, &notReadyErr)
+	assert.Len(t, notReadyErr.Notification.GetErrors(), 2)
+	assert.EqualError(t, notReadyErr.Notification.GetErrors()[0], "'Banner' media must be present")
+	assert.EqualError(t, notReadyErr.Notification.GetErrors()[1], "at least one genre must be attached")
+	videoGateway.AssertNumberOfCalls(t, "UpdatePublication", 0)
+}
+
+func TestPublishEpisodeInheritingFromItsSeries(t *testing.T) {
+	videoGateway := new(mocks.VideoGatewayMock)
+	seriesGateway := new(mocks.SeriesGatewayMock)
+	sut := video_usecase.DefaultPublishVideoUseCase{
+		Gateway:       videoGateway,
+		SeriesGateway: seriesGateway,
+		Rules:         video.DefaultReadinessRules(),
+	}
+	aVideo := videoInReview()
+	aVideo.CategoryIds = nil
+	aVideo.GenreIds = nil
+
+	videoGateway.On("FindById", aVideo.ID).Return(aVideo, nil)
+	seriesGateway.On("FindByEpisode", aVideo.ID).Return(series.NewSeries("Dark", "time travel", []int64{1}, []int64{3}), nil)
+	videoGateway.On("UpdatePublication", mock.MatchedBy(func(v video.Video) bool {
+		return v.CategoryIds == nil && v.GenreIds == nil
+	}), video.IN_REVIEW).Return(nil)
+
+	output, err := sut.Execute(video_usecase.PublishVideoCommand{VideoId: aVideo.ID})
+
+	assert.Nil(t, err)
+	assert.Equal(t, "PUBLISHED", output.PublicationStatus)
+	videoGateway.AssertExpectations(t)
+}
+
+func TestRejectVideoInDraft(t *testing.T) {
+	videoGateway := new(mocks.VideoGatewayMock)
+	sut := video_usecase.DefaultRejectVideoUseCase{Gateway: videoGateway}
+	aVideo := video.NewVideo("title", "desc", 2024, 120.0, true, video.L, nil, nil, nil)
+
+	videoGateway.On("FindById", aVideo.ID).Return(aVideo, nil)
+
+	output, err := sut.Execute(aVideo.ID)
+
+	assert.Nil(t, output)
+	assert.ErrorAs(t, err, &video.InvalidPublicationTransitionError{})
+	videoGateway.AssertNumberOfCalls(t, "UpdatePublication", 0)
+}
+
+func TestUnpublishVideo(t *testing.T) {
+	videoGateway := new(mocks.VideoGatewayMock)
+	sut := video_usecase.DefaultUnpublishVideoUseCase{Gateway: videoGateway}
+	aVideo := videoInReview()
+	aVideo.Approve(time.Now(), time.Now())
+
+	videoGateway.On("FindById", aVideo.ID).Return(aVideo, nil)
+	videoGateway.On("UpdatePublication", mock.Anything, mock.Anything).Return(nil)
+
+	output, err := sut.Execute(aVideo.ID)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "UNPUBLISHED", output.PublicationStatus)
+	assert.Nil(t, output.PublishAt)
+	assert.False(t, output.Published)
+}
+
+func TestChangePublicationOfMissingVideo(t *testing.T) {
+	videoGateway := new(mocks.VideoGatewayMock)
+	sut := video_usecase.DefaultSubmitVideoUseCase{Gateway: videoGateway}
+
+	videoGateway.On("FindById", int64(5)).Return((*video.Video)(nil), video.ErrVideoNotFound)
+
+	output, err := sut.Execute(5)
+
+	assert.Nil(t, output)
+	assert.ErrorIs(t, err, video.ErrVideoNotFound)
+}
+
+func TestPublishDueVideos(t *testing.T) {
+	videoGateway := new(mocks.VideoGatewayMock)
+	sut := video_usecase.DefaultPublishDueVideosUseCase{
+		Gateway:       videoGateway,
+		SeriesGateway: standaloneVideos(),
+		Rules:         video.DefaultReadinessRules(),
+	}
+	now := time.Now().UTC()
+	due := videoInReview()
+	due.Approve(now.Add(time.Hour), now)
+	notScheduled := video.NewVideo("title", "desc", 2024, 120.0, true, video.L, nil, nil, nil)
+	notScheduled.ID = 1000
+	updateErr := errors.New("connection lost")
+	failing := videoInReview()
+	failing.ID = 1001
+	failing.Approve(now.Add(time.Hour), now)
+	notReady := videoInReview()
+	notReady.ID = 1002
+	notReady.Approve(now.Add(time.Hour), now)
+	notReady.ThumbNail = nil
+
+	later := now.Add(2 * time.Hour)
+	videoGateway.On("FindDueForPublication", later).Return([]int64{due.ID, notScheduled.ID, failing.ID, notReady.ID}, nil)
+	videoGateway.On("FindById", due.ID).Return(due, nil)
+	videoGateway.On("FindById", notScheduled.ID).Return(notScheduled, nil)
+	videoGateway.On("FindById", failing.ID).Return(failing, nil)
+	videoGateway.On("FindById", notReady.ID).Return(notReady, nil)
+	videoGateway.On("UpdatePublication", mock.MatchedBy(func(v video.Video) bool {
+		return v.ID == due.ID && v.PublicationStatus == video.PUBLISHED && v.Published
+	}), mock.Anything).Return(nil)
+	videoGateway.On("UpdatePublication", mock.MatchedBy(func(v video.Video) bool {
+		return v.ID == failing.ID
+	}), mock.Anything).Return(updateErr)
+	videoGateway.On("UpdatePublication", mock.MatchedBy(func(v video.Video) bool {
+		return v.ID == notReady.ID && v.PublicationStatus == video.IN_REVIEW && v.PublishAt == nil
+	}), video.SCHEDULED).Return(nil)
+
+	published, err := sut.Execute(later)
+
+	assert.Equal(t, []int64{due.ID}, published)
+	assert.ErrorAs(t, err, &video.InvalidPublicationTransitionError{})
+	assert.ErrorIs(t, err, updateErr)
+	assert.ErrorAs(t, err, &video_usecase.VideoNotReadyError{})
+	videoGateway.AssertExpectations(t)
+}
+
+func TestPublishDueVideosKeepsTheScheduleWhenTheSeriesLookupFails(t *testing.T) {
+	videoGateway := new(mocks.VideoGatewayMock)
+	seriesGateway := new(mocks.SeriesGatewayMock)
+	sut := video_usecase.DefaultPublishDueVideosUseCase{
+		Gateway:       videoGateway,
+		SeriesGateway: seriesGateway,
+		Rules:         video.DefaultReadinessRules(),
+	}
+	now := time.Now().UTC()
+	due := videoInReview()
+	due.Approve(now.Add(time.Hour), now)
+	lookupErr := errors.New("connection lost")
+
+	later := now.Add(2 * time.Hour)
+	videoGateway.On("FindDueForPublication", later).Return([]int64{due.ID}, nil)
+	videoGateway.On("FindById", due.ID).Return(due, nil)
+	seriesGateway.On("FindByEpisode", due.ID).Return((*series.Series)(nil), lookupErr)
+
+	published, err := sut.Execute(later)
+
+	assert.Empty(t, published)
+	assert.ErrorIs(t, err, lookupErr)
+	assert.False(t, errors.As(err, &video_usecase.VideoNotReadyError{}))
+	videoGateway.AssertNotCalled(t, "UpdatePublication", mock.Anything, mock.Anything)
+}
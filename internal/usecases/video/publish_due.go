@@ -0,0 +1,65 @@
+package video_usecase
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/series"
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/video"
+)
+
+type PublishDueVideosUseCase interface {
+	Execute(now time.Time) ([]int64, error)
+}
+
+type DefaultPublishDueVideosUseCase struct {
+	Gateway       video.VideoGateway
+	SeriesGateway series.SeriesGateway
+	Rules         []video.ReadinessRule
+}
+
+func (useCase DefaultPublishDueVideosUseCase) Execute(now time.Time) ([]int64, error) {
+	videoIds, err := useCase.Gateway.FindDueForPublication(now)
+	if err != nil {
+		return nil, err
+	}
+
+	published := []int64{}
+	var errs []error
+
+	for _, videoId := range videoIds {
+		var notReady error
+
+		_, err := changePublication(useCase.Gateway, videoId, func(aVideo *video.Video) error {
+			if !aVideo.IsDueForPublication(now) {
+				return aVideo.Publish(now)
+			}
+
+			// a video may have lost required media while it was scheduled, it
+			// goes back to review instead of being retried on every run
+			if notReady = checkReadiness(aVideo, useCase.Rules, useCase.SeriesGateway); notReady != nil {
+				// a failed lookup says nothing about readiness, the video stays scheduled
+				if !errors.As(notReady, new(VideoNotReadyError)) {
+					return notReady
+				}
+				return aVideo.Submit()
+			}
+
+			return aVideo.Publish(now)
+		})
+
+		if err == nil {
+			err = notReady
+		}
+
+		if err != nil {
+			errs = append(errs, fmt.Errorf("video %d: %w", videoId, err))
+			continue
+		}
+
+		published = append(published, videoId)
+	}
+
+	return published, errors.Join(errs...)
+}
@@ -0,0 +1,92 @@
+package video_usecase
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/video"
+	"github.com.br/gibranct/admin_do_catalogo/pkg/notification"
+)
+
+type RatingCommand struct {
+	System    string
+	Territory string
+	Value     string
+}
+
+type RatingOutput struct {
+	System    string `json:"system"`
+	Territory string `json:"territory"`
+	Value     string `json:"value"`
+}
+
+type RatingEquivalentOutput struct {
+	System     string `json:"system"`
+	Value      string `json:"value"`
+	MinimumAge int    `json:"minimumAge"`
+}
+
+type GetRatingEquivalentsUseCase interface {
+	Execute(c RatingCommand) ([]RatingEquivalentOutput, error)
+}
+
+type DefaultGetRatingEquivalentsUseCase struct{}
+
+func (useCase DefaultGetRatingEquivalentsUseCase) Execute(command RatingCommand) ([]RatingEquivalentOutput, error) {
+	system, err := video.StringToRatingSystem(command.System)
+	if err != nil {
+		return nil, err
+	}
+
+	if !slices.Contains(system.Values(), command.Value) {
+		return nil, fmt.Errorf("'%s' is not a valid %s rating", command.Value, system)
+	}
+
+	rating := video.NewTerritoryRating(system, "", command.Value)
+	outputs := []RatingEquivalentOutput{}
+
+	for _, other := range video.RatingSystems() {
+		if other == system {
+			continue
+		}
+
+		equivalent := video.NewTerritoryRating(other, "", rating.EquivalentIn(other))
+		outputs = append(outputs, RatingEquivalentOutput{
+			System:     other.String(),
+			Value:      equivalent.Value,
+			MinimumAge: equivalent.MinimumAge(),
+		})
+	}
+
+	return outputs, nil
+}
+
+func toTerritoryRatings(commands []RatingCommand, n *notification.Notification) []video.TerritoryRating {
+	ratings := []video.TerritoryRating{}
+
+	for _, command := range commands {
+		system, err := video.StringToRatingSystem(command.System)
+		if err != nil {
+			n.Add(err)
+			continue
+		}
+
+		ratings = append(ratings, video.NewTerritoryRating(system, command.Territory, command.Value))
+	}
+
+	return ratings
+}
+
+func toRatingOutputs(ratings []video.TerritoryRating) []RatingOutput {
+	outputs := []RatingOutput{}
+
+	for _, rating := range ratings {
+		outputs = append(outputs, RatingOutput{
+			System:    rating.System.String(),
+			Territory: rating.Territory,
+			Value:     rating.Value,
+		})
+	}
+
+	return outputs
+}
@@ -0,0 +1,31 @@
+package video_usecase_test
+
+import (
+	"testing"
+
+	video_usecase "github.com.br/gibranct/admin_do_catalogo/internal/usecases/video"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetRatingEquivalents(t *testing.T) {
+	sut := video_usecase.DefaultGetRatingEquivalentsUseCase{}
+
+	output, err := sut.Execute(video_usecase.RatingCommand{System: "ClassInd", Value: "14"})
+
+	assert.Nil(t, err)
+	assert.Equal(t, []video_usecase.RatingEquivalentOutput{
+		{System: "MPAA", Value: "PG-13", MinimumAge: 13},
+		{System: "PEGI", Value: "16", MinimumAge: 16},
+		{System: "BBFC", Value: "15", MinimumAge: 15},
+	}, output)
+}
+
+func TestGetRatingEquivalentsOfInvalidRating(t *testing.T) {
+	sut := video_usecase.DefaultGetRatingEquivalentsUseCase{}
+
+	_, err := sut.Execute(video_usecase.RatingCommand{System: "ESRB", Value: "M"})
+	assert.EqualError(t, err, "unknown rating system 'ESRB'")
+
+	_, err = sut.Execute(video_usecase.RatingCommand{System: "PEGI", Value: "15"})
+	assert.EqualError(t, err, "'15' is not a valid PEGI rating")
+}
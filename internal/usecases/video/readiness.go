@@ -0,0 +1,75 @@
+package video_usecase
+
+import (
+	"errors"
+
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/series"
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/video"
+)
+
+type ReadinessCheckOutput struct {
+	Rule        string `json:"rule"`
+	Description string `json:"description"`
+	Passed      bool   `json:"passed"`
+}
+
+type ReadinessOutput struct {
+	ID     int64                  `json:"id"`
+	Ready  bool                   `json:"ready"`
+	Checks []ReadinessCheckOutput `json:"checks"`
+}
+
+type GetReadinessUseCase interface {
+	Execute(videoId int64) (*ReadinessOutput, error)
+}
+
+type DefaultGetReadinessUseCase struct {
+	Gateway       video.VideoGateway
+	SeriesGateway series.SeriesGateway
+	Rules         []video.ReadinessRule
+}
+
+func (useCase DefaultGetReadinessUseCase) Execute(videoId int64) (*ReadinessOutput, error) {
+	aVideo, err := useCase.Gateway.FindById(videoId)
+	if err != nil {
+		return nil, err
+	}
+
+	effective, err := withEffectiveTaxonomy(*aVideo, useCase.SeriesGateway)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &ReadinessOutput{ID: aVideo.ID, Ready: true, Checks: []ReadinessCheckOutput{}}
+
+	for _, rule := range useCase.Rules {
+		passed := rule.IsSatisfied(&effective)
+		output.Ready = output.Ready && passed
+		output.Checks = append(output.Checks, ReadinessCheckOutput{
+			Rule:        rule.Name,
+			Description: rule.Description,
+			Passed:      passed,
+		})
+	}
+
+	return output, nil
+}
+
+// an episode without its own categories or genres is checked against the ones of its series
+func withEffectiveTaxonomy(aVideo video.Video, seriesGateway series.SeriesGateway) (video.Video, error) {
+	aSeries, err := seriesGateway.FindByEpisode(aVideo.ID)
+
+	if errors.Is(err, series.ErrSeriesNotFound) {
+		return aVideo, nil
+	}
+
+	if err != nil {
+		return aVideo, err
+	}
+
+	episode := series.Episode{VideoId: aVideo.ID, CategoryIds: aVideo.CategoryIds, GenreIds: aVideo.GenreIds}
+	aVideo.CategoryIds = episode.EffectiveCategoryIds(*aSeries)
+	aVideo.GenreIds = episode.EffectiveGenreIds(*aSeries)
+
+	return aVideo, nil
+}
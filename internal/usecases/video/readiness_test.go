@@ -0,0 +1,65 @@
+package video_usecase_test
+
+import (
+	"testing"
+
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/series"
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/video"
+	video_usecase "github.com.br/gibranct/admin_do_catalogo/internal/usecases/video"
+	"github.com.br/gibranct/admin_do_catalogo/pkg/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetReadiness(t *testing.T) {
+	videoGateway := new(mocks.VideoGatewayMock)
+	rules, _ := video.ReadinessRulesByName([]string{"video_completed", "banner", "rating"})
+	sut := video_usecase.DefaultGetReadinessUseCase{Gateway: videoGateway, SeriesGateway: standaloneVideos(), Rules: rules}
+	aVideo := videoWithMedias()
+	aVideo.Completed(video.VIDEO, "/encoded")
+
+	videoGateway.On("FindById", aVideo.ID).Return(aVideo, nil)
+
+	output, err := sut.Execute(aVideo.ID)
+
+	assert.Nil(t, err)
+	assert.False(t, output.Ready)
+	assert.Equal(t, []video_usecase.ReadinessCheckOutput{
+		{Rule: "video_completed", Description: "'Video' media must be COMPLETED", Passed: true},
+		{Rule: "banner", Description: "'Banner' media must be present", Passed: false},
+		{Rule: "rating", Description: "'rating' must be known", Passed: true},
+	}, output.Checks)
+}
+
+func TestGetReadinessOfEpisodeInheritingFromItsSeries(t *testing.T) {
+	videoGateway := new(mocks.VideoGatewayMock)
+	seriesGateway := new(mocks.SeriesGatewayMock)
+	rules, _ := video.ReadinessRulesByName([]string{"categories", "genres"})
+	sut := video_usecase.DefaultGetReadinessUseCase{Gateway: videoGateway, SeriesGateway: seriesGateway, Rules: rules}
+	aVideo := videoWithMedias()
+	aVideo.CategoryIds = []int64{}
+	aVideo.GenreIds = nil
+
+	videoGateway.On("FindById", aVideo.ID).Return(aVideo, nil)
+	seriesGateway.On("FindByEpisode", aVideo.ID).Return(series.NewSeries("Dark", "time travel", []int64{1, 2}, nil), nil)
+
+	output, err := sut.Execute(aVideo.ID)
+
+	assert.Nil(t, err)
+	assert.False(t, output.Ready)
+	assert.Equal(t, []video_usecase.ReadinessCheckOutput{
+		{Rule: "categories", Description: "at least one category must be attached", Passed: true},
+		{Rule: "genres", Description: "at least one genre must be attached", Passed: false},
+	}, output.Checks)
+}
+
+func TestGetReadinessOfMissingVideo(t *testing.T) {
+	videoGateway := new(mocks.VideoGatewayMock)
+	sut := video_usecase.DefaultGetReadinessUseCase{Gateway: videoGateway, SeriesGateway: standaloneVideos(), Rules: video.DefaultReadinessRules()}
+
+	videoGateway.On("FindById", int64(5)).Return((*video.Video)(nil), video.ErrVideoNotFound)
+
+	output, err := sut.Execute(5)
+
+	assert.Nil(t, output)
+	assert.ErrorIs(t, err, video.ErrVideoNotFound)
+}
@@ -0,0 +1,77 @@
+package video_usecase
+
+import (
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/video"
+)
+
+type RenditionCommand struct {
+	Bandwidth       int64
+	Width           int
+	Height          int
+	Codecs          []string
+	Location        string
+	SegmentDuration float64
+}
+
+type RenditionOutput struct {
+	Bandwidth       int64    `json:"bandwidth"`
+	Resolution      string   `json:"resolution"`
+	Width           int      `json:"width"`
+	Height          int      `json:"height"`
+	Codecs          []string `json:"codecs"`
+	Location        string   `json:"location"`
+	SegmentDuration float64  `json:"segmentDuration"`
+}
+
+type GetManifestCommand struct {
+	VideoId int64
+	Type    video.VideoMediaType
+	Format  video.ManifestFormat
+}
+
+type GetManifestUseCase interface {
+	Execute(c GetManifestCommand) (*video.Resource, error)
+}
+
+type DefaultGetManifestUseCase struct {
+	Gateway   video.VideoGateway
+	Manifests video.ManifestGenerator
+}
+
+func (useCase DefaultGetManifestUseCase) Execute(command GetManifestCommand) (*video.Resource, error) {
+	aVideo, err := useCase.Gateway.FindById(command.VideoId)
+	if err != nil {
+		return nil, err
+	}
+
+	media, err := aVideo.AudioVideoMedia(command.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	return useCase.Manifests.Generate(command.Format, *media)
+}
+
+func toRenditions(commands []RenditionCommand) []video.Rendition {
+	renditions := []video.Rendition{}
+	for _, c := range commands {
+		renditions = append(renditions, *video.NewRendition(c.Bandwidth, c.Width, c.Height, c.Codecs, c.Location, c.SegmentDuration))
+	}
+	return renditions
+}
+
+func toRenditionOutputs(renditions []video.Rendition) []RenditionOutput {
+	outputs := []RenditionOutput{}
+	for _, r := range renditions {
+		outputs = append(outputs, RenditionOutput{
+			Bandwidth:       r.Bandwidth,
+			Resolution:      r.Resolution(),
+			Width:           r.Width,
+			Height:          r.Height,
+			Codecs:          r.Codecs,
+			Location:        r.Location,
+			SegmentDuration: r.SegmentDuration,
+		})
+	}
+	return outputs
+}
@@ -0,0 +1,43 @@
+package video_usecase_test
+
+import (
+	"testing"
+
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/video"
+	video_usecase "github.com.br/gibranct/admin_do_catalogo/internal/usecases/video"
+	"github.com.br/gibranct/admin_do_catalogo/pkg/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGetManifest(t *testing.T) {
+	videoGateway := new(mocks.VideoGatewayMock)
+	manifests := new(mocks.ManifestGeneratorMock)
+	sut := video_usecase.DefaultGetManifestUseCase{Gateway: videoGateway, Manifests: manifests}
+	aVideo := videoWithMedias()
+	manifest := &video.Resource{Content: []byte("#EXTM3U"), Name: "master.m3u8"}
+
+	videoGateway.On("FindById", aVideo.ID).Return(aVideo, nil)
+	manifests.On("Generate", video.HLS, *aVideo.Trailer).Return(manifest, nil)
+
+	resource, err := sut.Execute(video_usecase.GetManifestCommand{VideoId: aVideo.ID, Type: video.TRAILER, Format: video.HLS})
+
+	assert.Nil(t, err)
+	assert.Equal(t, manifest, resource)
+	manifests.AssertExpectations(t)
+}
+
+func TestGetManifestWithoutMedia(t *testing.T) {
+	videoGateway := new(mocks.VideoGatewayMock)
+	manifests := new(mocks.ManifestGeneratorMock)
+	sut := video_usecase.DefaultGetManifestUseCase{Gateway: videoGateway, Manifests: manifests}
+	aVideo := videoWithMedias()
+	aVideo.Trailer = nil
+
+	videoGateway.On("FindById", aVideo.ID).Return(aVideo, nil)
+
+	_, err := sut.Execute(video_usecase.GetManifestCommand{VideoId: aVideo.ID, Type: video.TRAILER, Format: video.DASH})
+
+	assert.ErrorIs(t, err, video.ErrResourceNotFound)
+	manifests.AssertNotCalled(t, "Generate", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,47 @@
+package video_usecase
+
+import (
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/video"
+)
+
+type RetryMediaCommand struct {
+	VideoId int64
+	Type    video.VideoMediaType
+}
+
+type RetryMediaUseCase interface {
+	Execute(c RetryMediaCommand) (*AudioVideoMediaOutput, error)
+}
+
+type DefaultRetryMediaUseCase struct {
+	Gateway  video.VideoGateway
+	Encoding EncodingJobs
+}
+
+func (useCase DefaultRetryMediaUseCase) Execute(command RetryMediaCommand) (*AudioVideoMediaOutput, error) {
+	aVideo, err := useCase.Gateway.FindById(command.VideoId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = aVideo.RetryMedia(command.Type); err != nil {
+		return nil, err
+	}
+
+	media, err := aVideo.AudioVideoMedia(command.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = useCase.Gateway.UpdateMediaStatus(*media); err != nil {
+		return nil, err
+	}
+
+	if command.Type == video.VIDEO {
+		if err = useCase.Encoding.request(useCase.Gateway, aVideo); err != nil {
+			return nil, err
+		}
+	}
+
+	return toAudioVideoMediaOutput(media), nil
+}
@@ -0,0 +1,57 @@
+package video_usecase_test
+
+import (
+	"testing"
+
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/video"
+	video_usecase "github.com.br/gibranct/admin_do_catalogo/internal/usecases/video"
+	"github.com.br/gibranct/admin_do_catalogo/pkg/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestRetryFailedMedia(t *testing.T) {
+	videoGateway := new(mocks.VideoGatewayMock)
+	sut := video_usecase.DefaultRetryMediaUseCase{Gateway: videoGateway}
+	aVideo := videoWithMedias()
+	aVideo.Failed(video.VIDEO, "corrupted stream")
+
+	videoGateway.On("FindById", aVideo.ID).Return(aVideo, nil)
+	videoGateway.On("UpdateMediaStatus", mock.MatchedBy(func(m video.AudioVideoMedia) bool {
+		return m.ID == 15 && *m.Status == video.PENDING && m.FailureReason == ""
+	})).Return(nil)
+
+	output, err := sut.Execute(video_usecase.RetryMediaCommand{VideoId: aVideo.ID, Type: video.VIDEO})
+
+	assert.Nil(t, err)
+	assert.Equal(t, "PENDING", output.Status)
+	assert.Equal(t, 1, output.Attempts)
+	videoGateway.AssertExpectations(t)
+}
+
+func TestRetryMediaThatHasNotFailed(t *testing.T) {
+	videoGateway := new(mocks.VideoGatewayMock)
+	sut := video_usecase.DefaultRetryMediaUseCase{Gateway: videoGateway}
+	aVideo := videoWithMedias()
+
+	videoGateway.On("FindById", aVideo.ID).Return(aVideo, nil)
+
+	output, err := sut.Execute(video_usecase.RetryMediaCommand{VideoId: aVideo.ID, Type: video.TRAILER})
+
+	assert.Nil(t, output)
+	assert.ErrorAs(t, err, &video.InvalidMediaStatusTransitionError{})
+	videoGateway.AssertNumberOfCalls(t, "UpdateMediaStatus", 0)
+}
+
+func TestRetryMissingMedia(t *testing.T) {
+	videoGateway := new(mocks.VideoGatewayMock)
+	sut := video_usecase.DefaultRetryMediaUseCase{Gateway: videoGateway}
+	aVideo := video.NewVideo("title", "desc", 2024, 120.0, true, video.L, nil, nil, nil)
+
+	videoGateway.On("FindById", aVideo.ID).Return(aVideo, nil)
+
+	output, err := sut.Execute(video_usecase.RetryMediaCommand{VideoId: aVideo.ID, Type: video.VIDEO})
+
+	assert.Nil(t, output)
+	assert.ErrorIs(t, err, video.ErrResourceNotFound)
+}
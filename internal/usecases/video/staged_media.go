@@ -0,0 +1,64 @@
+package video_usecase
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/video"
+)
+
+// content stored by a use case is committed once the database references it, or
+// discarded when the use case fails before that
+type stagedMedia struct {
+	gateway video.MediaResourceGateway
+	stored  []stagedResource
+}
+
+type stagedResource struct {
+	location string
+	checksum string
+}
+
+func newStagedMedia(gateway video.MediaResourceGateway) *stagedMedia {
+	return &stagedMedia{gateway: gateway}
+}
+
+func (s *stagedMedia) add(location, checksum string) {
+	s.stored = append(s.stored, stagedResource{location: location, checksum: checksum})
+}
+
+func (s *stagedMedia) commit() error {
+	var err error
+	for _, r := range s.stored {
+		err = errors.Join(err, s.gateway.Commit(r.location, r.checksum))
+	}
+	s.stored = nil
+
+	return err
+}
+
+func (s *stagedMedia) discard(cause error) error {
+	var err error
+	for _, r := range s.stored {
+		err = errors.Join(err, s.gateway.Discard(r.checksum))
+	}
+	s.stored = nil
+
+	if err == nil {
+		return cause
+	}
+
+	return errors.Join(cause, fmt.Errorf("could not discard stored media: %w", err))
+}
+
+// the media is already replaced, content that could not be released is only left behind
+func releaseMedia(gateway video.MediaResourceGateway, checksums ...string) []string {
+	var warnings []string
+	for _, checksum := range checksums {
+		if err := gateway.Release(checksum); err != nil {
+			warnings = append(warnings, fmt.Sprintf("could not release previous media %s: %s", checksum, err.Error()))
+		}
+	}
+
+	return warnings
+}
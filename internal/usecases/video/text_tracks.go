@@ -0,0 +1,209 @@
+package video_usecase
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/video"
+	"github.com.br/gibranct/admin_do_catalogo/pkg/notification"
+)
+
+const maxTextTrackSize = 5 << 20
+
+type UploadTextTrackCommand struct {
+	VideoId  int64
+	Language string
+	Kind     string
+	Resource video.Resource
+}
+
+type GetTextTrackCommand struct {
+	VideoId  int64
+	Language string
+	Kind     video.TextTrackKind
+}
+
+type TextTrackOutput struct {
+	Language string   `json:"language"`
+	Kind     string   `json:"kind"`
+	Name     string   `json:"name"`
+	Checksum string   `json:"checksum"`
+	Cues     int      `json:"cues"`
+	Warnings []string `json:"warnings,omitempty"`
+}
+
+type UploadTextTrackUseCase interface {
+	Execute(c UploadTextTrackCommand) (*TextTrackOutput, error)
+}
+
+type ListTextTracksUseCase interface {
+	Execute(videoId int64) ([]TextTrackOutput, error)
+}
+
+type GetTextTrackUseCase interface {
+	Execute(c GetTextTrackCommand) (*video.Resource, error)
+}
+
+type DefaultUploadTextTrackUseCase struct {
+	Gateway      video.VideoGateway
+	MediaGateway video.MediaResourceGateway
+}
+
+type DefaultListTextTracksUseCase struct {
+	Gateway video.VideoGateway
+}
+
+type DefaultGetTextTrackUseCase struct {
+	Gateway      video.VideoGateway
+	MediaGateway video.MediaResourceGateway
+}
+
+func (useCase DefaultUploadTextTrackUseCase) Execute(command UploadTextTrackCommand) (*TextTrackOutput, error) {
+	if _, err := useCase.Gateway.FindById(command.VideoId); err != nil {
+		return nil, err
+	}
+
+	n := notification.CreateNotification()
+
+	kind, err := video.StringToTextTrackKind(command.Kind)
+	if err != nil {
+		n.Add(err)
+	}
+
+	track := video.NewTextTrack(command.Language, kind)
+	track.Validate(n)
+
+	content, err := readTextTrack(command.Resource)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(content) > maxTextTrackSize {
+		n.Add(fmt.Errorf("'Subtitle' must not exceed %d bytes", maxTextTrackSize))
+		return nil, InvalidMediaError{Notification: n}
+	}
+
+	if err = verifyChecksum(command.Resource, content); err != nil {
+		return nil, err
+	}
+
+	cues := video.ParseSubtitle(content, n)
+
+	if n.HasErrors() {
+		return nil, InvalidMediaError{Notification: n}
+	}
+
+	tracks, err := useCase.Gateway.FindTextTracks(command.VideoId)
+	if err != nil {
+		return nil, err
+	}
+
+	// the stored track is the normalized WebVTT, so the client checksum only applies to what was sent
+	stored, err := useCase.MediaGateway.StoreTextTrack(command.VideoId, *track, video.Resource{
+		Content:     video.RenderWebVTT(cues),
+		ContentType: "text/vtt",
+		Name:        video.WebVTTName(command.Resource.Name, track.Language, track.Kind),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	staged := newStagedMedia(useCase.MediaGateway)
+	staged.add(stored.Location, stored.Checksum)
+	stored.Cues = len(cues)
+
+	if err = useCase.Gateway.SaveTextTrack(command.VideoId, *stored); err != nil {
+		return nil, staged.discard(err)
+	}
+
+	if err = staged.commit(); err != nil {
+		return nil, err
+	}
+
+	output := toTextTrackOutput(*stored)
+	index := slices.IndexFunc(tracks, func(t video.TextTrack) bool { return t.Matches(track.Language, track.Kind) })
+	if index >= 0 && tracks[index].Checksum != stored.Checksum {
+		output.Warnings = releaseMedia(useCase.MediaGateway, tracks[index].Checksum)
+	}
+
+	return &output, nil
+}
+
+func (useCase DefaultListTextTracksUseCase) Execute(videoId int64) ([]TextTrackOutput, error) {
+	if _, err := useCase.Gateway.FindById(videoId); err != nil {
+		return nil, err
+	}
+
+	tracks, err := useCase.Gateway.FindTextTracks(videoId)
+	if err != nil {
+		return nil, err
+	}
+
+	outputs := []TextTrackOutput{}
+	for _, track := range tracks {
+		outputs = append(outputs, toTextTrackOutput(track))
+	}
+
+	return outputs, nil
+}
+
+func (useCase DefaultGetTextTrackUseCase) Execute(command GetTextTrackCommand) (*video.Resource, error) {
+	if _, err := useCase.Gateway.FindById(command.VideoId); err != nil {
+		return nil, err
+	}
+
+	tracks, err := useCase.Gateway.FindTextTracks(command.VideoId)
+	if err != nil {
+		return nil, err
+	}
+
+	index := slices.IndexFunc(tracks, func(t video.TextTrack) bool { return t.Matches(command.Language, command.Kind) })
+	if index < 0 {
+		return nil, video.ErrResourceNotFound
+	}
+
+	resource, err := useCase.MediaGateway.GetTextTrack(command.VideoId, command.Language, command.Kind)
+	if err != nil {
+		return nil, err
+	}
+
+	resource.Checksum = tracks[index].Checksum
+
+	return resource, nil
+}
+
+func readTextTrack(resource video.Resource) ([]byte, error) {
+	if resource.Stream == nil {
+		return resource.Content, nil
+	}
+
+	// one byte past the limit is enough to tell the track is too large
+	return io.ReadAll(io.LimitReader(resource.Stream, maxTextTrackSize+1))
+}
+
+func verifyChecksum(resource video.Resource, content []byte) error {
+	if resource.Checksum == "" {
+		return nil
+	}
+
+	sum := sha256.Sum256(content)
+	if checksum := hex.EncodeToString(sum[:]); checksum != strings.ToLower(resource.Checksum) {
+		return fmt.Errorf("%w for %s: expected %s but got %s", video.ErrChecksumMismatch, resource.Name, resource.Checksum, checksum)
+	}
+
+	return nil
+}
+
+func toTextTrackOutput(track video.TextTrack) TextTrackOutput {
+	return TextTrackOutput{
+		Language: track.Language,
+		Kind:     track.Kind.String(),
+		Name:     track.Name,
+		Checksum: track.Checksum,
+		Cues:     track.Cues,
+	}
+}
@@ -0,0 +1,146 @@
+package video_usecase_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/video"
+	video_usecase "github.com.br/gibranct/admin_do_catalogo/internal/usecases/video"
+	"github.com.br/gibranct/admin_do_catalogo/pkg/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const srtContent = "1\n00:00:01,000 --> 00:00:02,500\nHello\n\n2\n00:00:03,000 --> 00:00:04,000\nWorld\n"
+
+func TestUploadTextTrack(t *testing.T) {
+	videoGateway := new(mocks.VideoGatewayMock)
+	mediaGateway := new(mocks.MediaResourceGatewayMock)
+	sut := video_usecase.DefaultUploadTextTrackUseCase{Gateway: videoGateway, MediaGateway: mediaGateway}
+	aVideo := video.NewVideo("title", "desc", 2024, 120.0, true, video.L, nil, nil, nil)
+	aVideo.ID = 999
+	previous := video.TextTrack{Language: "pt-BR", Kind: video.CAPTIONS, Checksum: "old-checksum"}
+	expectedContent := "WEBVTT\n\n00:00:01.000 --> 00:00:02.500\nHello\n\n00:00:03.000 --> 00:00:04.000\nWorld\n"
+
+	videoGateway.On("FindById", aVideo.ID).Return(aVideo, nil)
+	videoGateway.On("FindTextTracks", aVideo.ID).Return([]video.TextTrack{previous}, nil)
+	mediaGateway.On("StoreTextTrack", aVideo.ID, *video.NewTextTrack("pt-BR", video.CAPTIONS), mock.MatchedBy(func(r video.Resource) bool {
+		return string(r.Content) == expectedContent && r.Name == "movie.vtt"
+	})).Return(&video.TextTrack{
+		Language: "pt-BR",
+		Kind:     video.CAPTIONS,
+		Checksum: "new-checksum",
+		Name:     "movie.vtt",
+		Location: "/subtitle/pt-BR/captions/movie.vtt",
+	}, nil)
+	videoGateway.On("SaveTextTrack", aVideo.ID, mock.MatchedBy(func(track video.TextTrack) bool {
+		return track.Checksum == "new-checksum" && track.Cues == 2
+	})).Return(nil)
+	mediaGateway.On("Commit", "/subtitle/pt-BR/captions/movie.vtt", "new-checksum").Return(nil)
+	mediaGateway.On("Release", "old-checksum").Return(nil)
+
+	output, err := sut.Execute(video_usecase.UploadTextTrackCommand{
+		VideoId:  aVideo.ID,
+		Language: "pt-BR",
+		Kind:     "CAPTIONS",
+		Resource: video.Resource{Stream: strings.NewReader(srtContent), Name: "movie.srt"},
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, video_usecase.TextTrackOutput{
+		Language: "pt-BR",
+		Kind:     "CAPTIONS",
+		Name:     "movie.vtt",
+		Checksum: "new-checksum",
+		Cues:     2,
+	}, *output)
+	videoGateway.AssertExpectations(t)
+	mediaGateway.AssertExpectations(t)
+}
+
+func TestUploadInvalidTextTrack(t *testing.T) {
+	videoGateway := new(mocks.VideoGatewayMock)
+	mediaGateway := new(mocks.MediaResourceGatewayMock)
+	sut := video_usecase.DefaultUploadTextTrackUseCase{Gateway: videoGateway, MediaGateway: mediaGateway}
+	aVideo := video.NewVideo("title", "desc", 2024, 120.0, true, video.L, nil, nil, nil)
+
+	videoGateway.On("FindById", aVideo.ID).Return(aVideo, nil)
+
+	output, err := sut.Execute(video_usecase.UploadTextTrackCommand{
+		VideoId:  aVideo.ID,
+		Language: "portuguese",
+		Kind:     "DUBBED",
+		Resource: video.Resource{Content: []byte("1\n00:00:05,000 --> 00:00:01,000\nHello\n"), Name: "movie.srt"},
+	})
+
+	var invalidErr video_usecase.InvalidMediaError
+	assert.Nil(t, output)
+	assert.ErrorAs(t, err, &invalidErr)
+	assert.Len(t, invalidErr.Notification.GetErrors(), 3)
+	assert.EqualError(t, invalidErr.Notification.GetErrors()[0], "unknown text track kind 'DUBBED'")
+	assert.EqualError(t, invalidErr.Notification.GetErrors()[1], "'language' must be a BCP 47 tag such as 'pt-BR' but got 'portuguese'")
+	assert.EqualError(t, invalidErr.Notification.GetErrors()[2], "cue 1 ends at 00:00:01.000 before it starts at 00:00:05.000")
+	mediaGateway.AssertNumberOfCalls(t, "StoreTextTrack", 0)
+}
+
+func TestUploadTextTrackWithChecksumMismatch(t *testing.T) {
+	videoGateway := new(mocks.VideoGatewayMock)
+	mediaGateway := new(mocks.MediaResourceGatewayMock)
+	sut := video_usecase.DefaultUploadTextTrackUseCase{Gateway: videoGateway, MediaGateway: mediaGateway}
+	aVideo := video.NewVideo("title", "desc", 2024, 120.0, true, video.L, nil, nil, nil)
+
+	videoGateway.On("FindById", aVideo.ID).Return(aVideo, nil)
+
+	output, err := sut.Execute(video_usecase.UploadTextTrackCommand{
+		VideoId:  aVideo.ID,
+		Language: "en",
+		Kind:     "SUBTITLES",
+		Resource: video.Resource{Content: []byte(srtContent), Name: "movie.srt", Checksum: strings.Repeat("0", 64)},
+	})
+
+	assert.Nil(t, output)
+	assert.ErrorIs(t, err, video.ErrChecksumMismatch)
+	mediaGateway.AssertNumberOfCalls(t, "StoreTextTrack", 0)
+}
+
+func TestListTextTracks(t *testing.T) {
+	videoGateway := new(mocks.VideoGatewayMock)
+	sut := video_usecase.DefaultListTextTracksUseCase{Gateway: videoGateway}
+	aVideo := video.NewVideo("title", "desc", 2024, 120.0, true, video.L, nil, nil, nil)
+
+	videoGateway.On("FindById", aVideo.ID).Return(aVideo, nil)
+	videoGateway.On("FindTextTracks", aVideo.ID).Return([]video.TextTrack{
+		{Language: "en", Kind: video.SUBTITLES, Checksum: "sum", Name: "movie.vtt", Cues: 10},
+	}, nil)
+
+	outputs, err := sut.Execute(aVideo.ID)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []video_usecase.TextTrackOutput{
+		{Language: "en", Kind: "SUBTITLES", Name: "movie.vtt", Checksum: "sum", Cues: 10},
+	}, outputs)
+}
+
+func TestGetTextTrack(t *testing.T) {
+	videoGateway := new(mocks.VideoGatewayMock)
+	mediaGateway := new(mocks.MediaResourceGatewayMock)
+	sut := video_usecase.DefaultGetTextTrackUseCase{Gateway: videoGateway, MediaGateway: mediaGateway}
+	aVideo := video.NewVideo("title", "desc", 2024, 120.0, true, video.L, nil, nil, nil)
+	resource := &video.Resource{Stream: strings.NewReader("WEBVTT\n"), ContentType: "text/vtt", Name: "movie.vtt"}
+
+	videoGateway.On("FindById", aVideo.ID).Return(aVideo, nil)
+	videoGateway.On("FindTextTracks", aVideo.ID).Return([]video.TextTrack{
+		{Language: "en", Kind: video.SUBTITLES, Checksum: "sum"},
+	}, nil)
+	mediaGateway.On("GetTextTrack", aVideo.ID, "en", video.SUBTITLES).Return(resource, nil)
+
+	found, err := sut.Execute(video_usecase.GetTextTrackCommand{VideoId: aVideo.ID, Language: "en", Kind: video.SUBTITLES})
+
+	assert.Nil(t, err)
+	assert.Equal(t, "sum", found.Checksum)
+
+	missing, err := sut.Execute(video_usecase.GetTextTrackCommand{VideoId: aVideo.ID, Language: "en", Kind: video.FORCED})
+
+	assert.Nil(t, missing)
+	assert.ErrorIs(t, err, video.ErrResourceNotFound)
+}
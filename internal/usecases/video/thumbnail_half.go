@@ -0,0 +1,49 @@
+package video_usecase
+
+import (
+	"errors"
+	"io"
+
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/video"
+)
+
+func generateThumbnailHalf(
+	aVideo *video.Video,
+	mediaGateway video.MediaResourceGateway,
+	thumbnails video.ThumbnailGenerator,
+	staged *stagedMedia,
+) error {
+	// the thumbnail may only be staged yet, so it is read from its blob
+	thumbnail, err := mediaGateway.GetBlob(aVideo.ThumbNail.Checksum)
+	if err != nil {
+		return err
+	}
+	thumbnail.Name = aVideo.ThumbNail.Name
+
+	if closer, ok := thumbnail.Stream.(io.Closer); ok {
+		defer closer.Close()
+	}
+
+	// a half generated from the previous thumbnail would no longer match it
+	half, err := thumbnails.GenerateHalf(*thumbnail)
+	if errors.Is(err, video.ErrUnsupportedImage) {
+		aVideo.UpdateThumbnailHalfMedia(nil)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	media, err := mediaGateway.StoreImage(aVideo.ID, video.VideoResource{Type: video.THUMBNAIL_HALF, Resource: *half})
+	if err != nil {
+		return err
+	}
+	staged.add(media.Location, media.Checksum)
+
+	media.Generated = true
+	if aVideo.ThumbNailHalf != nil {
+		media.ID = aVideo.ThumbNailHalf.ID
+	}
+	aVideo.UpdateThumbnailHalfMedia(media)
+	return nil
+}
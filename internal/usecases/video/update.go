@@ -0,0 +1,93 @@
+package video_usecase
+
+import (
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/castmember"
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/category"
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/genre"
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/video"
+	"github.com.br/gibranct/admin_do_catalogo/pkg/notification"
+)
+
+type UpdateVideoCommand struct {
+	ID          int64
+	Title       string
+	Description string
+	LaunchedAt  int
+	Duration    float64
+	Opened      bool
+	Rating      string
+	CategoryIds []int64
+	GenreIds    []int64
+	MemberIds   []int64
+	Ratings     []RatingCommand
+	Descriptors []string
+}
+
+type UpdateVideoUseCase interface {
+	Execute(c UpdateVideoCommand) *notification.Notification
+}
+
+type DefaultUpdateVideoUseCase struct {
+	Gateway           video.VideoGateway
+	CategoryGateway   category.CategoryGateway
+	GenreGateway      genre.GenreGateway
+	CastMemberGateway castmember.CastMemberGateway
+}
+
+func (useCase DefaultUpdateVideoUseCase) Execute(
+	command UpdateVideoCommand,
+) *notification.Notification {
+
+	n := notification.CreateNotification()
+
+	aVideo, err := useCase.Gateway.FindById(command.ID)
+
+	if err != nil {
+		n.Add(err)
+		return n
+	}
+
+	rating, err := video.StringToRating(command.Rating)
+
+	if err != nil {
+		n.Add(err)
+		return n
+	}
+
+	aVideo.Update(
+		command.Title,
+		command.Description,
+		command.LaunchedAt,
+		command.Duration,
+		command.Opened,
+		rating,
+		command.CategoryIds,
+		command.GenreIds,
+		command.MemberIds,
+	)
+	aVideo.UpdateRatings(toTerritoryRatings(command.Ratings, n))
+	aVideo.UpdateDescriptors(toContentDescriptors(command.Descriptors, n))
+
+	aVideo.Validate(n)
+
+	if n.HasErrors() {
+		return n
+	}
+
+	n.Append(validateAggregate("categories", command.CategoryIds, useCase.CategoryGateway.ExistsByIds))
+	n.Append(validateAggregate("genres", command.GenreIds, useCase.GenreGateway.ExistsByIds))
+	n.Append(validateAggregate("cast members", command.MemberIds, useCase.CastMemberGateway.ExistsByIds))
+
+	if n.HasErrors() {
+		return n
+	}
+
+	_, err = useCase.Gateway.Update(*aVideo)
+
+	if err != nil {
+		n.Add(err)
+		return n
+	}
+
+	return nil
+}
@@ -0,0 +1,136 @@
+package video_usecase
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/encoding"
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/video"
+	"github.com.br/gibranct/admin_do_catalogo/pkg/notification"
+)
+
+type UpdateMediaStatusCommand struct {
+	VideoId       int64
+	ResourceId    string
+	Status        string
+	EncodedPath   string
+	FailureReason string
+	Renditions    []RenditionCommand
+}
+
+type UpdateMediaStatusUseCase interface {
+	Execute(c UpdateMediaStatusCommand) error
+}
+
+type DefaultUpdateMediaStatusUseCase struct {
+	Gateway  video.VideoGateway
+	Profiles encoding.ProfileGateway
+}
+
+func (useCase DefaultUpdateMediaStatusUseCase) Execute(command UpdateMediaStatusCommand) error {
+	status, err := video.StringToMediaStatus(command.Status)
+	if err != nil {
+		return err
+	}
+
+	renditions := toRenditions(command.Renditions)
+	if status == video.COMPLETED {
+		n := notification.CreateNotification()
+		video.ValidateRenditions(renditions, n)
+		if n.HasErrors() {
+			return InvalidMediaError{Notification: n}
+		}
+	}
+
+	aVideo, err := useCase.Gateway.FindById(command.VideoId)
+	if err != nil {
+		return err
+	}
+
+	if track, found := audioTrackOf(aVideo, command.ResourceId); found {
+		media, err := track.Media.Encoded(status, command.EncodedPath, command.FailureReason, renditions...)
+		if err != nil {
+			return err
+		}
+		return useCase.Gateway.UpdateMediaStatus(*media)
+	}
+
+	if extra, found := extraOf(aVideo, command.ResourceId); found {
+		media, err := extra.Media.Encoded(status, command.EncodedPath, command.FailureReason, renditions...)
+		if err != nil {
+			return err
+		}
+		return useCase.Gateway.UpdateMediaStatus(*media)
+	}
+
+	aType, found := mediaTypeOf(aVideo, command.ResourceId)
+	if !found {
+		return fmt.Errorf("%w: video %d has no audio/video media %s", video.ErrResourceNotFound, aVideo.ID, command.ResourceId)
+	}
+
+	switch status {
+	case video.PROCESSING:
+		err = aVideo.Processing(aType)
+	case video.COMPLETED:
+		err = useCase.complete(aVideo, aType, command.EncodedPath, renditions)
+	case video.FAILED:
+		err = aVideo.Failed(aType, command.FailureReason)
+	default:
+		return fmt.Errorf("%w to %s", video.ErrUnsupportedEncoderStatus, status)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	media, err := aVideo.AudioVideoMedia(aType)
+	if err != nil {
+		return err
+	}
+
+	return useCase.Gateway.UpdateMediaStatus(*media)
+}
+
+// a VIDEO media missing renditions of its profile fails instead, so it can be retried
+func (useCase DefaultUpdateMediaStatusUseCase) complete(aVideo *video.Video, aType video.VideoMediaType, encodedPath string, renditions []video.Rendition) error {
+	if aType == video.VIDEO {
+		reason, err := missingRenditions(useCase.Profiles, aVideo.Video.ID, renditions)
+		if err != nil {
+			return err
+		}
+
+		if reason != "" {
+			return aVideo.Failed(aType, reason)
+		}
+	}
+
+	return aVideo.Completed(aType, encodedPath, renditions...)
+}
+
+func mediaTypeOf(aVideo *video.Video, resourceId string) (video.VideoMediaType, bool) {
+	if aVideo.Video != nil && strconv.FormatInt(aVideo.Video.ID, 10) == resourceId {
+		return video.VIDEO, true
+	}
+
+	if aVideo.Trailer != nil && strconv.FormatInt(aVideo.Trailer.ID, 10) == resourceId {
+		return video.TRAILER, true
+	}
+
+	return 0, false
+}
+
+func audioTrackOf(aVideo *video.Video, resourceId string) (*video.AudioTrack, bool) {
+	mediaId, err := strconv.ParseInt(resourceId, 10, 64)
+	if err != nil {
+		return nil, false
+	}
+	return aVideo.FindAudioTrackByMediaId(mediaId)
+}
+
+func extraOf(aVideo *video.Video, resourceId string) (*video.Extra, bool) {
+	mediaId, err := strconv.ParseInt(resourceId, 10, 64)
+	if err != nil {
+		return nil, false
+	}
+	return aVideo.FindExtraByMediaId(mediaId)
+}
@@ -0,0 +1,201 @@
+package video_usecase_test
+
+import (
+	"testing"
+
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/video"
+	video_usecase "github.com.br/gibranct/admin_do_catalogo/internal/usecases/video"
+	"github.com.br/gibranct/admin_do_catalogo/pkg/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func videoWithMedias() *video.Video {
+	aVideo := video.NewVideo("title", "desc", 2024, 120.0, true, video.L, nil, nil, nil)
+	aVideo.ID = 999
+	videoStatus := video.PENDING
+	trailerStatus := video.PROCESSING
+	aVideo.UpdateVideoMedia(video.NewAudioVideoMediaWith(15, &videoStatus, "sum", "movie.mp4", "/movie.mp4", ""))
+	aVideo.UpdateTrailerMedia(video.NewAudioVideoMediaWith(16, &trailerStatus, "sum", "trailer.mp4", "/trailer.mp4", ""))
+	return aVideo
+}
+
+func TestUpdateMediaStatusToProcessing(t *testing.T) {
+	videoGateway := new(mocks.VideoGatewayMock)
+	sut := video_usecase.DefaultUpdateMediaStatusUseCase{Gateway: videoGateway}
+	aVideo := videoWithMedias()
+
+	videoGateway.On("FindById", aVideo.ID).Return(aVideo, nil)
+	videoGateway.On("UpdateMediaStatus", mock.MatchedBy(func(m video.AudioVideoMedia) bool {
+		return m.ID == 15 && *m.Status == video.PROCESSING && m.EncodedLocation == ""
+	})).Return(nil)
+
+	err := sut.Execute(video_usecase.UpdateMediaStatusCommand{
+		VideoId:    aVideo.ID,
+		ResourceId: "15",
+		Status:     "PROCESSING",
+	})
+
+	assert.Nil(t, err)
+	videoGateway.AssertExpectations(t)
+}
+
+func TestUpdateMediaStatusToCompleted(t *testing.T) {
+	videoGateway := new(mocks.VideoGatewayMock)
+	sut := video_usecase.DefaultUpdateMediaStatusUseCase{Gateway: videoGateway}
+	aVideo := videoWithMedias()
+
+	videoGateway.On("FindById", aVideo.ID).Return(aVideo, nil)
+	videoGateway.On("UpdateMediaStatus", mock.MatchedBy(func(m video.AudioVideoMedia) bool {
+		return m.ID == 16 && *m.Status == video.COMPLETED && m.EncodedLocation == "/encoded/trailer"
+	})).Return(nil)
+
+	err := sut.Execute(video_usecase.UpdateMediaStatusCommand{
+		VideoId:     aVideo.ID,
+		ResourceId:  "16",
+		Status:      "COMPLETED",
+		EncodedPath: "/encoded/trailer",
+	})
+
+	assert.Nil(t, err)
+	videoGateway.AssertExpectations(t)
+}
+
+func TestUpdateMediaStatusForUnknownResource(t *testing.T) {
+	videoGateway := new(mocks.VideoGatewayMock)
+	sut := video_usecase.DefaultUpdateMediaStatusUseCase{Gateway: videoGateway}
+	aVideo := videoWithMedias()
+
+	videoGateway.On("FindById", aVideo.ID).Return(aVideo, nil)
+
+	err := sut.Execute(video_usecase.UpdateMediaStatusCommand{
+		VideoId:    aVideo.ID,
+		ResourceId: "77",
+		Status:     "COMPLETED",
+	})
+
+	assert.ErrorIs(t, err, video.ErrResourceNotFound)
+	videoGateway.AssertNumberOfCalls(t, "UpdateMediaStatus", 0)
+}
+
+func TestUpdateMediaStatusWithInvalidStatus(t *testing.T) {
+	videoGateway := new(mocks.VideoGatewayMock)
+	sut := video_usecase.DefaultUpdateMediaStatusUseCase{Gateway: videoGateway}
+
+	err := sut.Execute(video_usecase.UpdateMediaStatusCommand{VideoId: 1, ResourceId: "15", Status: "DONE"})
+	assert.EqualError(t, err, "unknown media status")
+
+	aVideo := videoWithMedias()
+	videoGateway.On("FindById", aVideo.ID).Return(aVideo, nil)
+
+	err = sut.Execute(video_usecase.UpdateMediaStatusCommand{VideoId: aVideo.ID, ResourceId: "15", Status: "PENDING"})
+	assert.EqualError(t, err, "encoder cannot move a media to PENDING")
+	videoGateway.AssertNumberOfCalls(t, "UpdateMediaStatus", 0)
+}
+
+func TestUpdateMediaStatusToFailed(t *testing.T) {
+	videoGateway := new(mocks.VideoGatewayMock)
+	sut := video_usecase.DefaultUpdateMediaStatusUseCase{Gateway: videoGateway}
+	aVideo := videoWithMedias()
+
+	videoGateway.On("FindById", aVideo.ID).Return(aVideo, nil)
+	videoGateway.On("UpdateMediaStatus", mock.MatchedBy(func(m video.AudioVideoMedia) bool {
+		return m.ID == 16 && *m.Status == video.FAILED && m.FailureReason == "corrupted stream"
+	})).Return(nil)
+
+	err := sut.Execute(video_usecase.UpdateMediaStatusCommand{
+		VideoId:       aVideo.ID,
+		ResourceId:    "16",
+		Status:        "FAILED",
+		FailureReason: "corrupted stream",
+	})
+
+	assert.Nil(t, err)
+	videoGateway.AssertExpectations(t)
+}
+
+func TestUpdateMediaStatusWithInvalidTransition(t *testing.T) {
+	videoGateway := new(mocks.VideoGatewayMock)
+	sut := video_usecase.DefaultUpdateMediaStatusUseCase{Gateway: videoGateway}
+	aVideo := videoWithMedias()
+	aVideo.Completed(video.TRAILER, "/encoded/trailer")
+
+	videoGateway.On("FindById", aVideo.ID).Return(aVideo, nil)
+
+	err := sut.Execute(video_usecase.UpdateMediaStatusCommand{
+		VideoId:    aVideo.ID,
+		ResourceId: "16",
+		Status:     "PROCESSING",
+	})
+
+	assert.ErrorAs(t, err, &video.InvalidMediaStatusTransitionError{})
+	videoGateway.AssertNumberOfCalls(t, "UpdateMediaStatus", 0)
+}
+
+func TestUpdateAudioTrackMediaStatus(t *testing.T) {
+	videoGateway := new(mocks.VideoGatewayMock)
+	sut := video_usecase.DefaultUpdateMediaStatusUseCase{Gateway: videoGateway}
+	aVideo := videoWithMedias()
+	status := video.PROCESSING
+	aVideo.UpdateAudioTrack(*video.NewAudioTrack("es", video.DUB, video.NewAudioVideoMediaWith(30, &status, "sum", "es.mp4", "/es.mp4", "")))
+
+	videoGateway.On("FindById", aVideo.ID).Return(aVideo, nil)
+	videoGateway.On("UpdateMediaStatus", mock.MatchedBy(func(m video.AudioVideoMedia) bool {
+		return m.ID == 30 && *m.Status == video.COMPLETED && m.EncodedLocation == "/encoded/es"
+	})).Return(nil)
+
+	err := sut.Execute(video_usecase.UpdateMediaStatusCommand{
+		VideoId:     aVideo.ID,
+		ResourceId:  "30",
+		Status:      "COMPLETED",
+		EncodedPath: "/encoded/es",
+	})
+
+	assert.Nil(t, err)
+	videoGateway.AssertExpectations(t)
+}
+
+func TestUpdateMediaStatusRecordsTheRenditions(t *testing.T) {
+	videoGateway := new(mocks.VideoGatewayMock)
+	sut := video_usecase.DefaultUpdateMediaStatusUseCase{Gateway: videoGateway}
+	aVideo := videoWithMedias()
+
+	videoGateway.On("FindById", aVideo.ID).Return(aVideo, nil)
+	videoGateway.On("UpdateMediaStatus", mock.MatchedBy(func(m video.AudioVideoMedia) bool {
+		return m.ID == 16 && len(m.Renditions) == 1 && m.Renditions[0].Resolution() == "1280x720"
+	})).Return(nil)
+
+	err := sut.Execute(video_usecase.UpdateMediaStatusCommand{
+		VideoId:     aVideo.ID,
+		ResourceId:  "16",
+		Status:      "COMPLETED",
+		EncodedPath: "/encoded/trailer",
+		Renditions: []video_usecase.RenditionCommand{
+			{Bandwidth: 3_000_000, Width: 1280, Height: 720, Codecs: []string{"avc1.64001f"}, Location: "/encoded/trailer/720p", SegmentDuration: 4},
+		},
+	})
+
+	assert.Nil(t, err)
+	videoGateway.AssertExpectations(t)
+}
+
+func TestUpdateMediaStatusWithInvalidRenditions(t *testing.T) {
+	videoGateway := new(mocks.VideoGatewayMock)
+	sut := video_usecase.DefaultUpdateMediaStatusUseCase{Gateway: videoGateway}
+
+	err := sut.Execute(video_usecase.UpdateMediaStatusCommand{
+		VideoId:     999,
+		ResourceId:  "16",
+		Status:      "COMPLETED",
+		EncodedPath: "/encoded/trailer",
+		Renditions: []video_usecase.RenditionCommand{
+			{Bandwidth: 3_000_000, Width: 1280, Height: 720, Codecs: []string{"avc1.64001f"}, SegmentDuration: 4},
+		},
+	})
+
+	var invalidErr video_usecase.InvalidMediaError
+	assert.ErrorAs(t, err, &invalidErr)
+	assert.Len(t, invalidErr.Notification.GetErrors(), 1)
+	assert.EqualError(t, invalidErr.Notification.GetErrors()[0], "rendition 0: 'location' should not be null or empty")
+	videoGateway.AssertNotCalled(t, "FindById", mock.Anything)
+}
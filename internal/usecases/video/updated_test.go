@@ -0,0 +1,188 @@
+package video_usecase_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/video"
+	video_usecase "github.com.br/gibranct/admin_do_catalogo/internal/usecases/video"
+	"github.com.br/gibranct/admin_do_catalogo/pkg/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func dummyUpdateVideoCommand() video_usecase.UpdateVideoCommand {
+	return video_usecase.UpdateVideoCommand{
+		ID:          999,
+		Title:       "new title",
+		Description: "new desc",
+		LaunchedAt:  2020,
+		Duration:    90.0,
+		Opened:      false,
+		Rating:      "16",
+		CategoryIds: []int64{78, 45},
+		GenreIds:    []int64{39},
+		MemberIds:   []int64{55},
+		Ratings: []video_usecase.RatingCommand{
+			{System: "ClassInd", Territory: "BR", Value: "16"},
+			{System: "MPAA", Territory: "US", Value: "R"},
+		},
+	}
+}
+
+func TestUpdateVideo(t *testing.T) {
+	videoGateway := new(mocks.VideoGatewayMock)
+	categoryGateway := new(mocks.CategoryGatewayMock)
+	genreGateway := new(mocks.GenreGatewayMock)
+	castGateway := new(mocks.CastMemberGatewayMock)
+	sut := video_usecase.DefaultUpdateVideoUseCase{
+		Gateway:           videoGateway,
+		CategoryGateway:   categoryGateway,
+		GenreGateway:      genreGateway,
+		CastMemberGateway: castGateway,
+	}
+	command := dummyUpdateVideoCommand()
+	ids := []int64{78}
+	aVideo := video.NewVideo("title", "desc", 2024, 120.0, true, video.L, ids, ids, ids)
+	aVideo.ID = command.ID
+
+	videoGateway.On("FindById", command.ID).Return(aVideo, nil)
+	categoryGateway.On("ExistsByIds", command.CategoryIds).Return(command.CategoryIds, nil)
+	genreGateway.On("ExistsByIds", command.GenreIds).Return(command.GenreIds, nil)
+	castGateway.On("ExistsByIds", command.MemberIds).Return(command.MemberIds, nil)
+	videoGateway.On("Update", mock.MatchedBy(func(v video.Video) bool {
+		return v.ID == command.ID &&
+			v.Title == command.Title &&
+			v.Rating == video.AGE_16 &&
+			len(v.CategoryIds) == 2 &&
+			len(v.Ratings) == 2 && v.Ratings[1] == video.NewTerritoryRating(video.MPAA, "US", "R")
+	})).Return(aVideo, nil)
+
+	noti := sut.Execute(command)
+
+	assert.Nil(t, noti)
+	videoGateway.AssertExpectations(t)
+	videoGateway.AssertNumberOfCalls(t, "Update", 1)
+	categoryGateway.AssertNumberOfCalls(t, "ExistsByIds", 1)
+	genreGateway.AssertNumberOfCalls(t, "ExistsByIds", 1)
+	castGateway.AssertNumberOfCalls(t, "ExistsByIds", 1)
+}
+
+func TestUpdateVideoWhenVideoIsNotFound(t *testing.T) {
+	videoGateway := new(mocks.VideoGatewayMock)
+	sut := video_usecase.DefaultUpdateVideoUseCase{
+		Gateway: videoGateway,
+	}
+	command := dummyUpdateVideoCommand()
+
+	videoGateway.On("FindById", command.ID).Return(&video.Video{}, video.ErrVideoNotFound)
+
+	noti := sut.Execute(command)
+
+	assert.NotNil(t, noti)
+	assert.Len(t, noti.GetErrors(), 1)
+	assert.Equal(t, video.ErrVideoNotFound, noti.GetErrors()[0])
+	videoGateway.AssertNotCalled(t, "Update", mock.Anything)
+}
+
+func TestUpdateVideoWithMissingIds(t *testing.T) {
+	videoGateway := new(mocks.VideoGatewayMock)
+	categoryGateway := new(mocks.CategoryGatewayMock)
+	genreGateway := new(mocks.GenreGatewayMock)
+	castGateway := new(mocks.CastMemberGatewayMock)
+	sut := video_usecase.DefaultUpdateVideoUseCase{
+		Gateway:           videoGateway,
+		CategoryGateway:   categoryGateway,
+		GenreGateway:      genreGateway,
+		CastMemberGateway: castGateway,
+	}
+	command := dummyUpdateVideoCommand()
+	ids := []int64{78}
+	aVideo := video.NewVideo("title", "desc", 2024, 120.0, true, video.L, ids, ids, ids)
+	aVideo.ID = command.ID
+
+	videoGateway.On("FindById", command.ID).Return(aVideo, nil)
+	categoryGateway.On("ExistsByIds", command.CategoryIds).Return([]int64{78}, nil)
+	genreGateway.On("ExistsByIds", command.GenreIds).Return([]int64{}, nil)
+	castGateway.On("ExistsByIds", command.MemberIds).Return(command.MemberIds, nil)
+
+	noti := sut.Execute(command)
+
+	assert.NotNil(t, noti)
+	assert.Len(t, noti.GetErrors(), 2)
+	assert.Equal(t, "missing categories ids: 45", noti.GetErrors()[0].Error())
+	assert.Equal(t, "missing genres ids: 39", noti.GetErrors()[1].Error())
+	videoGateway.AssertNotCalled(t, "Update", mock.Anything)
+}
+
+func TestUpdateVideoWhenGatewayFails(t *testing.T) {
+	videoGateway := new(mocks.VideoGatewayMock)
+	categoryGateway := new(mocks.CategoryGatewayMock)
+	genreGateway := new(mocks.GenreGatewayMock)
+	castGateway := new(mocks.CastMemberGatewayMock)
+	sut := video_usecase.DefaultUpdateVideoUseCase{
+		Gateway:           videoGateway,
+		CategoryGateway:   categoryGateway,
+		GenreGateway:      genreGateway,
+		CastMemberGateway: castGateway,
+	}
+	command := dummyUpdateVideoCommand()
+	command.CategoryIds = nil
+	command.GenreIds = nil
+	command.MemberIds = nil
+	aVideo := video.NewVideo("title", "desc", 2024, 120.0, true, video.L, nil, nil, nil)
+	aVideo.ID = command.ID
+	expectedErr := errors.New("failed to update video")
+
+	videoGateway.On("FindById", command.ID).Return(aVideo, nil)
+	videoGateway.On("Update", mock.Anything).Return(&video.Video{}, expectedErr)
+
+	noti := sut.Execute(command)
+
+	assert.NotNil(t, noti)
+	assert.Len(t, noti.GetErrors(), 1)
+	assert.Equal(t, expectedErr, noti.GetErrors()[0])
+}
+
+func TestUpdateVideoWithInvalidRatings(t *testing.T) {
+	videoGateway := new(mocks.VideoGatewayMock)
+	sut := video_usecase.DefaultUpdateVideoUseCase{
+		Gateway: videoGateway,
+	}
+	command := dummyUpdateVideoCommand()
+	command.Ratings = []video_usecase.RatingCommand{
+		{System: "ESRB", Territory: "US", Value: "M"},
+		{System: "BBFC", Territory: "GB", Value: "PG-13"},
+	}
+	aVideo := video.NewVideo("title", "desc", 2024, 120.0, true, video.L, nil, nil, nil)
+
+	videoGateway.On("FindById", command.ID).Return(aVideo, nil)
+
+	noti := sut.Execute(command)
+
+	assert.Len(t, noti.GetErrors(), 2)
+	assert.EqualError(t, noti.GetErrors()[0], "unknown rating system 'ESRB'")
+	assert.EqualError(t, noti.GetErrors()[1], "'PG-13' is not a valid BBFC rating")
+	videoGateway.AssertNotCalled(t, "Update", mock.Anything)
+}
+
+func TestUpdateVideoWithDescriptorsAboveRating(t *testing.T) {
+	videoGateway := new(mocks.VideoGatewayMock)
+	sut := video_usecase.DefaultUpdateVideoUseCase{
+		Gateway: videoGateway,
+	}
+	command := dummyUpdateVideoCommand()
+	command.Rating = "Livre"
+	command.Ratings = nil
+	command.Descriptors = []string{"GAMBLING", "EXPLICIT_SEX"}
+	aVideo := video.NewVideo("title", "desc", 2024, 120.0, true, video.L, nil, nil, nil)
+
+	videoGateway.On("FindById", command.ID).Return(aVideo, nil)
+
+	noti := sut.Execute(command)
+
+	assert.Len(t, noti.GetErrors(), 2)
+	assert.EqualError(t, noti.GetErrors()[0], "unknown content descriptor 'GAMBLING'")
+	assert.EqualError(t, noti.GetErrors()[1], "'Livre' rating cannot carry the EXPLICIT_SEX descriptor, it requires '18' or higher")
+	videoGateway.AssertNotCalled(t, "Update", mock.Anything)
+}
@@ -0,0 +1,185 @@
+package video_usecase
+
+import (
+	"fmt"
+
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/video"
+	"github.com.br/gibranct/admin_do_catalogo/pkg/notification"
+)
+
+// media that needs more than a type to be addressed, each with its own endpoint
+var trackMediaTypes = map[video.VideoMediaType]string{
+	video.SUBTITLE: "a text track with a language and kind",
+	video.AUDIO:    "an audio track with a language and role",
+	video.ARTWORK:  "an artwork with a role and language",
+}
+
+type UploadMediaCommand struct {
+	VideoId  int64
+	Type     video.VideoMediaType
+	Resource video.Resource
+}
+
+type UploadMediaOutput struct {
+	VideoId   int64    `json:"videoId"`
+	MediaType string   `json:"mediaType"`
+	Warnings  []string `json:"warnings,omitempty"`
+}
+
+type UploadMediaUseCase interface {
+	Execute(c UploadMediaCommand) (*UploadMediaOutput, error)
+}
+
+type DefaultUploadMediaUseCase struct {
+	Gateway           video.VideoGateway
+	MediaGateway      video.MediaResourceGateway
+	Thumbnails        video.ThumbnailGenerator
+	MetadataExtractor video.MediaMetadataExtractor
+	Encoding          EncodingJobs
+}
+
+func (useCase DefaultUploadMediaUseCase) Execute(command UploadMediaCommand) (*UploadMediaOutput, error) {
+	aVideo, err := useCase.Gateway.FindById(command.VideoId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	n := notification.CreateNotification()
+	if track, ok := trackMediaTypes[command.Type]; ok {
+		n.Add(fmt.Errorf("'%s' media must be uploaded as %s", command.Type, track))
+		return nil, InvalidMediaError{Notification: n}
+	}
+
+	if err = validateMedia(command.Type, &command.Resource, useCase.MediaGateway, n); err != nil {
+		return nil, err
+	}
+
+	if n.HasErrors() {
+		return nil, InvalidMediaError{Notification: n}
+	}
+
+	affected := []video.VideoMediaType{command.Type}
+	generateHalf := command.Type == video.THUMBNAIL && useCase.Thumbnails != nil && aVideo.HasGeneratedThumbnailHalf()
+	if generateHalf {
+		affected = append(affected, video.THUMBNAIL_HALF)
+	}
+
+	previousChecksums := make(map[video.VideoMediaType]string)
+	for _, aType := range affected {
+		if checksum, ok := storedChecksum(aVideo, aType); ok {
+			previousChecksums[aType] = checksum
+		}
+	}
+
+	resource := video.VideoResource{
+		Type:     command.Type,
+		Resource: command.Resource,
+	}
+
+	staged := newStagedMedia(useCase.MediaGateway)
+
+	switch command.Type {
+	case video.VIDEO, video.TRAILER:
+		err = useCase.storeAudioVideo(aVideo, resource, staged)
+	default:
+		err = useCase.storeImage(aVideo, resource, staged)
+	}
+
+	if err != nil {
+		return nil, staged.discard(err)
+	}
+
+	if generateHalf {
+		if err = generateThumbnailHalf(aVideo, useCase.MediaGateway, useCase.Thumbnails, staged); err != nil {
+			return nil, staged.discard(err)
+		}
+	}
+
+	if aVideo, err = useCase.Gateway.Update(*aVideo); err != nil {
+		return nil, staged.discard(err)
+	}
+
+	if err = staged.commit(); err != nil {
+		return nil, err
+	}
+
+	released := []string{}
+	for aType, previousChecksum := range previousChecksums {
+		if checksum, _ := storedChecksum(aVideo, aType); checksum != previousChecksum {
+			released = append(released, previousChecksum)
+		}
+	}
+
+	output := &UploadMediaOutput{
+		VideoId:   aVideo.ID,
+		MediaType: command.Type.String(),
+		Warnings:  releaseMedia(useCase.MediaGateway, released...),
+	}
+
+	if command.Type == video.VIDEO {
+		output.Warnings = append(output.Warnings, durationWarnings(aVideo)...)
+
+		// the upload is kept, the media is left FAILED to be retried
+		if err = useCase.Encoding.request(useCase.Gateway, aVideo); err != nil {
+			output.Warnings = append(output.Warnings, err.Error())
+		}
+	}
+
+	return output, nil
+}
+
+func (useCase DefaultUploadMediaUseCase) storeAudioVideo(aVideo *video.Video, resource video.VideoResource, staged *stagedMedia) error {
+	media, err := useCase.MediaGateway.StoreAudioVideo(aVideo.ID, resource)
+
+	if err != nil {
+		return err
+	}
+	staged.add(media.RawLocation, media.Checksum)
+
+	if useCase.MetadataExtractor != nil {
+		media.Metadata, err = extractMetadata(media.Checksum, useCase.MediaGateway, useCase.MetadataExtractor)
+		if err != nil {
+			return err
+		}
+	}
+
+	// a replaced file gets a new media, so renditions, the encoding profile and late encoder
+	// results of the previous one are never attached to it
+	if resource.Type == video.VIDEO {
+		aVideo.UpdateVideoMedia(media)
+		return nil
+	}
+
+	aVideo.UpdateTrailerMedia(media)
+	return nil
+}
+
+func (useCase DefaultUploadMediaUseCase) storeImage(aVideo *video.Video, resource video.VideoResource, staged *stagedMedia) error {
+	media, err := useCase.MediaGateway.StoreImage(aVideo.ID, resource)
+
+	if err != nil {
+		return err
+	}
+	staged.add(media.Location, media.Checksum)
+
+	switch resource.Type {
+	case video.BANNER:
+		if aVideo.Banner != nil {
+			media.ID = aVideo.Banner.ID
+		}
+		aVideo.UpdateBannerMedia(media)
+	case video.THUMBNAIL:
+		if aVideo.ThumbNail != nil {
+			media.ID = aVideo.ThumbNail.ID
+		}
+		aVideo.UpdateThumbnailMedia(media)
+	case video.THUMBNAIL_HALF:
+		if aVideo.ThumbNailHalf != nil {
+			media.ID = aVideo.ThumbNailHalf.ID
+		}
+		aVideo.UpdateThumbnailHalfMedia(media)
+	}
+
+	return nil
+}
@@ -0,0 +1,527 @@
+package video_usecase_test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/video"
+	video_usecase "github.com.br/gibranct/admin_do_catalogo/internal/usecases/video"
+	"github.com.br/gibranct/admin_do_catalogo/pkg/mocks"
+	"github.com.br/gibranct/admin_do_catalogo/pkg/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestUploadVideoMedia(t *testing.T) {
+	videoGateway := new(mocks.VideoGatewayMock)
+	mediaGateway := new(mocks.MediaResourceGatewayMock)
+	sut := video_usecase.DefaultUploadMediaUseCase{
+		Gateway:      videoGateway,
+		MediaGateway: mediaGateway,
+	}
+	aVideo := video.NewVideo("title", "desc", 2024, 120.0, true, video.L, nil, nil, nil)
+	aVideo.ID = 999
+	oldStatus := video.COMPLETED
+	aVideo.UpdateVideoMedia(video.NewAudioVideoMediaWith(15, &oldStatus, "old", "old.mp4", "/old.mp4", "/encoded"))
+	status := video.PENDING
+	media := video.NewAudioVideoMediaWith(0, &status, "checksum", "movie.mp4", "/videos/999/video/movie.mp4", "")
+	command := video_usecase.UploadMediaCommand{
+		VideoId:  aVideo.ID,
+		Type:     video.VIDEO,
+		Resource: video.Resource{Name: "movie.mp4", Content: test.DummyMP4("video")},
+	}
+
+	videoGateway.On("FindById", aVideo.ID).Return(aVideo, nil)
+	mediaGateway.On("StoreAudioVideo", aVideo.ID, video.VideoResource{Type: video.VIDEO, Resource: command.Resource}).
+		Return(media, nil)
+	videoGateway.On("Update", mock.MatchedBy(func(v video.Video) bool {
+		return v.Video.ID == 0 && v.Video.Checksum == "checksum" && *v.Video.Status == video.PENDING
+	})).Return(aVideo, nil)
+	mediaGateway.On("Commit", "/videos/999/video/movie.mp4", "checksum").Return(nil)
+	mediaGateway.On("Release", "old").Return(nil)
+
+	output, err := sut.Execute(command)
+
+	assert.Nil(t, err)
+	assert.Equal(t, aVideo.ID, output.VideoId)
+	assert.Equal(t, "Video", output.MediaType)
+	videoGateway.AssertExpectations(t)
+	mediaGateway.AssertExpectations(t)
+}
+
+func TestUploadImageMedia(t *testing.T) {
+	tests := []struct {
+		aType  video.VideoMediaType
+		target func(v video.Video) *video.ImageMedia
+	}{
+		{video.BANNER, func(v video.Video) *video.ImageMedia { return v.Banner }},
+		{video.THUMBNAIL, func(v video.Video) *video.ImageMedia { return v.ThumbNail }},
+		{video.THUMBNAIL_HALF, func(v video.Video) *video.ImageMedia { return v.ThumbNailHalf }},
+	}
+	content := test.DummyPNG(1280, 720)
+
+	for _, test := range tests {
+		videoGateway := new(mocks.VideoGatewayMock)
+		mediaGateway := new(mocks.MediaResourceGatewayMock)
+		sut := video_usecase.DefaultUploadMediaUseCase{
+			Gateway:      videoGateway,
+			MediaGateway: mediaGateway,
+		}
+		aVideo := video.NewVideo("title", "desc", 2024, 120.0, true, video.L, nil, nil, nil)
+		aVideo.ID = 999
+		media := video.NewImageMediaWithoutId("checksum", "image.png", "/image.png")
+
+		videoGateway.On("FindById", aVideo.ID).Return(aVideo, nil)
+		mediaGateway.On("StoreImage", aVideo.ID, mock.Anything).Return(media, nil)
+		videoGateway.On("Update", mock.MatchedBy(func(v video.Video) bool {
+			return test.target(v) == media
+		})).Return(aVideo, nil)
+		mediaGateway.On("Commit", "/image.png", "checksum").Return(nil)
+
+		output, err := sut.Execute(video_usecase.UploadMediaCommand{
+			VideoId:  aVideo.ID,
+			Type:     test.aType,
+			Resource: video.Resource{Name: "image.png", Content: content},
+		})
+
+		assert.Nil(t, err)
+		assert.Equal(t, test.aType.String(), output.MediaType)
+		videoGateway.AssertExpectations(t)
+		mediaGateway.AssertExpectations(t)
+	}
+}
+
+func TestUploadMediaWhenVideoIsNotFound(t *testing.T) {
+	videoGateway := new(mocks.VideoGatewayMock)
+	mediaGateway := new(mocks.MediaResourceGatewayMock)
+	sut := video_usecase.DefaultUploadMediaUseCase{
+		Gateway:      videoGateway,
+		MediaGateway: mediaGateway,
+	}
+
+	videoGateway.On("FindById", int64(999)).Return(&video.Video{}, video.ErrVideoNotFound)
+
+	output, err := sut.Execute(video_usecase.UploadMediaCommand{VideoId: 999, Type: video.BANNER})
+
+	assert.Nil(t, output)
+	assert.Equal(t, video.ErrVideoNotFound, err)
+	mediaGateway.AssertNotCalled(t, "StoreImage", mock.Anything, mock.Anything)
+}
+
+func TestUploadMediaWhenStoreFails(t *testing.T) {
+	videoGateway := new(mocks.VideoGatewayMock)
+	mediaGateway := new(mocks.MediaResourceGatewayMock)
+	sut := video_usecase.DefaultUploadMediaUseCase{
+		Gateway:      videoGateway,
+		MediaGateway: mediaGateway,
+	}
+	aVideo := &video.Video{ID: 999}
+	expectedErr := errors.New("disk full")
+
+	videoGateway.On("FindById", aVideo.ID).Return(aVideo, nil)
+	mediaGateway.On("StoreAudioVideo", aVideo.ID, mock.Anything).Return(&video.AudioVideoMedia{}, expectedErr)
+
+	output, err := sut.Execute(video_usecase.UploadMediaCommand{
+		VideoId:  aVideo.ID,
+		Type:     video.TRAILER,
+		Resource: video.Resource{Name: "trailer.mp4", Content: test.DummyMP4("trailer")},
+	})
+
+	assert.Nil(t, output)
+	assert.Equal(t, expectedErr, err)
+	videoGateway.AssertNotCalled(t, "Update", mock.Anything)
+}
+
+func TestUploadMediaDiscardsTheStoredMediaWhenTheVideoIsNotUpdated(t *testing.T) {
+	videoGateway := new(mocks.VideoGatewayMock)
+	mediaGateway := new(mocks.MediaResourceGatewayMock)
+	sut := video_usecase.DefaultUploadMediaUseCase{
+		Gateway:      videoGateway,
+		MediaGateway: mediaGateway,
+	}
+	aVideo := video.NewVideo("title", "desc", 2024, 120.0, true, video.L, nil, nil, nil)
+	aVideo.ID = 999
+	aVideo.UpdateBannerMedia(video.NewImageMediaWithId(20, "old", "old.png", "/old.png"))
+	media := video.NewImageMediaWithoutId("checksum", "banner.png", "/banner.png")
+	expectedErr := errors.New("connection reset")
+
+	videoGateway.On("FindById", aVideo.ID).Return(aVideo, nil)
+	mediaGateway.On("StoreImage", aVideo.ID, mock.Anything).Return(media, nil)
+	videoGateway.On("Update", mock.Anything).Return((*video.Video)(nil), expectedErr)
+	mediaGateway.On("Discard", "checksum").Return(nil)
+
+	output, err := sut.Execute(video_usecase.UploadMediaCommand{
+		VideoId:  aVideo.ID,
+		Type:     video.BANNER,
+		Resource: video.Resource{Name: "banner.png", Content: test.DummyPNG(1280, 720)},
+	})
+
+	assert.Nil(t, output)
+	assert.Equal(t, expectedErr, err)
+	mediaGateway.AssertExpectations(t)
+	mediaGateway.AssertNotCalled(t, "Commit", mock.Anything, mock.Anything)
+	mediaGateway.AssertNotCalled(t, "Release", mock.Anything)
+}
+
+func TestUploadMediaWarnsWhenThePreviousMediaIsNotReleased(t *testing.T) {
+	videoGateway := new(mocks.VideoGatewayMock)
+	mediaGateway := new(mocks.MediaResourceGatewayMock)
+	sut := video_usecase.DefaultUploadMediaUseCase{
+		Gateway:      videoGateway,
+		MediaGateway: mediaGateway,
+	}
+	aVideo := video.NewVideo("title", "desc", 2024, 120.0, true, video.L, nil, nil, nil)
+	aVideo.ID = 999
+	aVideo.UpdateBannerMedia(video.NewImageMediaWithId(20, "old", "old.png", "/old.png"))
+	media := video.NewImageMediaWithoutId("checksum", "banner.png", "/banner.png")
+
+	videoGateway.On("FindById", aVideo.ID).Return(aVideo, nil)
+	mediaGateway.On("StoreImage", aVideo.ID, mock.Anything).Return(media, nil)
+	videoGateway.On("Update", mock.Anything).Return(aVideo, nil)
+	mediaGateway.On("Commit", "/banner.png", "checksum").Return(nil)
+	mediaGateway.On("Release", "old").Return(errors.New("permission denied"))
+
+	output, err := sut.Execute(video_usecase.UploadMediaCommand{
+		VideoId:  aVideo.ID,
+		Type:     video.BANNER,
+		Resource: video.Resource{Name: "banner.png", Content: test.DummyPNG(1280, 720)},
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"could not release previous media old: permission denied"}, output.Warnings)
+}
+
+func TestUploadSameMediaContentKeepsIt(t *testing.T) {
+	videoGateway := new(mocks.VideoGatewayMock)
+	mediaGateway := new(mocks.MediaResourceGatewayMock)
+	sut := video_usecase.DefaultUploadMediaUseCase{
+		Gateway:      videoGateway,
+		MediaGateway: mediaGateway,
+	}
+	aVideo := video.NewVideo("title", "desc", 2024, 120.0, true, video.L, nil, nil, nil)
+	aVideo.ID = 999
+	aVideo.UpdateBannerMedia(video.NewImageMediaWithId(20, "checksum", "banner.png", "/banner.png"))
+	media := video.NewImageMediaWithoutId("checksum", "banner.png", "/banner.png")
+
+	videoGateway.On("FindById", aVideo.ID).Return(aVideo, nil)
+	mediaGateway.On("GetBlob", "checksum").Return(&video.Resource{Stream: bytes.NewReader(test.DummyPNG(1280, 720))}, nil)
+	mediaGateway.On("StoreImage", aVideo.ID, mock.Anything).Return(media, nil)
+	videoGateway.On("Update", mock.Anything).Return(aVideo, nil)
+	mediaGateway.On("Commit", "/banner.png", "checksum").Return(nil)
+
+	_, err := sut.Execute(video_usecase.UploadMediaCommand{
+		VideoId:  aVideo.ID,
+		Type:     video.BANNER,
+		Resource: video.Resource{Name: "banner.png", Checksum: "checksum"},
+	})
+
+	assert.Nil(t, err)
+	mediaGateway.AssertNumberOfCalls(t, "Release", 0)
+}
+
+func TestUploadMediaByChecksumValidatesTheStoredContent(t *testing.T) {
+	videoGateway := new(mocks.VideoGatewayMock)
+	mediaGateway := new(mocks.MediaResourceGatewayMock)
+	sut := video_usecase.DefaultUploadMediaUseCase{
+		Gateway:      videoGateway,
+		MediaGateway: mediaGateway,
+	}
+	aVideo := video.NewVideo("title", "desc", 2024, 120.0, true, video.L, nil, nil, nil)
+	aVideo.ID = 999
+
+	videoGateway.On("FindById", aVideo.ID).Return(aVideo, nil)
+	mediaGateway.On("GetBlob", "banner-sum").Return(&video.Resource{Stream: bytes.NewReader(test.DummyMP4("video"))}, nil)
+	mediaGateway.On("GetBlob", "missing-sum").Return((*video.Resource)(nil), video.ErrResourceNotFound)
+
+	_, err := sut.Execute(video_usecase.UploadMediaCommand{
+		VideoId:  aVideo.ID,
+		Type:     video.BANNER,
+		Resource: video.Resource{Name: "banner.png", Checksum: "banner-sum"},
+	})
+
+	var invalid video_usecase.InvalidMediaError
+	assert.ErrorAs(t, err, &invalid)
+	assert.Equal(t, "'Banner' must be image content but got video/mp4", invalid.Notification.GetErrors()[0].Error())
+
+	_, err = sut.Execute(video_usecase.UploadMediaCommand{
+		VideoId:  aVideo.ID,
+		Type:     video.BANNER,
+		Resource: video.Resource{Name: "banner.png", Checksum: "missing-sum"},
+	})
+
+	assert.ErrorAs(t, err, &invalid)
+	assert.Equal(t, "'Banner' should not be empty", invalid.Notification.GetErrors()[0].Error())
+	mediaGateway.AssertNotCalled(t, "StoreImage", mock.Anything, mock.Anything)
+}
+
+func TestUploadThumbnailGeneratesThumbnailHalf(t *testing.T) {
+	videoGateway := new(mocks.VideoGatewayMock)
+	mediaGateway := new(mocks.MediaResourceGatewayMock)
+	thumbnails := new(mocks.ThumbnailGeneratorMock)
+	sut := video_usecase.DefaultUploadMediaUseCase{
+		Gateway:      videoGateway,
+		MediaGateway: mediaGateway,
+		Thumbnails:   thumbnails,
+	}
+	aVideo := video.NewVideo("title", "desc", 2024, 120.0, true, video.L, nil, nil, nil)
+	aVideo.ID = 999
+	aVideo.UpdateThumbnailMedia(video.NewImageMediaWithId(20, "old", "old.png", "/old.png"))
+	aVideo.UpdateThumbnailHalfMedia(video.NewImageMediaWithId(21, "old-half", "old_half.png", "/old_half.png"))
+	aVideo.ThumbNailHalf.Generated = true
+	thumbnailMedia := video.NewImageMediaWithoutId("checksum", "cover.png", "/cover.png")
+	halfMedia := video.NewImageMediaWithoutId("half-checksum", "cover_half.png", "/cover_half.png")
+	stored := &video.Resource{Name: "cover.png", Content: test.DummyPNG(640, 360)}
+	half := &video.Resource{Name: "cover_half.png", Content: test.DummyPNG(320, 180)}
+
+	videoGateway.On("FindById", aVideo.ID).Return(aVideo, nil)
+	mediaGateway.On("StoreImage", aVideo.ID, video.VideoResource{Type: video.THUMBNAIL, Resource: *stored}).
+		Return(thumbnailMedia, nil)
+	mediaGateway.On("GetBlob", "checksum").Return(stored, nil)
+	thumbnails.On("GenerateHalf", *stored).Return(half, nil)
+	mediaGateway.On("StoreImage", aVideo.ID, video.VideoResource{Type: video.THUMBNAIL_HALF, Resource: *half}).
+		Return(halfMedia, nil)
+	videoGateway.On("Update", mock.MatchedBy(func(v video.Video) bool {
+		return v.ThumbNail.ID == 20 && v.ThumbNailHalf == halfMedia && halfMedia.ID == 21 && halfMedia.Generated
+	})).Return(aVideo, nil)
+	mediaGateway.On("Commit", "/cover.png", "checksum").Return(nil)
+	mediaGateway.On("Commit", "/cover_half.png", "half-checksum").Return(nil)
+	mediaGateway.On("Release", "old").Return(nil)
+	mediaGateway.On("Release", "old-half").Return(nil)
+
+	_, err := sut.Execute(video_usecase.UploadMediaCommand{
+		VideoId:  aVideo.ID,
+		Type:     video.THUMBNAIL,
+		Resource: *stored,
+	})
+
+	assert.Nil(t, err)
+	videoGateway.AssertExpectations(t)
+	mediaGateway.AssertExpectations(t)
+	thumbnails.AssertExpectations(t)
+}
+
+func TestUploadThumbnailKeepsExplicitThumbnailHalf(t *testing.T) {
+	videoGateway := new(mocks.VideoGatewayMock)
+	mediaGateway := new(mocks.MediaResourceGatewayMock)
+	thumbnails := new(mocks.ThumbnailGeneratorMock)
+	sut := video_usecase.DefaultUploadMediaUseCase{
+		Gateway:      videoGateway,
+		MediaGateway: mediaGateway,
+		Thumbnails:   thumbnails,
+	}
+	aVideo := video.NewVideo("title", "desc", 2024, 120.0, true, video.L, nil, nil, nil)
+	aVideo.ID = 999
+	explicitHalf := video.NewImageMediaWithId(21, "explicit", "small.png", "/small.png")
+	aVideo.UpdateThumbnailHalfMedia(explicitHalf)
+	thumbnailMedia := video.NewImageMediaWithoutId("checksum", "cover.png", "/cover.png")
+
+	videoGateway.On("FindById", aVideo.ID).Return(aVideo, nil)
+	mediaGateway.On("StoreImage", aVideo.ID, mock.Anything).Return(thumbnailMedia, nil)
+	videoGateway.On("Update", mock.MatchedBy(func(v video.Video) bool {
+		return v.ThumbNail == thumbnailMedia && v.ThumbNailHalf == explicitHalf
+	})).Return(aVideo, nil)
+	mediaGateway.On("Commit", "/cover.png", "checksum").Return(nil)
+
+	_, err := sut.Execute(video_usecase.UploadMediaCommand{
+		VideoId:  aVideo.ID,
+		Type:     video.THUMBNAIL,
+		Resource: video.Resource{Name: "cover.png", Content: test.DummyPNG(640, 360)},
+	})
+
+	assert.Nil(t, err)
+	videoGateway.AssertExpectations(t)
+	mediaGateway.AssertNumberOfCalls(t, "StoreImage", 1)
+	thumbnails.AssertNotCalled(t, "GenerateHalf", mock.Anything)
+}
+
+func TestUploadThumbnailWithUnsupportedImageClearsGeneratedThumbnailHalf(t *testing.T) {
+	videoGateway := new(mocks.VideoGatewayMock)
+	mediaGateway := new(mocks.MediaResourceGatewayMock)
+	thumbnails := new(mocks.ThumbnailGeneratorMock)
+	sut := video_usecase.DefaultUploadMediaUseCase{
+		Gateway:      videoGateway,
+		MediaGateway: mediaGateway,
+		Thumbnails:   thumbnails,
+	}
+	aVideo := video.NewVideo("title", "desc", 2024, 120.0, true, video.L, nil, nil, nil)
+	aVideo.ID = 999
+	aVideo.UpdateThumbnailHalfMedia(video.NewImageMediaWithId(21, "old-half", "old_half.png", "/old_half.png"))
+	aVideo.ThumbNailHalf.Generated = true
+	thumbnailMedia := video.NewImageMediaWithoutId("checksum", "cover.webp", "/cover.webp")
+	stored := &video.Resource{Name: "cover.webp", Content: test.DummyPNG(640, 360)}
+
+	videoGateway.On("FindById", aVideo.ID).Return(aVideo, nil)
+	mediaGateway.On("StoreImage", aVideo.ID, mock.Anything).Return(thumbnailMedia, nil)
+	mediaGateway.On("GetBlob", "checksum").Return(stored, nil)
+	thumbnails.On("GenerateHalf", *stored).Return(&video.Resource{}, video.ErrUnsupportedImage)
+	videoGateway.On("Update", mock.MatchedBy(func(v video.Video) bool {
+		return v.ThumbNail == thumbnailMedia && v.ThumbNailHalf == nil
+	})).Return(aVideo, nil)
+	mediaGateway.On("Commit", "/cover.webp", "checksum").Return(nil)
+	mediaGateway.On("Release", "old-half").Return(nil)
+
+	_, err := sut.Execute(video_usecase.UploadMediaCommand{
+		VideoId:  aVideo.ID,
+		Type:     video.THUMBNAIL,
+		Resource: *stored,
+	})
+
+	assert.Nil(t, err)
+	videoGateway.AssertExpectations(t)
+	mediaGateway.AssertNumberOfCalls(t, "StoreImage", 1)
+}
+
+func TestUploadMediaWithMismatchedContent(t *testing.T) {
+	videoGateway := new(mocks.VideoGatewayMock)
+	mediaGateway := new(mocks.MediaResourceGatewayMock)
+	sut := video_usecase.DefaultUploadMediaUseCase{
+		Gateway:      videoGateway,
+		MediaGateway: mediaGateway,
+	}
+	aVideo := &video.Video{ID: 999}
+
+	videoGateway.On("FindById", aVideo.ID).Return(aVideo, nil)
+
+	output, err := sut.Execute(video_usecase.UploadMediaCommand{
+		VideoId:  aVideo.ID,
+		Type:     video.VIDEO,
+		Resource: video.Resource{Name: "movie.mp4", Stream: bytes.NewReader(test.DummyPNG(1280, 720))},
+	})
+
+	var invalidErr video_usecase.InvalidMediaError
+	assert.Nil(t, output)
+	assert.True(t, errors.As(err, &invalidErr))
+	assert.Len(t, invalidErr.Notification.GetErrors(), 1)
+	assert.Equal(t, "'Video' must be video content but got image/png", invalidErr.Notification.GetErrors()[0].Error())
+	mediaGateway.AssertNotCalled(t, "StoreAudioVideo", mock.Anything, mock.Anything)
+	videoGateway.AssertNotCalled(t, "Update", mock.Anything)
+}
+
+func TestUploadSubtitleAsMediaIsRejected(t *testing.T) {
+	videoGateway := new(mocks.VideoGatewayMock)
+	mediaGateway := new(mocks.MediaResourceGatewayMock)
+	sut := video_usecase.DefaultUploadMediaUseCase{
+		Gateway:      videoGateway,
+		MediaGateway: mediaGateway,
+	}
+	aVideo := &video.Video{ID: 999}
+
+	videoGateway.On("FindById", aVideo.ID).Return(aVideo, nil)
+
+	output, err := sut.Execute(video_usecase.UploadMediaCommand{
+		VideoId:  aVideo.ID,
+		Type:     video.SUBTITLE,
+		Resource: video.Resource{Name: "movie.srt", Content: []byte("1\n00:00:01,000 --> 00:00:02,000\nHi\n")},
+	})
+
+	var invalidErr video_usecase.InvalidMediaError
+	assert.Nil(t, output)
+	assert.True(t, errors.As(err, &invalidErr))
+	assert.Equal(t, "'Subtitle' media must be uploaded as a text track with a language and kind", invalidErr.Notification.GetErrors()[0].Error())
+	videoGateway.AssertNotCalled(t, "Update", mock.Anything)
+}
+
+func TestUploadMediaStreamIsStoredAfterValidation(t *testing.T) {
+	videoGateway := new(mocks.VideoGatewayMock)
+	mediaGateway := new(mocks.MediaResourceGatewayMock)
+	sut := video_usecase.DefaultUploadMediaUseCase{
+		Gateway:      videoGateway,
+		MediaGateway: mediaGateway,
+	}
+	aVideo := &video.Video{ID: 999}
+	content := test.DummyMP4("trailer content")
+	status := video.PENDING
+	var stored []byte
+
+	videoGateway.On("FindById", aVideo.ID).Return(aVideo, nil)
+	mediaGateway.On("StoreAudioVideo", aVideo.ID, mock.Anything).
+		Run(func(args mock.Arguments) {
+			stored, _ = io.ReadAll(args.Get(1).(video.VideoResource).Resource.Stream)
+		}).
+		Return(video.NewAudioVideoMediaWith(0, &status, "checksum", "trailer.mp4", "/trailer.mp4", ""), nil)
+	videoGateway.On("Update", mock.Anything).Return(aVideo, nil)
+	mediaGateway.On("Commit", "/trailer.mp4", "checksum").Return(nil)
+
+	_, err := sut.Execute(video_usecase.UploadMediaCommand{
+		VideoId:  aVideo.ID,
+		Type:     video.TRAILER,
+		Resource: video.Resource{Name: "trailer.mp4", ContentType: "video/mp4", Stream: bytes.NewReader(content)},
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, content, stored)
+}
+
+func TestUploadVideoMediaExtractsMetadata(t *testing.T) {
+	videoGateway := new(mocks.VideoGatewayMock)
+	mediaGateway := new(mocks.MediaResourceGatewayMock)
+	extractor := new(mocks.MediaMetadataExtractorMock)
+	sut := video_usecase.DefaultUploadMediaUseCase{
+		Gateway:           videoGateway,
+		MediaGateway:      mediaGateway,
+		MetadataExtractor: extractor,
+	}
+	aVideo := video.NewVideo("title", "desc", 2024, 120.0, true, video.L, nil, nil, nil)
+	aVideo.ID = 999
+	status := video.PENDING
+	media := video.NewAudioVideoMediaWith(0, &status, "checksum", "movie.mp4", "/movie.mp4", "")
+	stored := bytes.NewReader(test.DummyMP4("video"))
+	metadata := &video.MediaMetadata{Duration: 5400, Width: 1920, Height: 1080, Codecs: []string{"avc1"}, TrackCount: 1}
+
+	videoGateway.On("FindById", aVideo.ID).Return(aVideo, nil)
+	mediaGateway.On("StoreAudioVideo", aVideo.ID, mock.Anything).Return(media, nil)
+	mediaGateway.On("GetBlob", "checksum").Return(&video.Resource{Stream: stored}, nil)
+	extractor.On("Extract", stored).Return(metadata, nil)
+	videoGateway.On("Update", mock.MatchedBy(func(v video.Video) bool {
+		return v.Video.Metadata == metadata
+	})).Return(aVideo, nil)
+	mediaGateway.On("Commit", "/movie.mp4", "checksum").Return(nil)
+
+	output, err := sut.Execute(video_usecase.UploadMediaCommand{
+		VideoId:  aVideo.ID,
+		Type:     video.VIDEO,
+		Resource: video.Resource{Name: "movie.mp4", Content: test.DummyMP4("video")},
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"'duration' is 120.00 minutes but the video file lasts 90.00 minutes"}, output.Warnings)
+	videoGateway.AssertExpectations(t)
+	extractor.AssertExpectations(t)
+}
+
+func TestUploadVideoMediaWithUnsupportedContainerHasNoMetadata(t *testing.T) {
+	videoGateway := new(mocks.VideoGatewayMock)
+	mediaGateway := new(mocks.MediaResourceGatewayMock)
+	extractor := new(mocks.MediaMetadataExtractorMock)
+	sut := video_usecase.DefaultUploadMediaUseCase{
+		Gateway:           videoGateway,
+		MediaGateway:      mediaGateway,
+		MetadataExtractor: extractor,
+	}
+	aVideo := video.NewVideo("title", "desc", 2024, 120.0, true, video.L, nil, nil, nil)
+	aVideo.ID = 999
+	status := video.PENDING
+	media := video.NewAudioVideoMediaWith(0, &status, "checksum", "movie.webm", "/movie.webm", "")
+	stored := bytes.NewReader([]byte("\x1a\x45\xdf\xa3webm"))
+
+	videoGateway.On("FindById", aVideo.ID).Return(aVideo, nil)
+	mediaGateway.On("StoreAudioVideo", aVideo.ID, mock.Anything).Return(media, nil)
+	mediaGateway.On("GetBlob", "checksum").Return(&video.Resource{Stream: stored}, nil)
+	extractor.On("Extract", stored).Return((*video.MediaMetadata)(nil), video.ErrUnsupportedMedia)
+	videoGateway.On("Update", mock.MatchedBy(func(v video.Video) bool {
+		return v.Video == media && v.Video.Metadata == nil
+	})).Return(aVideo, nil)
+	mediaGateway.On("Commit", "/movie.webm", "checksum").Return(nil)
+
+	output, err := sut.Execute(video_usecase.UploadMediaCommand{
+		VideoId:  aVideo.ID,
+		Type:     video.VIDEO,
+		Resource: video.Resource{Name: "movie.webm", Content: []byte("\x1a\x45\xdf\xa3webm")},
+	})
+
+	assert.Nil(t, err)
+	assert.Empty(t, output.Warnings)
+	videoGateway.AssertExpectations(t)
+}
@@ -0,0 +1,87 @@
+package video_usecase
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/video"
+	"github.com.br/gibranct/admin_do_catalogo/pkg/notification"
+	"github.com.br/gibranct/admin_do_catalogo/pkg/validator"
+)
+
+// large enough to reach the frame header of JPEGs carrying EXIF segments
+const mediaHeadSize = 1 << 20
+
+type InvalidMediaError struct {
+	Notification *notification.Notification
+}
+
+func (e InvalidMediaError) Error() string {
+	return fmt.Sprintf("invalid media: %v", errors.Join(e.Notification.GetErrors()...))
+}
+
+func validateMedia(
+	aType video.VideoMediaType,
+	resource *video.Resource,
+	mediaGateway video.MediaResourceGateway,
+	handler validator.ValidationHandler,
+) error {
+	head, err := peekHead(resource, mediaGateway)
+	if err != nil {
+		return err
+	}
+
+	video.NewMediaValidator(aType, resource.ContentType, head, handler).Validate()
+	return nil
+}
+
+// streams are buffered so the peeked head is still read when the resource is stored
+func peekHead(resource *video.Resource, mediaGateway video.MediaResourceGateway) ([]byte, error) {
+	if resource.Stream == nil && resource.Content == nil {
+		return storedHead(resource.Checksum, mediaGateway)
+	}
+
+	if resource.Stream == nil {
+		return resource.Content, nil
+	}
+
+	buffered := bufio.NewReaderSize(resource.Stream, mediaHeadSize)
+
+	head, err := buffered.Peek(mediaHeadSize)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+
+	resource.Stream = buffered
+	return head, nil
+}
+
+// resources referencing already stored content by its checksum are inspected through
+// that content, a checksum nothing was stored under leaves the head empty
+func storedHead(checksum string, mediaGateway video.MediaResourceGateway) ([]byte, error) {
+	if checksum == "" {
+		return nil, nil
+	}
+
+	blob, err := mediaGateway.GetBlob(checksum)
+	if errors.Is(err, video.ErrResourceNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if closer, ok := blob.Stream.(io.Closer); ok {
+		defer closer.Close()
+	}
+
+	head := make([]byte, mediaHeadSize)
+	n, err := io.ReadFull(blob.Stream, head)
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return nil, err
+	}
+
+	return head[:n], nil
+}
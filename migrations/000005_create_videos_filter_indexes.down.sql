@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_vcs_category_video;
+DROP INDEX IF EXISTS idx_vgs_genre_video;
+DROP INDEX IF EXISTS idx_vcms_member_video;
+DROP INDEX IF EXISTS idx_videos_year_launched;
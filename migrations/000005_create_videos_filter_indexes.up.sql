@@ -0,0 +1,4 @@
+CREATE INDEX IF NOT EXISTS idx_vcs_category_video ON videos_categories (category_id, video_id);
+CREATE INDEX IF NOT EXISTS idx_vgs_genre_video ON videos_genres (genre_id, video_id);
+CREATE INDEX IF NOT EXISTS idx_vcms_member_video ON videos_cast_members (cast_member_id, video_id);
+CREATE INDEX IF NOT EXISTS idx_videos_year_launched ON videos (year_launched);
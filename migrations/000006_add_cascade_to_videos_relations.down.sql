@@ -0,0 +1,11 @@
+ALTER TABLE videos_categories
+    DROP CONSTRAINT fk_vcs_video_id,
+    ADD CONSTRAINT fk_vcs_video_id FOREIGN KEY (video_id) REFERENCES videos (id);
+
+ALTER TABLE videos_genres
+    DROP CONSTRAINT fk_vgs_video_id,
+    ADD CONSTRAINT fk_vgs_video_id FOREIGN KEY (video_id) REFERENCES videos (id);
+
+ALTER TABLE videos_cast_members
+    DROP CONSTRAINT fk_vcms_video_id,
+    ADD CONSTRAINT fk_vcms_video_id FOREIGN KEY (video_id) REFERENCES videos (id);
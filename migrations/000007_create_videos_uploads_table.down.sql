@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_videos_uploads_video_id;
+
+DROP TABLE IF EXISTS videos_uploads;
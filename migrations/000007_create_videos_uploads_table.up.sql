@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS videos_uploads (
+    id VARCHAR(64) PRIMARY KEY,
+    video_id BIGINT NOT NULL,
+    media_type VARCHAR(50) NOT NULL,
+    upload_length BIGINT NOT NULL,
+    upload_offset BIGINT NOT NULL DEFAULT 0,
+    file_name VARCHAR(255) NOT NULL,
+    checksum VARCHAR(255) NOT NULL DEFAULT '',
+    created_at TIMESTAMP(0) WITH TIME ZONE NOT NULL,
+    updated_at TIMESTAMP(0) WITH TIME ZONE NOT NULL,
+    CONSTRAINT fk_vus_video_id FOREIGN KEY (video_id) REFERENCES videos (id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_videos_uploads_video_id ON videos_uploads (video_id);
@@ -0,0 +1,3 @@
+ALTER TABLE videos_video_media
+    DROP COLUMN IF EXISTS failure_reason,
+    DROP COLUMN IF EXISTS attempts;
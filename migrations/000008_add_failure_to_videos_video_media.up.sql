@@ -0,0 +1,3 @@
+ALTER TABLE videos_video_media
+    ADD COLUMN IF NOT EXISTS failure_reason VARCHAR(1000) NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS attempts INT NOT NULL DEFAULT 0;
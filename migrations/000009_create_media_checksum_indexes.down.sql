@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_videos_image_media_checksum;
+
+DROP INDEX IF EXISTS idx_videos_video_media_checksum;
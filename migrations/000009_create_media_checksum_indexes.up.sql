@@ -0,0 +1,3 @@
+CREATE INDEX IF NOT EXISTS idx_videos_video_media_checksum ON videos_video_media (checksum);
+
+CREATE INDEX IF NOT EXISTS idx_videos_image_media_checksum ON videos_image_media (checksum);
@@ -0,0 +1,6 @@
+ALTER TABLE videos_video_media
+    DROP COLUMN IF EXISTS duration_seconds,
+    DROP COLUMN IF EXISTS width,
+    DROP COLUMN IF EXISTS height,
+    DROP COLUMN IF EXISTS codecs,
+    DROP COLUMN IF EXISTS track_count;
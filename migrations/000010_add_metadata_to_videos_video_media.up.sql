@@ -0,0 +1,6 @@
+ALTER TABLE videos_video_media
+    ADD COLUMN IF NOT EXISTS duration_seconds DOUBLE PRECISION,
+    ADD COLUMN IF NOT EXISTS width INT,
+    ADD COLUMN IF NOT EXISTS height INT,
+    ADD COLUMN IF NOT EXISTS codecs VARCHAR(255),
+    ADD COLUMN IF NOT EXISTS track_count INT;
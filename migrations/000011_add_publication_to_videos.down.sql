@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_videos_publication_due;
+ALTER TABLE videos
+    DROP COLUMN IF EXISTS publication_status,
+    DROP COLUMN IF EXISTS publish_at;
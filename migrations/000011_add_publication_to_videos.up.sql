@@ -0,0 +1,5 @@
+ALTER TABLE videos
+    ADD COLUMN IF NOT EXISTS publication_status VARCHAR(20) NOT NULL DEFAULT 'DRAFT',
+    ADD COLUMN IF NOT EXISTS publish_at TIMESTAMP(0) WITH TIME ZONE NULL;
+UPDATE videos SET publication_status = 'PUBLISHED' WHERE published;
+CREATE INDEX IF NOT EXISTS idx_videos_publication_due ON videos (publication_status, publish_at);
@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS videos_ratings (
+    video_id BIGINT NOT NULL,
+    rating_system VARCHAR(20) NOT NULL,
+    territory CHAR(2) NOT NULL,
+    value VARCHAR(10) NOT NULL,
+    CONSTRAINT idx_vrs_video_system_territory UNIQUE (video_id, rating_system, territory),
+    CONSTRAINT fk_vrs_video_id FOREIGN KEY (video_id) REFERENCES videos (id) ON DELETE CASCADE
+);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS videos_content_descriptors;
+DROP TABLE IF EXISTS content_descriptors;
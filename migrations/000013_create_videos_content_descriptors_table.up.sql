@@ -0,0 +1,26 @@
+CREATE TABLE IF NOT EXISTS content_descriptors (
+    code VARCHAR(30) NOT NULL PRIMARY KEY
+);
+
+INSERT INTO content_descriptors (code) VALUES
+    ('FEAR'),
+    ('VIOLENCE'),
+    ('EXTREME_VIOLENCE'),
+    ('NUDITY'),
+    ('SEXUAL_CONTENT'),
+    ('EXPLICIT_SEX'),
+    ('LEGAL_DRUGS'),
+    ('ILLEGAL_DRUGS'),
+    ('STRONG_LANGUAGE'),
+    ('CRIMINAL_ACTS')
+ON CONFLICT (code) DO NOTHING;
+
+CREATE TABLE IF NOT EXISTS videos_content_descriptors (
+    video_id BIGINT NOT NULL,
+    descriptor VARCHAR(30) NOT NULL,
+    CONSTRAINT idx_vcd_video_descriptor UNIQUE (video_id, descriptor),
+    CONSTRAINT fk_vcd_video_id FOREIGN KEY (video_id) REFERENCES videos (id) ON DELETE CASCADE,
+    CONSTRAINT fk_vcd_descriptor FOREIGN KEY (descriptor) REFERENCES content_descriptors (code)
+);
+
+CREATE INDEX IF NOT EXISTS idx_vcd_descriptor ON videos_content_descriptors (descriptor);
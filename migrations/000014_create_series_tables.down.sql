@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS episodes_genres;
+DROP TABLE IF EXISTS episodes_categories;
+DROP TABLE IF EXISTS seasons_episodes;
+DROP TABLE IF EXISTS seasons;
+DROP TABLE IF EXISTS series_genres;
+DROP TABLE IF EXISTS series_categories;
+DROP TABLE IF EXISTS series;
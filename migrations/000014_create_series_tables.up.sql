@@ -0,0 +1,61 @@
+CREATE TABLE IF NOT EXISTS series (
+    id BIGSERIAL PRIMARY KEY,
+    title VARCHAR(255) NOT NULL,
+    description VARCHAR(4000) NOT NULL,
+    created_at TIMESTAMP(0) WITH TIME ZONE NOT NULL,
+    updated_at TIMESTAMP(0) WITH TIME ZONE NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS series_categories (
+    series_id BIGINT NOT NULL,
+    category_id BIGINT NOT NULL,
+    CONSTRAINT idx_scs_series_category UNIQUE (series_id, category_id),
+    CONSTRAINT fk_scs_series_id FOREIGN KEY (series_id) REFERENCES series (id) ON DELETE CASCADE,
+    CONSTRAINT fk_scs_category_id FOREIGN KEY (category_id) REFERENCES categories (id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS series_genres (
+    series_id BIGINT NOT NULL,
+    genre_id BIGINT NOT NULL,
+    CONSTRAINT idx_sgs_series_genre UNIQUE (series_id, genre_id),
+    CONSTRAINT fk_sgs_series_id FOREIGN KEY (series_id) REFERENCES series (id) ON DELETE CASCADE,
+    CONSTRAINT fk_sgs_genre_id FOREIGN KEY (genre_id) REFERENCES genres (id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS seasons (
+    id BIGSERIAL PRIMARY KEY,
+    series_id BIGINT NOT NULL,
+    number SMALLINT NOT NULL CHECK (number > 0),
+    title VARCHAR(255) NOT NULL,
+    created_at TIMESTAMP(0) WITH TIME ZONE NOT NULL,
+    updated_at TIMESTAMP(0) WITH TIME ZONE NOT NULL,
+    CONSTRAINT idx_ss_series_number UNIQUE (series_id, number),
+    CONSTRAINT fk_ss_series_id FOREIGN KEY (series_id) REFERENCES series (id) ON DELETE CASCADE
+);
+
+-- a video can only be a single episode, so it identifies the episode
+CREATE TABLE IF NOT EXISTS seasons_episodes (
+    video_id BIGINT PRIMARY KEY,
+    season_id BIGINT NOT NULL,
+    number SMALLINT NOT NULL CHECK (number > 0),
+    title VARCHAR(255) NOT NULL,
+    CONSTRAINT idx_ses_season_number UNIQUE (season_id, number),
+    CONSTRAINT fk_ses_season_id FOREIGN KEY (season_id) REFERENCES seasons (id) ON DELETE CASCADE,
+    CONSTRAINT fk_ses_video_id FOREIGN KEY (video_id) REFERENCES videos (id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS episodes_categories (
+    video_id BIGINT NOT NULL,
+    category_id BIGINT NOT NULL,
+    CONSTRAINT idx_ecs_episode_category UNIQUE (video_id, category_id),
+    CONSTRAINT fk_ecs_video_id FOREIGN KEY (video_id) REFERENCES seasons_episodes (video_id) ON DELETE CASCADE,
+    CONSTRAINT fk_ecs_category_id FOREIGN KEY (category_id) REFERENCES categories (id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS episodes_genres (
+    video_id BIGINT NOT NULL,
+    genre_id BIGINT NOT NULL,
+    CONSTRAINT idx_egs_episode_genre UNIQUE (video_id, genre_id),
+    CONSTRAINT fk_egs_video_id FOREIGN KEY (video_id) REFERENCES seasons_episodes (video_id) ON DELETE CASCADE,
+    CONSTRAINT fk_egs_genre_id FOREIGN KEY (genre_id) REFERENCES genres (id) ON DELETE CASCADE
+);
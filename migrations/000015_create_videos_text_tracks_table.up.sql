@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS videos_text_tracks (
+    video_id BIGINT NOT NULL,
+    language VARCHAR(35) NOT NULL,
+    kind VARCHAR(20) NOT NULL,
+    checksum VARCHAR(255) NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    file_path VARCHAR(500) NOT NULL,
+    cues INT NOT NULL,
+    CONSTRAINT idx_vtt_video_language_kind UNIQUE (video_id, language, kind),
+    CONSTRAINT fk_vtt_video_id FOREIGN KEY (video_id) REFERENCES videos (id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_vtt_checksum ON videos_text_tracks (checksum);
@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS videos_audio_tracks (
+    video_id BIGINT NOT NULL,
+    language VARCHAR(35) NOT NULL,
+    role VARCHAR(20) NOT NULL,
+    video_media_id BIGINT NOT NULL UNIQUE,
+    CONSTRAINT idx_vat_video_language UNIQUE (video_id, language),
+    CONSTRAINT fk_vat_video_id FOREIGN KEY (video_id) REFERENCES videos (id) ON DELETE CASCADE,
+    CONSTRAINT fk_vat_video_media_id FOREIGN KEY (video_media_id) REFERENCES videos_video_media (id)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_vat_original ON videos_audio_tracks (video_id) WHERE role = 'ORIGINAL';
@@ -0,0 +1,12 @@
+-- positions are swapped in bulk when reordering, so uniqueness is only checked at commit
+CREATE TABLE IF NOT EXISTS videos_extras (
+    id BIGSERIAL PRIMARY KEY,
+    video_id BIGINT NOT NULL,
+    extra_type VARCHAR(30) NOT NULL,
+    title VARCHAR(255) NOT NULL,
+    position SMALLINT NOT NULL CHECK (position > 0),
+    video_media_id BIGINT NULL UNIQUE,
+    CONSTRAINT idx_ve_video_position UNIQUE (video_id, position) DEFERRABLE INITIALLY DEFERRED,
+    CONSTRAINT fk_ve_video_id FOREIGN KEY (video_id) REFERENCES videos (id) ON DELETE CASCADE,
+    CONSTRAINT fk_ve_video_media_id FOREIGN KEY (video_media_id) REFERENCES videos_video_media (id)
+);
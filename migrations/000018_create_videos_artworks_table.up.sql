@@ -0,0 +1,14 @@
+-- an empty language marks artwork without text
+CREATE TABLE IF NOT EXISTS videos_artworks (
+    id BIGSERIAL PRIMARY KEY,
+    video_id BIGINT NOT NULL,
+    role VARCHAR(20) NOT NULL,
+    language VARCHAR(35) NOT NULL DEFAULT '',
+    aspect_ratio VARCHAR(11) NOT NULL,
+    width INT NOT NULL CHECK (width > 0),
+    height INT NOT NULL CHECK (height > 0),
+    image_media_id BIGINT NOT NULL UNIQUE,
+    CONSTRAINT idx_va_video_role_language_width UNIQUE (video_id, role, language, width),
+    CONSTRAINT fk_va_video_id FOREIGN KEY (video_id) REFERENCES videos (id) ON DELETE CASCADE,
+    CONSTRAINT fk_va_image_media_id FOREIGN KEY (image_media_id) REFERENCES videos_image_media (id)
+);
@@ -0,0 +1,14 @@
+-- the ladder reported by the encoder for an audio/video media, replaced when it completes
+CREATE TABLE IF NOT EXISTS videos_media_renditions (
+    id BIGSERIAL PRIMARY KEY,
+    video_media_id BIGINT NOT NULL,
+    bandwidth BIGINT NOT NULL CHECK (bandwidth > 0),
+    width INT NOT NULL CHECK (width > 0),
+    height INT NOT NULL CHECK (height > 0),
+    codecs VARCHAR(255) NOT NULL,
+    segment_location VARCHAR(1024) NOT NULL,
+    segment_duration NUMERIC(8, 3) NOT NULL CHECK (segment_duration > 0),
+    CONSTRAINT fk_vmr_video_media_id FOREIGN KEY (video_media_id) REFERENCES videos_video_media (id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_vmr_video_media_id ON videos_media_renditions (video_media_id);
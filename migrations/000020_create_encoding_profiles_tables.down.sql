@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS categories_encoding_profiles;
+DROP TABLE IF EXISTS videos_encoding_profiles;
+DROP TABLE IF EXISTS encoding_profiles_renditions;
+DROP TABLE IF EXISTS encoding_profiles;
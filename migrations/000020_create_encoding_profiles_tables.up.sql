@@ -0,0 +1,34 @@
+CREATE TABLE IF NOT EXISTS encoding_profiles (
+    id BIGSERIAL PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    created_at TIMESTAMP(0) WITH TIME ZONE NOT NULL,
+    updated_at TIMESTAMP(0) WITH TIME ZONE NOT NULL,
+    CONSTRAINT idx_ep_name UNIQUE (name)
+);
+
+CREATE TABLE IF NOT EXISTS encoding_profiles_renditions (
+    profile_id BIGINT NOT NULL,
+    position SMALLINT NOT NULL,
+    width INT NOT NULL CHECK (width > 0),
+    height INT NOT NULL CHECK (height > 0),
+    bitrate BIGINT NOT NULL CHECK (bitrate > 0),
+    codec VARCHAR(16) NOT NULL,
+    container VARCHAR(16) NOT NULL,
+    CONSTRAINT idx_epr_profile_position UNIQUE (profile_id, position),
+    CONSTRAINT fk_epr_profile_id FOREIGN KEY (profile_id) REFERENCES encoding_profiles (id) ON DELETE CASCADE
+);
+
+-- deleting a profile drops its assignments, videos fall back to their category defaults
+CREATE TABLE IF NOT EXISTS videos_encoding_profiles (
+    video_id BIGINT PRIMARY KEY,
+    profile_id BIGINT NOT NULL,
+    CONSTRAINT fk_vep_video_id FOREIGN KEY (video_id) REFERENCES videos (id) ON DELETE CASCADE,
+    CONSTRAINT fk_vep_profile_id FOREIGN KEY (profile_id) REFERENCES encoding_profiles (id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS categories_encoding_profiles (
+    category_id BIGINT PRIMARY KEY,
+    profile_id BIGINT NOT NULL,
+    CONSTRAINT fk_cep_category_id FOREIGN KEY (category_id) REFERENCES categories (id) ON DELETE CASCADE,
+    CONSTRAINT fk_cep_profile_id FOREIGN KEY (profile_id) REFERENCES encoding_profiles (id) ON DELETE CASCADE
+);
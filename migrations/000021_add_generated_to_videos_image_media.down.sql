@@ -0,0 +1 @@
+ALTER TABLE videos_image_media DROP COLUMN IF EXISTS generated;
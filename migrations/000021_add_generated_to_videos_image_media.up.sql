@@ -0,0 +1,8 @@
+ALTER TABLE videos_image_media ADD COLUMN generated BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- halves generated so far were only recognizable by their derived name
+UPDATE videos_image_media im SET generated = TRUE
+FROM videos v
+JOIN videos_image_media th ON th.id = v.thumbnail_id
+WHERE im.id = v.thumbnail_half_id
+AND im.name = regexp_replace(th.name, '(\.[^.]*)?$', '_half\1');
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS videos_media_encoding_renditions;
+ALTER TABLE videos_video_media DROP COLUMN encoding_profile_name;
+ALTER TABLE videos_video_media DROP COLUMN encoding_profile_id;
@@ -0,0 +1,16 @@
+-- a copy of the profile an encoding job was sent with, kept on the media so the
+-- result is checked against it even if the profile changes or is deleted meanwhile
+ALTER TABLE videos_video_media ADD COLUMN encoding_profile_id BIGINT;
+ALTER TABLE videos_video_media ADD COLUMN encoding_profile_name VARCHAR(255);
+
+CREATE TABLE IF NOT EXISTS videos_media_encoding_renditions (
+    video_media_id BIGINT NOT NULL,
+    position SMALLINT NOT NULL,
+    width INT NOT NULL CHECK (width > 0),
+    height INT NOT NULL CHECK (height > 0),
+    bitrate BIGINT NOT NULL CHECK (bitrate > 0),
+    codec VARCHAR(16) NOT NULL,
+    container VARCHAR(16) NOT NULL,
+    CONSTRAINT idx_vmer_video_media_position UNIQUE (video_media_id, position),
+    CONSTRAINT fk_vmer_video_media_id FOREIGN KEY (video_media_id) REFERENCES videos_video_media (id) ON DELETE CASCADE
+);
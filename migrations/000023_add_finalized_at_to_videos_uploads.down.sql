@@ -0,0 +1 @@
+ALTER TABLE videos_uploads DROP COLUMN IF EXISTS finalized_at;
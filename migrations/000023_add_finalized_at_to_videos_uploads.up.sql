@@ -0,0 +1,2 @@
+-- a finalized upload is kept so that repeating its last request changes nothing
+ALTER TABLE videos_uploads ADD COLUMN IF NOT EXISTS finalized_at TIMESTAMP(0) WITH TIME ZONE NULL;
@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS episodes_categories (
+    video_id BIGINT NOT NULL,
+    category_id BIGINT NOT NULL,
+    CONSTRAINT idx_ecs_episode_category UNIQUE (video_id, category_id),
+    CONSTRAINT fk_ecs_video_id FOREIGN KEY (video_id) REFERENCES seasons_episodes (video_id) ON DELETE CASCADE,
+    CONSTRAINT fk_ecs_category_id FOREIGN KEY (category_id) REFERENCES categories (id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS episodes_genres (
+    video_id BIGINT NOT NULL,
+    genre_id BIGINT NOT NULL,
+    CONSTRAINT idx_egs_episode_genre UNIQUE (video_id, genre_id),
+    CONSTRAINT fk_egs_video_id FOREIGN KEY (video_id) REFERENCES seasons_episodes (video_id) ON DELETE CASCADE,
+    CONSTRAINT fk_egs_genre_id FOREIGN KEY (genre_id) REFERENCES genres (id) ON DELETE CASCADE
+);
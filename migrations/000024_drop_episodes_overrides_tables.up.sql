@@ -0,0 +1,12 @@
+-- an episode's own categories and genres are now the ones of its video, overrides
+-- kept here move to videos that have none of their own
+INSERT INTO videos_categories (video_id, category_id)
+SELECT ec.video_id, ec.category_id FROM episodes_categories ec
+WHERE NOT EXISTS (SELECT 1 FROM videos_categories vc WHERE vc.video_id = ec.video_id);
+
+INSERT INTO videos_genres (video_id, genre_id)
+SELECT eg.video_id, eg.genre_id FROM episodes_genres eg
+WHERE NOT EXISTS (SELECT 1 FROM videos_genres vg WHERE vg.video_id = eg.video_id);
+
+DROP TABLE IF EXISTS episodes_genres;
+DROP TABLE IF EXISTS episodes_categories;
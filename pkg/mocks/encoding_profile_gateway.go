@@ -0,0 +1,70 @@
+package mocks
+
+import (
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain"
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/encoding"
+	"github.com/stretchr/testify/mock"
+)
+
+type ProfileGatewayMock struct {
+	mock.Mock
+}
+
+func (m *ProfileGatewayMock) Create(aProfile *encoding.Profile) error {
+	args := m.Called(aProfile)
+	return args.Error(0)
+}
+
+func (m *ProfileGatewayMock) FindById(profileId int64) (*encoding.Profile, error) {
+	args := m.Called(profileId)
+	return args.Get(0).(*encoding.Profile), args.Error(1)
+}
+
+func (m *ProfileGatewayMock) Update(aProfile encoding.Profile) error {
+	args := m.Called(aProfile)
+	return args.Error(0)
+}
+
+func (m *ProfileGatewayMock) DeleteById(profileId int64) error {
+	args := m.Called(profileId)
+	return args.Error(0)
+}
+
+func (m *ProfileGatewayMock) FindAll(query domain.SearchQuery) (*domain.Pagination[encoding.Profile], error) {
+	args := m.Called(query)
+	return args.Get(0).(*domain.Pagination[encoding.Profile]), args.Error(1)
+}
+
+func (m *ProfileGatewayMock) AssignToVideo(videoId int64, profileId *int64) error {
+	args := m.Called(videoId, profileId)
+	return args.Error(0)
+}
+
+func (m *ProfileGatewayMock) AssignToCategory(categoryId int64, profileId *int64) error {
+	args := m.Called(categoryId, profileId)
+	return args.Error(0)
+}
+
+func (m *ProfileGatewayMock) FindAssignments(videoId int64) (*encoding.Assignments, error) {
+	args := m.Called(videoId)
+	return args.Get(0).(*encoding.Assignments), args.Error(1)
+}
+
+type JobPublisherMock struct {
+	mock.Mock
+}
+
+func (m *JobPublisherMock) Publish(job encoding.Job) error {
+	args := m.Called(job)
+	return args.Error(0)
+}
+
+func (m *ProfileGatewayMock) SaveMediaProfile(mediaId int64, aProfile *encoding.Profile) error {
+	args := m.Called(mediaId, aProfile)
+	return args.Error(0)
+}
+
+func (m *ProfileGatewayMock) FindMediaProfile(mediaId int64) (*encoding.Profile, error) {
+	args := m.Called(mediaId)
+	return args.Get(0).(*encoding.Profile), args.Error(1)
+}
@@ -0,0 +1,139 @@
+package mocks
+
+import (
+	"io"
+
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/video"
+	"github.com/stretchr/testify/mock"
+)
+
+type MediaResourceGatewayMock struct {
+	mock.Mock
+}
+
+func (m *MediaResourceGatewayMock) StoreAudioVideo(videoId int64, resource video.VideoResource) (*video.AudioVideoMedia, error) {
+	args := m.Called(videoId, resource)
+	return args.Get(0).(*video.AudioVideoMedia), args.Error(1)
+}
+
+func (m *MediaResourceGatewayMock) StoreImage(videoId int64, resource video.VideoResource) (*video.ImageMedia, error) {
+	args := m.Called(videoId, resource)
+	return args.Get(0).(*video.ImageMedia), args.Error(1)
+}
+
+func (m *MediaResourceGatewayMock) GetResource(videoId int64, aType video.VideoMediaType) (*video.Resource, error) {
+	args := m.Called(videoId, aType)
+	return args.Get(0).(*video.Resource), args.Error(1)
+}
+
+func (m *MediaResourceGatewayMock) GetEncoded(location string) (*video.Resource, error) {
+	args := m.Called(location)
+	return args.Get(0).(*video.Resource), args.Error(1)
+}
+
+func (m *MediaResourceGatewayMock) StoreTextTrack(videoId int64, track video.TextTrack, resource video.Resource) (*video.TextTrack, error) {
+	args := m.Called(videoId, track, resource)
+	return args.Get(0).(*video.TextTrack), args.Error(1)
+}
+
+func (m *MediaResourceGatewayMock) GetTextTrack(videoId int64, language string, kind video.TextTrackKind) (*video.Resource, error) {
+	args := m.Called(videoId, language, kind)
+	return args.Get(0).(*video.Resource), args.Error(1)
+}
+
+func (m *MediaResourceGatewayMock) StoreAudioTrack(videoId int64, language string, resource video.Resource) (*video.AudioVideoMedia, error) {
+	args := m.Called(videoId, language, resource)
+	return args.Get(0).(*video.AudioVideoMedia), args.Error(1)
+}
+
+func (m *MediaResourceGatewayMock) RemoveAudioTrack(videoId int64, language string) error {
+	args := m.Called(videoId, language)
+	return args.Error(0)
+}
+
+func (m *MediaResourceGatewayMock) StoreExtra(videoId int64, extraId int64, resource video.Resource) (*video.AudioVideoMedia, error) {
+	args := m.Called(videoId, extraId, resource)
+	return args.Get(0).(*video.AudioVideoMedia), args.Error(1)
+}
+
+func (m *MediaResourceGatewayMock) GetExtra(videoId int64, extraId int64) (*video.Resource, error) {
+	args := m.Called(videoId, extraId)
+	return args.Get(0).(*video.Resource), args.Error(1)
+}
+
+func (m *MediaResourceGatewayMock) RemoveExtra(videoId int64, extraId int64) error {
+	args := m.Called(videoId, extraId)
+	return args.Error(0)
+}
+
+func (m *MediaResourceGatewayMock) StoreArtwork(videoId int64, artwork video.Artwork, resource video.Resource) (*video.ImageMedia, error) {
+	args := m.Called(videoId, artwork, resource)
+	return args.Get(0).(*video.ImageMedia), args.Error(1)
+}
+
+func (m *MediaResourceGatewayMock) GetArtwork(videoId int64, artwork video.Artwork) (*video.Resource, error) {
+	args := m.Called(videoId, artwork)
+	return args.Get(0).(*video.Resource), args.Error(1)
+}
+
+func (m *MediaResourceGatewayMock) RemoveArtwork(videoId int64, artwork video.Artwork) error {
+	args := m.Called(videoId, artwork)
+	return args.Error(0)
+}
+
+func (m *MediaResourceGatewayMock) GetBlob(checksum string) (*video.Resource, error) {
+	args := m.Called(checksum)
+	return args.Get(0).(*video.Resource), args.Error(1)
+}
+
+func (m *MediaResourceGatewayMock) Commit(location, checksum string) error {
+	args := m.Called(location, checksum)
+	return args.Error(0)
+}
+
+func (m *MediaResourceGatewayMock) Discard(checksum string) error {
+	args := m.Called(checksum)
+	return args.Error(0)
+}
+
+func (m *MediaResourceGatewayMock) Exists(checksum string) (bool, error) {
+	args := m.Called(checksum)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MediaResourceGatewayMock) Release(checksum string) error {
+	args := m.Called(checksum)
+	return args.Error(0)
+}
+
+func (m *MediaResourceGatewayMock) ClearResources(videoId int64) error {
+	args := m.Called(videoId)
+	return args.Error(0)
+}
+
+type ThumbnailGeneratorMock struct {
+	mock.Mock
+}
+
+func (m *ThumbnailGeneratorMock) GenerateHalf(thumbnail video.Resource) (*video.Resource, error) {
+	args := m.Called(thumbnail)
+	return args.Get(0).(*video.Resource), args.Error(1)
+}
+
+type MediaMetadataExtractorMock struct {
+	mock.Mock
+}
+
+func (m *MediaMetadataExtractorMock) Extract(content io.ReadSeeker) (*video.MediaMetadata, error) {
+	args := m.Called(content)
+	return args.Get(0).(*video.MediaMetadata), args.Error(1)
+}
+
+type ManifestGeneratorMock struct {
+	mock.Mock
+}
+
+func (m *ManifestGeneratorMock) Generate(format video.ManifestFormat, media video.AudioVideoMedia) (*video.Resource, error) {
+	args := m.Called(format, media)
+	return args.Get(0).(*video.Resource), args.Error(1)
+}
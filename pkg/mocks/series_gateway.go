@@ -0,0 +1,75 @@
+package mocks
+
+import (
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain"
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/series"
+	"github.com/stretchr/testify/mock"
+)
+
+type SeriesGatewayMock struct {
+	mock.Mock
+}
+
+func (m *SeriesGatewayMock) Create(aSeries *series.Series) error {
+	args := m.Called(aSeries)
+	return args.Error(0)
+}
+
+func (m *SeriesGatewayMock) FindById(seriesId int64) (*series.Series, error) {
+	args := m.Called(seriesId)
+	return args.Get(0).(*series.Series), args.Error(1)
+}
+
+func (m *SeriesGatewayMock) FindByEpisode(videoId int64) (*series.Series, error) {
+	args := m.Called(videoId)
+	return args.Get(0).(*series.Series), args.Error(1)
+}
+
+func (m *SeriesGatewayMock) Update(aSeries series.Series) error {
+	args := m.Called(aSeries)
+	return args.Error(0)
+}
+
+func (m *SeriesGatewayMock) DeleteById(seriesId int64) error {
+	args := m.Called(seriesId)
+	return args.Error(0)
+}
+
+func (m *SeriesGatewayMock) FindAll(query domain.SearchQuery) (*domain.Pagination[series.Series], error) {
+	args := m.Called(query)
+	return args.Get(0).(*domain.Pagination[series.Series]), args.Error(1)
+}
+
+type SeasonGatewayMock struct {
+	mock.Mock
+}
+
+func (m *SeasonGatewayMock) Create(aSeason *series.Season) error {
+	args := m.Called(aSeason)
+	return args.Error(0)
+}
+
+func (m *SeasonGatewayMock) FindById(seasonId int64) (*series.Season, error) {
+	args := m.Called(seasonId)
+	return args.Get(0).(*series.Season), args.Error(1)
+}
+
+func (m *SeasonGatewayMock) FindBySeriesId(seriesId int64) ([]*series.Season, error) {
+	args := m.Called(seriesId)
+	return args.Get(0).([]*series.Season), args.Error(1)
+}
+
+func (m *SeasonGatewayMock) Update(aSeason series.Season) error {
+	args := m.Called(aSeason)
+	return args.Error(0)
+}
+
+func (m *SeasonGatewayMock) DeleteById(seasonId int64) error {
+	args := m.Called(seasonId)
+	return args.Error(0)
+}
+
+func (m *SeasonGatewayMock) FindEpisodeSeasons(videoIds []int64) (map[int64]int64, error) {
+	args := m.Called(videoIds)
+	return args.Get(0).(map[int64]int64), args.Error(1)
+}
@@ -0,0 +1,56 @@
+package mocks
+
+import (
+	"io"
+
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain/upload"
+	"github.com/stretchr/testify/mock"
+)
+
+type UploadGatewayMock struct {
+	mock.Mock
+}
+
+func (m *UploadGatewayMock) Create(anUpload *upload.Upload) error {
+	args := m.Called(anUpload)
+	return args.Error(0)
+}
+
+func (m *UploadGatewayMock) FindById(uploadId string) (*upload.Upload, error) {
+	args := m.Called(uploadId)
+	return args.Get(0).(*upload.Upload), args.Error(1)
+}
+
+func (m *UploadGatewayMock) UpdateOffset(anUpload upload.Upload, previousOffset int64) error {
+	args := m.Called(anUpload, previousOffset)
+	return args.Error(0)
+}
+
+func (m *UploadGatewayMock) Finalize(anUpload upload.Upload) error {
+	args := m.Called(anUpload)
+	return args.Error(0)
+}
+
+func (m *UploadGatewayMock) DeleteById(uploadId string) error {
+	args := m.Called(uploadId)
+	return args.Error(0)
+}
+
+type ChunkStorageMock struct {
+	mock.Mock
+}
+
+func (m *ChunkStorageMock) Append(uploadId string, offset int64, chunk io.Reader) (int64, error) {
+	args := m.Called(uploadId, offset, chunk)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *ChunkStorageMock) Open(uploadId string) (io.ReadCloser, error) {
+	args := m.Called(uploadId)
+	return args.Get(0).(io.ReadCloser), args.Error(1)
+}
+
+func (m *ChunkStorageMock) Remove(uploadId string) error {
+	args := m.Called(uploadId)
+	return args.Error(0)
+}
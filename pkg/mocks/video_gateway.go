@@ -1,6 +1,9 @@
 package mocks
 
 import (
+	"time"
+
+	"github.com.br/gibranct/admin_do_catalogo/internal/domain"
 	"github.com.br/gibranct/admin_do_catalogo/internal/domain/video"
 	"github.com/stretchr/testify/mock"
 )
@@ -14,12 +17,92 @@ func (vg *VideoGatewayMock) Create(aVideo video.Video) (*video.Video, error) {
 	return args.Get(0).(*video.Video), args.Error(1)
 }
 
-func (vg *VideoGatewayMock) DeleteById(aVideo int64) error {
+func (vg *VideoGatewayMock) Update(aVideo video.Video) (*video.Video, error) {
 	args := vg.Called(aVideo)
+	return args.Get(0).(*video.Video), args.Error(1)
+}
+
+func (vg *VideoGatewayMock) DeleteById(videoId int64) error {
+	args := vg.Called(videoId)
+	return args.Error(0)
+}
+
+func (vg *VideoGatewayMock) UpdateMediaStatus(media video.AudioVideoMedia) error {
+	args := vg.Called(media)
+	return args.Error(0)
+}
+
+func (vg *VideoGatewayMock) UpdatePublication(aVideo video.Video, previous video.PublicationStatus) error {
+	args := vg.Called(aVideo, previous)
 	return args.Error(0)
 }
 
+func (vg *VideoGatewayMock) FindDueForPublication(now time.Time) ([]int64, error) {
+	args := vg.Called(now)
+	return args.Get(0).([]int64), args.Error(1)
+}
+
 func (vg *VideoGatewayMock) FindById(videoId int64) (*video.Video, error) {
 	args := vg.Called(videoId)
 	return args.Get(0).(*video.Video), args.Error(1)
 }
+
+func (vg *VideoGatewayMock) FindAll(query video.VideoSearchQuery) (*domain.Pagination[video.Video], error) {
+	args := vg.Called(query)
+	return args.Get(0).(*domain.Pagination[video.Video]), args.Error(1)
+}
+
+func (vg *VideoGatewayMock) ExistsByIds(videoIds []int64) ([]int64, error) {
+	args := vg.Called(videoIds)
+	return args.Get(0).([]int64), args.Error(1)
+}
+
+func (vg *VideoGatewayMock) SaveTextTrack(videoId int64, track video.TextTrack) error {
+	args := vg.Called(videoId, track)
+	return args.Error(0)
+}
+
+func (vg *VideoGatewayMock) FindTextTracks(videoId int64) ([]video.TextTrack, error) {
+	args := vg.Called(videoId)
+	return args.Get(0).([]video.TextTrack), args.Error(1)
+}
+
+func (vg *VideoGatewayMock) SaveAudioTrack(videoId int64, track video.AudioTrack) (*video.AudioTrack, error) {
+	args := vg.Called(videoId, track)
+	return args.Get(0).(*video.AudioTrack), args.Error(1)
+}
+
+func (vg *VideoGatewayMock) DeleteAudioTrack(videoId int64, language string) error {
+	args := vg.Called(videoId, language)
+	return args.Error(0)
+}
+
+func (vg *VideoGatewayMock) SaveExtra(videoId int64, extra video.Extra) (*video.Extra, error) {
+	args := vg.Called(videoId, extra)
+	return args.Get(0).(*video.Extra), args.Error(1)
+}
+
+func (vg *VideoGatewayMock) DeleteExtra(videoId int64, extraId int64) error {
+	args := vg.Called(videoId, extraId)
+	return args.Error(0)
+}
+
+func (vg *VideoGatewayMock) ReorderExtras(videoId int64, extras []video.Extra) error {
+	args := vg.Called(videoId, extras)
+	return args.Error(0)
+}
+
+func (vg *VideoGatewayMock) UpdatePrimaryTrailer(videoId int64, mediaId int64) error {
+	args := vg.Called(videoId, mediaId)
+	return args.Error(0)
+}
+
+func (vg *VideoGatewayMock) SaveArtwork(videoId int64, artwork video.Artwork) (*video.Artwork, error) {
+	args := vg.Called(videoId, artwork)
+	return args.Get(0).(*video.Artwork), args.Error(1)
+}
+
+func (vg *VideoGatewayMock) DeleteArtwork(videoId int64, artworkId int64) error {
+	args := vg.Called(videoId, artworkId)
+	return args.Error(0)
+}
@@ -0,0 +1,39 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+func InitBroker(ctx context.Context) (string, testcontainers.Container, error) {
+	container, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
+		ContainerRequest: testcontainers.ContainerRequest{
+			Image:        "rabbitmq:3.13-alpine",
+			ExposedPorts: []string{"5672/tcp"},
+			WaitingFor: wait.ForLog("Server startup complete").
+				WithStartupTimeout(60 * time.Second),
+		},
+		Started: true,
+	})
+
+	if err != nil {
+		log.Fatalf("Could not start container: %s", err)
+	}
+
+	host, err := container.Host(ctx)
+	if err != nil {
+		log.Fatalf("Could not get container host: %s", err)
+	}
+
+	port, err := container.MappedPort(ctx, "5672/tcp")
+	if err != nil {
+		log.Fatalf("Could not get container port: %s", err)
+	}
+
+	return fmt.Sprintf("amqp://guest:guest@%s:%s/", host, port.Port()), container, nil
+}
@@ -15,6 +15,26 @@ var scripts = []string{
 	"../../migrations/000002_create_cast_members_table.up.sql",
 	"../../migrations/000003_create_genres_table.sql.up.sql",
 	"../../migrations/000004_create_videos_table.sql.up.sql",
+	"../../migrations/000005_create_videos_filter_indexes.up.sql",
+	"../../migrations/000006_add_cascade_to_videos_relations.up.sql",
+	"../../migrations/000007_create_videos_uploads_table.up.sql",
+	"../../migrations/000008_add_failure_to_videos_video_media.up.sql",
+	"../../migrations/000009_create_media_checksum_indexes.up.sql",
+	"../../migrations/000010_add_metadata_to_videos_video_media.up.sql",
+	"../../migrations/000011_add_publication_to_videos.up.sql",
+	"../../migrations/000012_create_videos_ratings_table.up.sql",
+	"../../migrations/000013_create_videos_content_descriptors_table.up.sql",
+	"../../migrations/000014_create_series_tables.up.sql",
+	"../../migrations/000015_create_videos_text_tracks_table.up.sql",
+	"../../migrations/000016_create_videos_audio_tracks_table.up.sql",
+	"../../migrations/000017_create_videos_extras_table.up.sql",
+	"../../migrations/000018_create_videos_artworks_table.up.sql",
+	"../../migrations/000019_create_videos_media_renditions_table.up.sql",
+	"../../migrations/000020_create_encoding_profiles_tables.up.sql",
+	"../../migrations/000021_add_generated_to_videos_image_media.up.sql",
+	"../../migrations/000022_add_encoding_profile_to_videos_video_media.up.sql",
+	"../../migrations/000023_add_finalized_at_to_videos_uploads.up.sql",
+	"../../migrations/000024_drop_episodes_overrides_tables.up.sql",
 }
 
 func InitDatabase(ctx context.Context) (string, *postgres.PostgresContainer, error) {
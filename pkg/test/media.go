@@ -0,0 +1,18 @@
+package test
+
+import (
+	"bytes"
+	"image"
+	"image/png"
+)
+
+func DummyMP4(payload string) []byte {
+	ftyp := []byte("\x00\x00\x00\x18ftypmp42\x00\x00\x00\x00mp42isom")
+	return append(ftyp, payload...)
+}
+
+func DummyPNG(width, height int) []byte {
+	var buf bytes.Buffer
+	png.Encode(&buf, image.NewGray(image.Rect(0, 0, width, height)))
+	return buf.Bytes()
+}